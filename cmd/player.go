@@ -10,9 +10,11 @@ import (
 	"fmt"
 	"strings"
 
+	redis "github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
 	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/player/password"
+	"petrichormud.com/app/internal/player/permission"
 	"petrichormud.com/app/internal/player/username"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/service"
@@ -95,6 +97,48 @@ var addPlayerCmd = &cobra.Command{
 	},
 }
 
+var playerHashesCmd = &cobra.Command{
+	Use:   "hashes",
+	Short: "Report how many players' passwords are hashed with each set of params.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		db, err := sql.Open("mysql", dbURL)
+		if err != nil {
+			return err
+		}
+		if err = service.SetupDB(db); err != nil {
+			return errors.New("error while setting up DB")
+		}
+		if err = service.PingDB(db); err != nil {
+			return errors.New("error while pinging DB")
+		}
+
+		q := query.New(db)
+		hashes, err := q.ListPlayerPasswordHashes(context.Background())
+		if err != nil {
+			return err
+		}
+
+		counts, invalid := password.CountParams(hashes)
+		fmt.Printf("Target params: %s, salt %d, key %d\n", password.Target, password.Target.SaltLength, password.Target.KeyLength)
+		for _, c := range counts {
+			msg := fmt.Sprintf("%s, salt %d, key %d: %d", c.Params, c.Params.SaltLength, c.Params.KeyLength, c.Count)
+			if c.Params.Below(password.Target) {
+				msg = fmt.Sprintf("%s (upgraded on next login)", msg)
+			}
+			fmt.Println(msg)
+		}
+		if invalid > 0 {
+			fmt.Printf("Unreadable hashes: %d\n", invalid)
+		}
+		return nil
+	},
+}
+
 var playerPermissionCmd = &cobra.Command{
 	Use:   "permission",
 	Short: "Grant, revoke, or get data about player permissions.",
@@ -116,6 +160,10 @@ var grantPlayerPermissionCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		redisAddr, err := cmd.Flags().GetString("redis-addr")
+		if err != nil {
+			return err
+		}
 
 		if !username.IsValid(u) {
 			return errors.New("please enter a valid username")
@@ -183,6 +231,13 @@ var grantPlayerPermissionCmd = &cobra.Command{
 			return err
 		}
 
+		// The player's cached permissions have to go, or the grant won't show up until they expire
+		r := redis.NewClient(&redis.Options{Addr: redisAddr})
+		defer r.Close()
+		if err := permission.Invalidate(r, p.ID); err != nil {
+			return errors.New("permission granted, but error while clearing cached permissions")
+		}
+
 		msg := fmt.Sprintf("User %s granted permission %s.", u, perm.Name)
 		fmt.Println(msg)
 		return nil
@@ -264,12 +319,16 @@ func init() {
 	addPlayerCmd.MarkFlagRequired("username")
 	addPlayerCmd.MarkFlagRequired("password")
 
+	playerCmd.AddCommand(playerHashesCmd)
+	playerHashesCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+
 	playerCmd.AddCommand(playerPermissionCmd)
 
 	playerPermissionCmd.AddCommand(grantPlayerPermissionCmd)
 	grantPlayerPermissionCmd.Flags().StringP("username", "u", "", "The username for the player.")
 	grantPlayerPermissionCmd.Flags().StringP("permission", "p", "", "The tag for the permission to grant.")
 	grantPlayerPermissionCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	grantPlayerPermissionCmd.Flags().StringP("redis-addr", "r", "127.0.0.1:6379", "The address for Redis.")
 
 	playerPermissionCmd.AddCommand(listPlayerPermissionCmd)
 	listPlayerPermissionCmd.Flags().StringP("username", "u", "", "The username for the player.")
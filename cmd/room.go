@@ -0,0 +1,218 @@
+/*
+Copyright © 2023 Alec DuBois <alec@petrichormud.com>
+*/
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"petrichormud.com/app/internal/config"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/room"
+	"petrichormud.com/app/internal/room/analysis"
+	"petrichormud.com/app/internal/service"
+)
+
+var roomCmd = &cobra.Command{
+	Use:   "room",
+	Short: "Analyze and map the rooms of the world and how they connect.",
+}
+
+var roomReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Report connected groups, unreachable rooms, orphans, and dead ends.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		origin, err := cmd.Flags().GetInt64("origin")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		g, err := loadRoomGraph(dbURL)
+		if err != nil {
+			return err
+		}
+
+		if !g.HasRoom(origin) {
+			return fmt.Errorf("there's no room with ID %d to start from", origin)
+		}
+
+		components := g.Components()
+		fmt.Printf("Rooms: %d in %d connected groups\n", len(g.IDs), len(components))
+		for n, component := range components {
+			fmt.Printf("Group %d (%d rooms): %s\n", n+1, len(component), formatRoomIDs(component))
+		}
+		fmt.Printf("Unreachable from %d: %s\n", origin, formatRoomIDs(g.Unreachable(origin)))
+		fmt.Printf("Orphans: %s\n", formatRoomIDs(g.Orphans()))
+		fmt.Printf("Dead ends: %s\n", formatRoomIDs(g.DeadEnds()))
+		return nil
+	},
+}
+
+var roomPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Find the shortest path between two rooms.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		from, err := cmd.Flags().GetInt64("from")
+		if err != nil {
+			return err
+		}
+		to, err := cmd.Flags().GetInt64("to")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		g, err := loadRoomGraph(dbURL)
+		if err != nil {
+			return err
+		}
+
+		steps, err := g.ShortestPath(from, to)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d steps from %d to %d\n", len(steps), from, to)
+		for _, step := range steps {
+			fmt.Printf("%d -> %s -> %d\n", step.From, room.DirectionTitle(step.Direction), step.To)
+		}
+		return nil
+	},
+}
+
+var roomPlaceCmd = &cobra.Command{
+	Use:   "place",
+	Short: "Store map coordinates for every room that can be reached from the origin.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		origin, err := cmd.Flags().GetInt64("origin")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		q, err := openRoomQueries(dbURL)
+		if err != nil {
+			return err
+		}
+
+		count, err := room.Backfill(room.BackfillParams{
+			Queries: q,
+			Origin:  origin,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Placed %d rooms, starting from %d.\n", count, origin)
+		return nil
+	},
+}
+
+var roomGeometryCmd = &cobra.Command{
+	Use:   "geometry",
+	Short: "Report exits that don't line up on the map, starting from the origin.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		origin, err := cmd.Flags().GetInt64("origin")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		q, err := openRoomQueries(dbURL)
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.GetRoom(context.Background(), origin); err != nil {
+			return fmt.Errorf("there's no room with ID %d to start from", origin)
+		}
+
+		issues, err := room.ValidateGeometry(room.ValidateGeometryParams{
+			Queries: q,
+			ID:      origin,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d map issues reachable from %d\n", len(issues), origin)
+		for _, issue := range issues {
+			fmt.Println(issue.String())
+		}
+		return nil
+	},
+}
+
+func loadRoomGraph(dbURL string) (analysis.Graph, error) {
+	q, err := openRoomQueries(dbURL)
+	if err != nil {
+		return analysis.Graph{}, err
+	}
+	return analysis.Load(q)
+}
+
+func openRoomQueries(dbURL string) (*query.Queries, error) {
+	db, err := sql.Open("mysql", fmt.Sprintf("%s?parseTime=true", dbURL))
+	if err != nil {
+		return nil, err
+	}
+	if err = service.SetupDB(db); err != nil {
+		return nil, errors.New("error while setting up DB")
+	}
+	if err = service.PingDB(db); err != nil {
+		return nil, errors.New("error while pinging DB")
+	}
+	return query.New(db), nil
+}
+
+func formatRoomIDs(ids []int64) string {
+	if len(ids) == 0 {
+		return "none"
+	}
+	strs := []string{}
+	for _, id := range ids {
+		strs = append(strs, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(strs, ", ")
+}
+
+func init() {
+	rootCmd.AddCommand(roomCmd)
+
+	roomCmd.AddCommand(roomReportCmd)
+	roomReportCmd.Flags().Int64P("origin", "o", config.OriginRoomID(), "The ID of the room the rest should be reachable from.")
+	roomReportCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+
+	roomCmd.AddCommand(roomPathCmd)
+	roomPathCmd.Flags().Int64P("from", "f", 0, "The ID of the room to start from.")
+	roomPathCmd.Flags().Int64P("to", "t", 0, "The ID of the room to end at.")
+	roomPathCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	roomPathCmd.MarkFlagRequired("from")
+	roomPathCmd.MarkFlagRequired("to")
+
+	roomCmd.AddCommand(roomPlaceCmd)
+	roomPlaceCmd.Flags().Int64P("origin", "o", config.OriginRoomID(), "The ID of the room to place the rest from.")
+	roomPlaceCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+
+	roomCmd.AddCommand(roomGeometryCmd)
+	roomGeometryCmd.Flags().Int64P("origin", "o", config.OriginRoomID(), "The ID of the room to start checking from.")
+	roomGeometryCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+}
@@ -7,6 +7,9 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"petrichormud.com/app/internal/config"
+	"petrichormud.com/app/internal/player/password"
 )
 
 var rootCmd = &cobra.Command{
@@ -22,4 +25,14 @@ func Execute() {
 	}
 }
 
-func init() {}
+func init() {
+	cobra.OnInitialize(setPasswordHashTarget)
+}
+
+func setPasswordHashTarget() {
+	p := password.DefaultParams
+	p.Memory = config.PasswordHashMemory(p.Memory)
+	p.Iterations = config.PasswordHashIterations(p.Iterations)
+	p.Parallelism = config.PasswordHashParallelism(p.Parallelism)
+	password.Target = p
+}
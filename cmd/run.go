@@ -4,6 +4,7 @@ Copyright © 2023 Alec DuBois <alec@petrichormud.com>
 package cmd
 
 import (
+	"context"
 	"log"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -12,6 +13,7 @@ import (
 
 	"petrichormud.com/app/internal/app"
 	"petrichormud.com/app/internal/config"
+	"petrichormud.com/app/internal/request"
 	"petrichormud.com/app/internal/service"
 )
 
@@ -29,7 +31,16 @@ var runCmd = &cobra.Command{
 		app.Handlers(a, &i)
 		app.Static(a)
 
-		log.Fatal(a.Listen(":8008"))
+		ctx, cancel := context.WithCancel(context.Background())
+		go request.RunReviewSweeper(ctx, i.Database, i.Queries, request.ReviewSweeperParams{
+			Timeout:  config.ReviewTimeout(),
+			Interval: config.ReviewSweepInterval(),
+		})
+
+		err := a.Listen(":8008")
+		// log.Fatal exits without running deferred calls, so stop the sweeper here
+		cancel()
+		log.Fatal(err)
 	},
 }
 
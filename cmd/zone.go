@@ -0,0 +1,333 @@
+/*
+Copyright © 2023 Alec DuBois <alec@petrichormud.com>
+*/
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/service"
+	"petrichormud.com/app/internal/zone"
+)
+
+var zoneCmd = &cobra.Command{
+	Use:   "zone",
+	Short: "Add zones and assign their owners, builders, and rooms.",
+}
+
+var addZoneCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Create a new zone.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		name, err := cmd.Flags().GetString("name")
+		if err != nil {
+			return err
+		}
+		owner, err := cmd.Flags().GetString("owner")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		if !zone.IsNameValid(name) {
+			return errors.New("please enter a valid zone name")
+		}
+
+		q, err := openZoneQueries(dbURL)
+		if err != nil {
+			return err
+		}
+
+		p, err := q.GetPlayerByUsername(context.Background(), owner)
+		if err != nil {
+			return err
+		}
+
+		result, err := q.CreateZone(context.Background(), query.CreateZoneParams{
+			Name:     name,
+			OwnerPID: p.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		zid, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Zone %s created with ID %d, owned by %s.\n", name, zid, owner)
+		return nil
+	},
+}
+
+var listZonesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List every zone, with its owner and builders.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		q, err := openZoneQueries(dbURL)
+		if err != nil {
+			return err
+		}
+
+		zones, err := q.ListZones(context.Background())
+		if err != nil {
+			return err
+		}
+
+		for _, z := range zones {
+			owner, err := q.GetPlayerUsername(context.Background(), z.OwnerPID)
+			if err != nil {
+				return err
+			}
+			builders, err := q.ListZoneBuilders(context.Background(), z.ID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("[%d] %s, owned by %s\n", z.ID, z.Name, owner)
+			for _, builder := range builders {
+				u, err := q.GetPlayerUsername(context.Background(), builder.PID)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("  builder: %s\n", u)
+			}
+		}
+		return nil
+	},
+}
+
+var zoneOwnerCmd = &cobra.Command{
+	Use:   "owner",
+	Short: "Hand a zone over to a new owner.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		zid, err := cmd.Flags().GetInt64("zone")
+		if err != nil {
+			return err
+		}
+		u, err := cmd.Flags().GetString("username")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		q, err := openZoneQueries(dbURL)
+		if err != nil {
+			return err
+		}
+
+		z, err := q.GetZone(context.Background(), zid)
+		if err != nil {
+			return err
+		}
+		p, err := q.GetPlayerByUsername(context.Background(), u)
+		if err != nil {
+			return err
+		}
+
+		if err := q.UpdateZoneOwner(context.Background(), query.UpdateZoneOwnerParams{
+			OwnerPID: p.ID,
+			ID:       z.ID,
+		}); err != nil {
+			return err
+		}
+
+		fmt.Printf("Zone %s is now owned by %s.\n", z.Name, u)
+		return nil
+	},
+}
+
+var zoneBuilderCmd = &cobra.Command{
+	Use:   "builder",
+	Short: "Assign builders to a zone, or take them off it.",
+}
+
+var addZoneBuilderCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Let a player build in a zone.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		z, p, q, err := zoneBuilderFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		if err := q.AddZoneBuilder(context.Background(), query.AddZoneBuilderParams{
+			ZID: z.ID,
+			PID: p.ID,
+		}); err != nil {
+			return err
+		}
+
+		fmt.Printf("%s can now build in zone %s.\n", p.Username, z.Name)
+		return nil
+	},
+}
+
+var removeZoneBuilderCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Stop a player from building in a zone.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		z, p, q, err := zoneBuilderFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		if err := q.RemoveZoneBuilder(context.Background(), query.RemoveZoneBuilderParams{
+			ZID: z.ID,
+			PID: p.ID,
+		}); err != nil {
+			return err
+		}
+
+		fmt.Printf("%s can no longer build in zone %s.\n", p.Username, z.Name)
+		return nil
+	},
+}
+
+var zoneRoomCmd = &cobra.Command{
+	Use:   "room",
+	Short: "Move a room into a zone. Zone 0 takes it out of any zone.",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		zid, err := cmd.Flags().GetInt64("zone")
+		if err != nil {
+			return err
+		}
+		rid, err := cmd.Flags().GetInt64("room")
+		if err != nil {
+			return err
+		}
+		dbURL, err := cmd.Flags().GetString("db-url")
+		if err != nil {
+			return err
+		}
+
+		q, err := openZoneQueries(dbURL)
+		if err != nil {
+			return err
+		}
+
+		if zid != 0 {
+			if _, err := q.GetZone(context.Background(), zid); err != nil {
+				return err
+			}
+		}
+		rm, err := q.GetRoom(context.Background(), rid)
+		if err != nil {
+			return err
+		}
+
+		if err := q.UpdateRoomZone(context.Background(), query.UpdateRoomZoneParams{
+			ZID: zid,
+			ID:  rm.ID,
+		}); err != nil {
+			return err
+		}
+
+		fmt.Printf("Room %d moved to zone %d.\n", rm.ID, zid)
+		return nil
+	},
+}
+
+func zoneBuilderFlags(cmd *cobra.Command) (query.Zone, query.Player, *query.Queries, error) {
+	zid, err := cmd.Flags().GetInt64("zone")
+	if err != nil {
+		return query.Zone{}, query.Player{}, nil, err
+	}
+	u, err := cmd.Flags().GetString("username")
+	if err != nil {
+		return query.Zone{}, query.Player{}, nil, err
+	}
+	dbURL, err := cmd.Flags().GetString("db-url")
+	if err != nil {
+		return query.Zone{}, query.Player{}, nil, err
+	}
+
+	q, err := openZoneQueries(dbURL)
+	if err != nil {
+		return query.Zone{}, query.Player{}, nil, err
+	}
+
+	z, err := q.GetZone(context.Background(), zid)
+	if err != nil {
+		return query.Zone{}, query.Player{}, nil, err
+	}
+	p, err := q.GetPlayerByUsername(context.Background(), u)
+	if err != nil {
+		return query.Zone{}, query.Player{}, nil, err
+	}
+	return z, p, q, nil
+}
+
+func openZoneQueries(dbURL string) (*query.Queries, error) {
+	db, err := sql.Open("mysql", fmt.Sprintf("%s?parseTime=true", dbURL))
+	if err != nil {
+		return nil, err
+	}
+	if err = service.SetupDB(db); err != nil {
+		return nil, errors.New("error while setting up DB")
+	}
+	if err = service.PingDB(db); err != nil {
+		return nil, errors.New("error while pinging DB")
+	}
+	return query.New(db), nil
+}
+
+func init() {
+	rootCmd.AddCommand(zoneCmd)
+
+	zoneCmd.AddCommand(addZoneCmd)
+	addZoneCmd.Flags().StringP("name", "n", "", "The name for the new zone.")
+	addZoneCmd.Flags().StringP("owner", "o", "", "The username of the player who owns the zone.")
+	addZoneCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	addZoneCmd.MarkFlagRequired("name")
+	addZoneCmd.MarkFlagRequired("owner")
+
+	zoneCmd.AddCommand(listZonesCmd)
+	listZonesCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+
+	zoneCmd.AddCommand(zoneOwnerCmd)
+	zoneOwnerCmd.Flags().Int64P("zone", "z", 0, "The ID of the zone.")
+	zoneOwnerCmd.Flags().StringP("username", "u", "", "The username of the new owner.")
+	zoneOwnerCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	zoneOwnerCmd.MarkFlagRequired("zone")
+	zoneOwnerCmd.MarkFlagRequired("username")
+
+	zoneCmd.AddCommand(zoneBuilderCmd)
+
+	zoneBuilderCmd.AddCommand(addZoneBuilderCmd)
+	addZoneBuilderCmd.Flags().Int64P("zone", "z", 0, "The ID of the zone.")
+	addZoneBuilderCmd.Flags().StringP("username", "u", "", "The username of the builder.")
+	addZoneBuilderCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	addZoneBuilderCmd.MarkFlagRequired("zone")
+	addZoneBuilderCmd.MarkFlagRequired("username")
+
+	zoneBuilderCmd.AddCommand(removeZoneBuilderCmd)
+	removeZoneBuilderCmd.Flags().Int64P("zone", "z", 0, "The ID of the zone.")
+	removeZoneBuilderCmd.Flags().StringP("username", "u", "", "The username of the builder.")
+	removeZoneBuilderCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	removeZoneBuilderCmd.MarkFlagRequired("zone")
+	removeZoneBuilderCmd.MarkFlagRequired("username")
+
+	zoneCmd.AddCommand(zoneRoomCmd)
+	zoneRoomCmd.Flags().Int64P("zone", "z", 0, "The ID of the zone, or 0 for none.")
+	zoneRoomCmd.Flags().Int64P("room", "r", 0, "The ID of the room.")
+	zoneRoomCmd.Flags().StringP("db-url", "d", "root:pass@/test", "The URL for the database.")
+	zoneRoomCmd.MarkFlagRequired("zone")
+	zoneRoomCmd.MarkFlagRequired("room")
+}
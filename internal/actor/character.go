@@ -0,0 +1,117 @@
+package actor
+
+import (
+	"context"
+	"errors"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/route"
+)
+
+var (
+	ErrNotCharacterOwner   error = errors.New("that character belongs to another player")
+	ErrCharacterRetired    error = errors.New("that character is retired")
+	ErrCharacterNotRetired error = errors.New("that character isn't retired")
+)
+
+type CharacterParams struct {
+	AIID int64
+	PID  int64
+}
+
+func getCharacter(ctx context.Context, q *query.Queries, p CharacterParams) (query.ActorImagesPlayerProperty, error) {
+	props, err := q.GetActorImagePlayerPropertiesForImage(ctx, p.AIID)
+	if err != nil {
+		return props, err
+	}
+	if props.PID != p.PID {
+		return props, ErrNotCharacterOwner
+	}
+	return props, nil
+}
+
+// RetireCharacter retires one of a player's characters. A retired character is never current.
+func RetireCharacter(ctx context.Context, q *query.Queries, p CharacterParams) error {
+	props, err := getCharacter(ctx, q, p)
+	if err != nil {
+		return err
+	}
+	if props.Retired {
+		return ErrCharacterRetired
+	}
+
+	if err := q.SetActorImagePlayerPropertiesRetired(ctx, query.SetActorImagePlayerPropertiesRetiredParams{
+		ID:      props.ID,
+		Retired: true,
+	}); err != nil {
+		return err
+	}
+
+	if err := q.SetActorImagePlayerPropertiesCurrent(ctx, query.SetActorImagePlayerPropertiesCurrentParams{
+		ID:      props.ID,
+		Current: false,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReactivateCharacter brings a retired character back to the roster without making it current
+func ReactivateCharacter(ctx context.Context, q *query.Queries, p CharacterParams) error {
+	props, err := getCharacter(ctx, q, p)
+	if err != nil {
+		return err
+	}
+	if !props.Retired {
+		return ErrCharacterNotRetired
+	}
+
+	return q.SetActorImagePlayerPropertiesRetired(ctx, query.SetActorImagePlayerPropertiesRetiredParams{
+		ID:      props.ID,
+		Retired: false,
+	})
+}
+
+// SwitchCurrentCharacter makes a character the player's current one, clearing any other.
+// Run it in a transaction so a player never has more than one current character.
+func SwitchCurrentCharacter(ctx context.Context, q *query.Queries, p CharacterParams) error {
+	props, err := getCharacter(ctx, q, p)
+	if err != nil {
+		return err
+	}
+	if props.Retired {
+		return ErrCharacterRetired
+	}
+
+	if err := q.ClearCurrentActorImagePlayerPropertiesForPlayer(ctx, p.PID); err != nil {
+		return err
+	}
+
+	return q.SetActorImagePlayerPropertiesCurrent(ctx, query.SetActorImagePlayerPropertiesCurrentParams{
+		ID:      props.ID,
+		Current: true,
+	})
+}
+
+type CharacterSummary struct {
+	Name             string
+	ShortDescription string
+	RetirePath       string
+	ReactivatePath   string
+	CurrentPath      string
+	Current          bool
+	Retired          bool
+}
+
+func NewCharacterSummary(row *query.ListCharactersForPlayerRow) CharacterSummary {
+	return CharacterSummary{
+		Name:             row.Value,
+		ShortDescription: row.ActorImage.ShortDescription,
+		RetirePath:       route.CharacterRetirePath(row.ActorImage.ID),
+		ReactivatePath:   route.CharacterReactivatePath(row.ActorImage.ID),
+		CurrentPath:      route.CharacterCurrentPath(row.ActorImage.ID),
+		Current:          row.ActorImagesPlayerProperty.Current,
+		Retired:          row.ActorImagesPlayerProperty.Retired,
+	}
+}
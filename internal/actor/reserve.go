@@ -0,0 +1,74 @@
+package actor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/VividCortex/mysqlerr"
+	"github.com/go-sql-driver/mysql"
+
+	"petrichormud.com/app/internal/query"
+)
+
+var ErrCharacterNameReserved error = errors.New("that character name is already taken")
+
+// IsCharacterNameAvailable reports whether a character name is free for a request to use.
+// A name is taken by any unique actor image with that name, or by another request's reservation.
+func IsCharacterNameAvailable(ctx context.Context, q *query.Queries, name string, rid int64) (bool, error) {
+	count, err := q.CountUniqueActorImagesWithCharacterName(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	reservation, err := q.GetCharacterNameReservation(ctx, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return reservation.RID == rid, nil
+}
+
+type ReserveCharacterNameParams struct {
+	Name string
+	RID  int64
+	PID  int64
+}
+
+// ReserveCharacterName holds a character name for a request, replacing any name it held before
+func ReserveCharacterName(ctx context.Context, q *query.Queries, p ReserveCharacterNameParams) error {
+	available, err := IsCharacterNameAvailable(ctx, q, p.Name, p.RID)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return ErrCharacterNameReserved
+	}
+
+	if err := q.DeleteCharacterNameReservationForRequest(ctx, p.RID); err != nil {
+		return err
+	}
+
+	if err := q.CreateCharacterNameReservation(ctx, query.CreateCharacterNameReservationParams{
+		Name: p.Name,
+		RID:  p.RID,
+		PID:  p.PID,
+	}); err != nil {
+		if me, ok := err.(*mysql.MySQLError); ok && me.Number == mysqlerr.ER_DUP_ENTRY {
+			return ErrCharacterNameReserved
+		}
+		return err
+	}
+
+	return nil
+}
+
+func ReleaseCharacterName(ctx context.Context, q *query.Queries, rid int64) error {
+	return q.DeleteCharacterNameReservationForRequest(ctx, rid)
+}
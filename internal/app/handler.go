@@ -4,6 +4,7 @@ import (
 	fiber "github.com/gofiber/fiber/v2"
 
 	"petrichormud.com/app/internal/handler"
+	"petrichormud.com/app/internal/middleware/limits"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 )
@@ -23,7 +24,13 @@ func Handlers(app *fiber.App, i *service.Interfaces) {
 	app.Patch(route.RequestFieldTypePathParam, handler.UpdateRequestField(i))
 	app.Post(route.RequestFieldStatusPathParam, handler.UpdateRequestFieldStatus(i))
 	app.Get(route.RequestPathParam, handler.RequestPage(i))
+	app.Delete(route.RequestPathParam, handler.DeleteRequest(i))
 	app.Post(route.RequestStatusPathParam, handler.UpdateRequestStatus(i))
+	app.Delete(route.RequestStatusPathParam, handler.DeleteRequestStatus(i))
+	app.Post(route.RequestRestorePathParam, handler.RestoreRequest(i))
+	app.Post(route.RequestClaimPathParam, handler.ClaimRequest(i))
+	app.Delete(route.RequestClaimPathParam, handler.UnclaimRequest(i))
+	app.Put(route.RequestReviewerPathParam, handler.AssignRequestReviewer(i))
 
 	app.Post(route.RequestSubfieldsPathParam, handler.CreateRequestSubfield(i))
 	app.Patch(route.RequestSubfieldPathParam, handler.UpdateRequestSubfield(i))
@@ -33,13 +40,23 @@ func Handlers(app *fiber.App, i *service.Interfaces) {
 	app.Delete(route.RequestChangeRequestPathParam, handler.DeleteRequestChangeRequest(i))
 	app.Put(route.RequestChangeRequestPathParam, handler.EditRequestChangeRequest(i))
 
+	app.Post(route.RequestFieldCommentsPathParam, handler.CreateRequestFieldComment(i))
+	app.Post(route.RequestFieldCommentsResolvedPathParam, handler.ResolveRequestFieldComments(i))
+	app.Delete(route.RequestFieldCommentsResolvedPathParam, handler.ReopenRequestFieldComments(i))
+
 	app.Post(route.Characters, handler.CreateCharacterApplication(i))
 	app.Get(route.Characters, handler.CharactersPage(i))
 
 	app.Get(route.CharacterApplications, handler.CharacterApplicationsQueuePage(i))
+	app.Post(route.CharacterNameReservedPathParam, handler.CharacterNameReserved(i))
+	app.Post(route.CharacterRetirePathParam, handler.RetireCharacter(i))
+	app.Post(route.CharacterReactivatePathParam, handler.ReactivateCharacter(i))
+	app.Post(route.CharacterCurrentPathParam, handler.SwitchCurrentCharacter(i))
 
-	app.Post(route.Login, handler.Login(i))
+	app.Post(route.Login, limits.New(i, limits.Login), handler.Login(i))
 	app.Get(route.Login, handler.LoginPage())
+	app.Post(route.LoginTwoFactor, limits.New(i, limits.LoginTwoFactor), handler.LoginTwoFactor(i))
+	app.Get(route.LoginTwoFactor, handler.LoginTwoFactorPage())
 	app.Post(route.Logout, handler.Logout(i))
 	app.Get(route.Logout, handler.LogoutPage())
 
@@ -51,22 +68,28 @@ func Handlers(app *fiber.App, i *service.Interfaces) {
 	app.Delete(route.EmailPath(route.ID), handler.DeleteEmail(i))
 	app.Put(route.EmailPath(route.ID), handler.EditEmail(i))
 	app.Post(route.ResendEmailVerificationPath(route.ID), handler.ResendEmailVerification(i))
+	app.Post(route.PrimaryEmailPath(route.ID), handler.SetPrimaryEmail(i))
 
 	app.Get(route.VerifyEmail, handler.VerifyEmailPage(i))
 	app.Post(route.VerifyEmail, handler.VerifyEmail(i))
 
 	app.Get(route.Profile, handler.ProfilePage(i))
+	app.Delete(route.ProfileSessions, handler.RevokeAllSessions(i))
+	app.Delete(route.ProfileSessionParam, handler.RevokeSession(i))
+	app.Post(route.ProfileTwoFactor, handler.SetupTwoFactor(i))
+	app.Post(route.ProfileTwoFactorEnable, handler.EnableTwoFactor(i))
+	app.Post(route.ProfileTwoFactorDisable, limits.New(i, limits.DisableTwoFactor), handler.DisableTwoFactor(i))
 
 	app.Put(route.PlayerPasswordParam, handler.ChangePassword(i))
 
 	app.Get(route.Recover, handler.RecoverPage())
 
 	app.Get(route.RecoverUsername, handler.RecoverUsernamePage())
-	app.Post(route.RecoverUsername, handler.RecoverUsername(i))
+	app.Post(route.RecoverUsername, limits.New(i, limits.RecoverUsername), handler.RecoverUsername(i))
 	app.Get(route.RecoverUsernameSuccess, handler.RecoverUsernameSuccessPage(i))
 
 	app.Get(route.RecoverPassword, handler.RecoverPasswordPage())
-	app.Post(route.RecoverPassword, handler.RecoverPassword(i))
+	app.Post(route.RecoverPassword, limits.New(i, limits.RecoverPassword), handler.RecoverPassword(i))
 	app.Get(route.RecoverPasswordSuccess, handler.RecoverPasswordSuccessPage(i))
 
 	app.Get(route.ResetPassword, handler.ResetPasswordPage())
@@ -74,16 +97,24 @@ func Handlers(app *fiber.App, i *service.Interfaces) {
 	app.Get(route.ResetPasswordSuccess, handler.ResetPasswordSuccessPage())
 
 	app.Get(route.PlayerPermissions, handler.PlayerPermissionsPage(i))
+	app.Get(route.PlayerHistory, handler.PlayerHistoryPage(i))
+	app.Post(route.PlayerTwoFactor, handler.RequireTwoFactor(i))
 	app.Get(route.PlayerPermissionsDetailPath(route.Username), handler.PlayerPermissionsDetailPage(i))
 	app.Post(route.PlayerPermissionsTogglePath(route.ID, route.Tag), handler.TogglePlayerPermission(i))
+	app.Post(route.PlayerRolesTogglePath(route.ID, route.Tag), handler.TogglePlayerRole(i))
 
 	app.Get(route.Rooms, handler.RoomsPage(i))
 	app.Post(route.Rooms, handler.NewRoom(i))
+	app.Get(route.RoomsReport, handler.RoomsReportPage(i))
+	app.Get(route.RoomProposals, handler.RoomProposalsPage(i))
+	app.Post(route.RoomProposals, handler.CreateRoomProposal(i))
+	app.Get(route.RoomProposalsQueue, handler.RoomProposalsQueuePage(i))
 	app.Get(route.RoomPathParam, handler.RoomPage(i))
 	app.Get(route.EditRoomPathParam, handler.EditRoomPage(i))
 	app.Get(route.RoomGridPathParam, handler.RoomGrid(i))
 	app.Patch(route.RoomExitsPathParam, handler.EditRoomExit(i))
 	app.Delete(route.RoomExitPathParam, handler.ClearRoomExit(i))
+	app.Patch(route.RoomExitFlagsPathParam, handler.EditRoomExitFlags(i))
 	app.Patch(route.RoomTitlePathParam, handler.EditRoomTitle(i))
 	app.Patch(route.RoomDescriptionPathParam, handler.EditRoomDescription(i))
 	app.Patch(route.RoomSizePathParam, handler.EditRoomSize(i))
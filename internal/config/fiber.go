@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
@@ -15,9 +16,34 @@ func Fiber(e *html.Engine) fiber.Config {
 	// TODO: Error handling here
 	readTimeoutSecondsCount, _ := strconv.Atoi(os.Getenv("SERVER_READ_TIMEOUT"))
 	readTimeout := time.Second * time.Duration(readTimeoutSecondsCount)
+	proxyHeader, trustedProxies := Proxies()
 	return fiber.Config{
 		Views:       e,
 		ViewsLayout: layout.Main,
 		ReadTimeout: readTimeout,
+		// c.IP() only reads the proxy header on requests from a trusted proxy, so rate limits
+		// can't be dodged by sending a made-up header straight to the server
+		ProxyHeader:             proxyHeader,
+		EnableTrustedProxyCheck: len(proxyHeader) > 0,
+		TrustedProxies:          trustedProxies,
+		EnableIPValidation:      len(proxyHeader) > 0,
 	}
 }
+
+// Proxies reads the header the reverse proxy puts the client's IP in, along with the
+// comma-separated IPs and CIDR ranges of the proxies trusted to set it. Without a header,
+// c.IP() is the address of whatever connected.
+func Proxies() (string, []string) {
+	header := strings.TrimSpace(os.Getenv("PROXY_HEADER"))
+	if len(header) == 0 {
+		return "", []string{}
+	}
+	trusted := []string{}
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		proxy = strings.TrimSpace(proxy)
+		if len(proxy) > 0 {
+			trusted = append(trusted, proxy)
+		}
+	}
+	return header, trusted
+}
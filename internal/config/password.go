@@ -0,0 +1,29 @@
+package config
+
+import (
+	"math"
+	"os"
+	"strconv"
+)
+
+// PasswordHashMemory is the argon2id memory cost in KiB new password hashes are made with.
+// Raising it, or any of the other costs, upgrades existing hashes as players log in.
+func PasswordHashMemory(fallback uint32) uint32 {
+	return uint32(uintFromEnv("PASSWORD_HASH_MEMORY", uint64(fallback), math.MaxUint32))
+}
+
+func PasswordHashIterations(fallback uint32) uint32 {
+	return uint32(uintFromEnv("PASSWORD_HASH_ITERATIONS", uint64(fallback), math.MaxUint32))
+}
+
+func PasswordHashParallelism(fallback uint8) uint8 {
+	return uint8(uintFromEnv("PASSWORD_HASH_PARALLELISM", uint64(fallback), math.MaxUint8))
+}
+
+func uintFromEnv(key string, fallback, max uint64) uint64 {
+	n, err := strconv.ParseUint(os.Getenv(key), 10, 64)
+	if err != nil || n == 0 || n > max {
+		return fallback
+	}
+	return n
+}
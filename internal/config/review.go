@@ -0,0 +1,29 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	DefaultReviewTimeout       = 72 * time.Hour
+	DefaultReviewSweepInterval = 15 * time.Minute
+)
+
+// ReviewTimeout is how long a request can sit in review before it's released back to the queue
+func ReviewTimeout() time.Duration {
+	return durationFromEnv("REVIEW_TIMEOUT", DefaultReviewTimeout)
+}
+
+// ReviewSweepInterval is how often stale reviews are checked for
+func ReviewSweepInterval() time.Duration {
+	return durationFromEnv("REVIEW_SWEEP_INTERVAL", DefaultReviewSweepInterval)
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,10 @@
+package config
+
+import "math"
+
+const DefaultOriginRoomID int64 = 1
+
+// OriginRoomID is the room the rest of the world should be reachable from
+func OriginRoomID() int64 {
+	return int64(uintFromEnv("ORIGIN_ROOM_ID", uint64(DefaultOriginRoomID), math.MaxInt64))
+}
@@ -1,11 +1,16 @@
 package config
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2/middleware/session"
 
 	"petrichormud.com/app/internal/util"
 )
 
+// SessionExpiration is how long a session lasts without activity
+const SessionExpiration = 24 * time.Hour
+
 func Session() session.Config {
 	if util.IsProd() {
 		return session.Config{
@@ -13,11 +18,13 @@ func Session() session.Config {
 			CookieSameSite:    "strict",
 			CookieSecure:      true,
 			CookieSessionOnly: true,
+			Expiration:        SessionExpiration,
 		}
 	}
 	return session.Config{
 		CookieHTTPOnly:    true,
 		CookieSameSite:    "strict",
 		CookieSessionOnly: true,
+		Expiration:        SessionExpiration,
 	}
 }
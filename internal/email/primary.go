@@ -0,0 +1,102 @@
+package email
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/service"
+)
+
+var (
+	ErrNotVerified               = errors.New("only a verified email can be primary")
+	ErrPrimaryChangedUnsupported = errors.New("the sender can't send primary email changes yet")
+)
+
+// Primary picks out the primary email from a player's emails
+func Primary(emails []query.Email) (query.Email, bool) {
+	for _, e := range emails {
+		if e.IsPrimary {
+			return e, true
+		}
+	}
+	return query.Email{}, false
+}
+
+// Fallback is the verified email that takes over as primary when a player doesn't have one, i.e. the oldest
+func Fallback(emails []query.Email) (query.Email, bool) {
+	var fallback query.Email
+	found := false
+	for _, e := range emails {
+		if !e.Verified {
+			continue
+		}
+		if !found || e.ID < fallback.ID {
+			fallback = e
+			found = true
+		}
+	}
+	return fallback, found
+}
+
+// EnsurePrimary gives a player a primary email if they've lost theirs or never had one, as long as they have a verified email to use.
+// It returns the email it promoted, if it had to promote one.
+func EnsurePrimary(ctx context.Context, q *query.Queries, pid int64) (query.Email, bool, error) {
+	emails, err := q.ListVerifiedEmails(ctx, pid)
+	if err != nil {
+		return query.Email{}, false, err
+	}
+	if _, ok := Primary(emails); ok {
+		return query.Email{}, false, nil
+	}
+	e, ok := Fallback(emails)
+	if !ok {
+		return query.Email{}, false, nil
+	}
+	if err := q.MarkEmailPrimary(ctx, e.ID); err != nil {
+		return query.Email{}, false, err
+	}
+	return e, true, nil
+}
+
+// SetPrimary makes one of a player's verified emails their primary. It returns the email it replaced, if there was one.
+func SetPrimary(ctx context.Context, q *query.Queries, e query.Email) (query.Email, bool, error) {
+	if !e.Verified {
+		return query.Email{}, false, ErrNotVerified
+	}
+
+	old, err := q.GetPrimaryEmail(ctx, e.PID)
+	if err != nil && err != sql.ErrNoRows {
+		return query.Email{}, false, err
+	}
+	replaced := err == nil && old.ID != e.ID
+
+	if err := q.ClearPrimaryEmail(ctx, e.PID); err != nil {
+		return query.Email{}, false, err
+	}
+	if err := q.MarkEmailPrimary(ctx, e.ID); err != nil {
+		return query.Email{}, false, err
+	}
+	return old, replaced, nil
+}
+
+// Recipient is the address notifications for a player go to. Without a primary, it falls back to the given address.
+func Recipient(ctx context.Context, q *query.Queries, pid int64, fallback string) (string, error) {
+	e, err := q.GetPrimaryEmail(ctx, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fallback, nil
+		}
+		return "", err
+	}
+	return e.Address, nil
+}
+
+// SendPrimaryChanged tells a player's old primary address that another one has taken over.
+// The Sender doesn't have an RPC for this yet, so it only reports that nothing was sent.
+//
+// TODO: Send this through the Sender once petrichormud/proto has a SendPrimaryEmailChanged RPC
+func SendPrimaryChanged(i *service.Interfaces, old, primary, username string) error {
+	return ErrPrimaryChangedUnsupported
+}
@@ -15,3 +15,26 @@ func TestVerified(t *testing.T) {
 	expected := []query.Email{v}
 	require.Equal(t, expected, Verified(emails))
 }
+
+func TestPrimary(t *testing.T) {
+	u := query.Email{ID: 1, PID: 69, Address: "test@test.com", Verified: true}
+	v := query.Email{ID: 2, PID: 69, Address: "testagain@test.com", Verified: true, IsPrimary: true}
+	p, ok := Primary([]query.Email{u, v})
+	require.True(t, ok)
+	require.Equal(t, v, p)
+
+	_, ok = Primary([]query.Email{u})
+	require.False(t, ok)
+}
+
+func TestFallback(t *testing.T) {
+	u := query.Email{ID: 1, PID: 69, Address: "test@test.com", Verified: false}
+	v := query.Email{ID: 3, PID: 69, Address: "testagain@test.com", Verified: true}
+	w := query.Email{ID: 2, PID: 69, Address: "testonemore@test.com", Verified: true}
+	f, ok := Fallback([]query.Email{u, v, w})
+	require.True(t, ok)
+	require.Equal(t, w, f)
+
+	_, ok = Fallback([]query.Email{u})
+	require.False(t, ok)
+}
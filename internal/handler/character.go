@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/actor"
+	"petrichormud.com/app/internal/header"
+	"petrichormud.com/app/internal/layout"
+	"petrichormud.com/app/internal/partial"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request"
+	"petrichormud.com/app/internal/service"
+	"petrichormud.com/app/internal/util"
+)
+
+func CharacterNameReserved(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Name string `form:"value"`
+	}
+
+	return func(c *fiber.Ctx) error {
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return nil
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		req, err := i.Queries.GetRequest(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if req.Type != request.TypeCharacterApplication {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		if req.PID != pid {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		available, err := actor.IsCharacterNameAvailable(context.Background(), i.Queries, in.Name, rid)
+		if err != nil {
+			c.Append("HX-Trigger-After-Swap", "ptrcr:character-name-reserved")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.CharacterNameReservedErr, fiber.Map{
+				"CSRF": c.Locals("csrf"),
+			}, layout.CSRF)
+		}
+
+		c.Append("HX-Trigger-After-Swap", "ptrcr:character-name-reserved")
+		if !available {
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusConflict)
+			return c.Render(partial.CharacterNameReserved, fiber.Map{
+				"CSRF": c.Locals("csrf"),
+			}, layout.CSRF)
+		}
+		return c.Render(partial.CharacterNameFree, fiber.Map{
+			"CSRF": c.Locals("csrf"),
+		}, layout.CSRF)
+	}
+}
+
+func RetireCharacter(i *service.Interfaces) fiber.Handler {
+	return updateCharacter(i, actor.RetireCharacter)
+}
+
+func ReactivateCharacter(i *service.Interfaces) fiber.Handler {
+	return updateCharacter(i, actor.ReactivateCharacter)
+}
+
+func SwitchCurrentCharacter(i *service.Interfaces) fiber.Handler {
+	return updateCharacter(i, actor.SwitchCurrentCharacter)
+}
+
+type characterUpdate func(ctx context.Context, q *query.Queries, p actor.CharacterParams) error
+
+func updateCharacter(i *service.Interfaces, update characterUpdate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return nil
+		}
+
+		aiid, err := util.GetID(c, "id")
+		if err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		if err := update(context.Background(), qtx, actor.CharacterParams{
+			AIID: aiid,
+			PID:  pid,
+		}); err != nil {
+			switch err {
+			case sql.ErrNoRows:
+				c.Status(fiber.StatusNotFound)
+			case actor.ErrNotCharacterOwner:
+				c.Status(fiber.StatusForbidden)
+			case actor.ErrCharacterRetired, actor.ErrCharacterNotRetired:
+				c.Status(fiber.StatusConflict)
+			default:
+				c.Status(fiber.StatusInternalServerError)
+			}
+			return nil
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
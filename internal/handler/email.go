@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"log"
 	"net/mail"
 	"strconv"
 
@@ -311,6 +312,29 @@ func EditEmail(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindProfileEditEmailErrInternal, layout.None)
 		}
 
+		// The new address has to be verified before it can be primary, so another one takes over in the meantime
+		var primary query.Email
+		promoted := false
+		if e.IsPrimary {
+			primary, promoted, err = email.EnsurePrimary(context.Background(), qtx, pid)
+			if err != nil {
+				c.Append("HX-Retarget", "#profile-email-error")
+				c.Append("HX-Reswap", "outerHTML")
+				c.Append(header.HXAcceptable, "true")
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(partial.NoticeSectionError, partial.BindProfileEditEmailErrInternal, layout.None)
+			}
+		}
+
+		u, err := qtx.GetPlayerUsernameById(context.Background(), pid)
+		if err != nil {
+			c.Append("HX-Retarget", "#profile-email-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileEditEmailErrInternal, layout.None)
+		}
+
 		err = tx.Commit()
 		if err != nil {
 			c.Append("HX-Retarget", "#profile-email-error")
@@ -320,6 +344,14 @@ func EditEmail(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindProfileEditEmailErrInternal, layout.None)
 		}
 
+		// The old address hears about this, in case it wasn't the player who made the change
+		if promoted {
+			// The change is already saved, so a notice that doesn't go out shouldn't fail the request
+			if err := email.SendPrimaryChanged(i, e.Address, primary.Address, u); err != nil {
+				log.Printf("email: primary change notice for %s not sent: %v", u, err)
+			}
+		}
+
 		return c.Render(partial.ProfileEmailUnverified, &fiber.Map{
 			"ID":       id,
 			"Address":  ne.Address,
@@ -408,6 +440,28 @@ func DeleteEmail(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindProfileDeleteEmailErrInternal, layout.None)
 		}
 
+		var primary query.Email
+		promoted := false
+		if e.IsPrimary {
+			primary, promoted, err = email.EnsurePrimary(context.Background(), qtx, e.PID)
+			if err != nil {
+				c.Append("HX-Retarget", "profile-email-error")
+				c.Append("HX-Reswap", "outerHTML")
+				c.Append(header.HXAcceptable, "true")
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(partial.NoticeSectionError, partial.BindProfileDeleteEmailErrInternal, layout.None)
+			}
+		}
+
+		u, err := qtx.GetPlayerUsernameById(context.Background(), e.PID)
+		if err != nil {
+			c.Append("HX-Retarget", "profile-email-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileDeleteEmailErrInternal, layout.None)
+		}
+
 		err = tx.Commit()
 		if err != nil {
 			c.Append("HX-Retarget", "profile-email-error")
@@ -417,6 +471,13 @@ func DeleteEmail(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindProfileDeleteEmailErrInternal, layout.None)
 		}
 
+		// The old address hears about this, in case it wasn't the player who made the change
+		if promoted {
+			if err := email.SendPrimaryChanged(i, e.Address, primary.Address, u); err != nil {
+				log.Printf("email: primary change notice for %s not sent: %v", u, err)
+			}
+		}
+
 		return c.Render(partial.ProfileEmailDeleteSuccess, &fiber.Map{
 			"ID":      e.ID,
 			"Address": e.Address,
@@ -577,6 +638,12 @@ func VerifyEmail(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		// A newly verified email only takes over when there's no primary, so there's no old one to tell
+		if _, _, err = email.EnsurePrimary(context.Background(), i.Queries, e.PID); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
 		err = i.Redis.Del(context.Background(), key).Err()
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
@@ -710,3 +777,79 @@ func ResendEmailVerification(i *service.Interfaces) fiber.Handler {
 		)
 	}
 }
+
+func SetPrimaryEmail(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return nil
+		}
+
+		id, err := util.GetID(c, "id")
+		if err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		e, err := qtx.GetEmail(context.Background(), id)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if e.PID != pid {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		if !e.Verified {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		if e.IsPrimary {
+			c.Status(fiber.StatusConflict)
+			return nil
+		}
+
+		old, replaced, err := email.SetPrimary(context.Background(), qtx, e)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		u, err := qtx.GetPlayerUsernameById(context.Background(), pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		// The old address hears about this, in case it wasn't the player who made the change
+		if replaced {
+			if err := email.SendPrimaryChanged(i, old.Address, e.Address, u); err != nil {
+				log.Printf("email: primary change notice for %s not sent: %v", u, err)
+			}
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
@@ -2,14 +2,16 @@ package handler
 
 import (
 	"context"
-	"database/sql"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/session"
 
 	"petrichormud.com/app/internal/header"
 	"petrichormud.com/app/internal/layout"
 	"petrichormud.com/app/internal/partial"
 	"petrichormud.com/app/internal/player/password"
+	"petrichormud.com/app/internal/player/twofactor"
 	"petrichormud.com/app/internal/player/username"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/route"
@@ -68,9 +70,8 @@ func Login(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindLoginErr, layout.None)
 		}
 
-		pid := p.ID
-		err = username.Cache(i.Redis, pid, p.Username)
-		if err != nil {
+		// Logging in is the only time the plaintext is on hand to upgrade a hash with
+		if err := rehash(qtx, p, in.Password); err != nil {
 			c.Append("HX-Retarget", "#login-error")
 			c.Append("HX-Reswap", "outerHTML")
 			c.Append(header.HXAcceptable, "true")
@@ -78,18 +79,8 @@ func Login(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindLoginErr, layout.None)
 		}
 
-		settings, err := qtx.GetPlayerSettings(context.Background(), pid)
+		enabled, err := twofactor.Enabled(context.Background(), qtx, p.ID)
 		if err != nil {
-			if err == sql.ErrNoRows {
-				// TODO: This means a player got created without settings
-				c.Status(fiber.StatusInternalServerError)
-				c.Append("HX-Retarget", "#login-error")
-				c.Append("HX-Reswap", "outerHTML")
-				c.Append(header.HXAcceptable, "true")
-				c.Status(fiber.StatusUnauthorized)
-				return c.Render(partial.NoticeSectionError, partial.BindLoginErr, layout.None)
-			}
-			c.Status(fiber.StatusInternalServerError)
 			c.Append("HX-Retarget", "#login-error")
 			c.Append("HX-Reswap", "outerHTML")
 			c.Append(header.HXAcceptable, "true")
@@ -106,23 +97,28 @@ func Login(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindLoginErr, layout.None)
 		}
 
-		sess.Set("pid", pid)
-		theme := sess.Get("theme")
-		if theme != nil {
-			if err := qtx.UpdatePlayerSettingsTheme(context.Background(), query.UpdatePlayerSettingsThemeParams{
-				PID:   pid,
-				Theme: theme.(string),
-			}); err != nil {
+		if enabled {
+			// The player isn't logged in until they get past the second step
+			sess.Set(twofactor.PendingSessionKey, p.ID)
+			if err = sess.Save(); err != nil {
 				c.Append("HX-Retarget", "#login-error")
 				c.Append("HX-Reswap", "outerHTML")
 				c.Append(header.HXAcceptable, "true")
 				c.Status(fiber.StatusUnauthorized)
 				return c.Render(partial.NoticeSectionError, partial.BindLoginErr, layout.None)
 			}
-		} else {
-			sess.Set("theme", settings.Theme)
+			if err := tx.Commit(); err != nil {
+				c.Append("HX-Retarget", "#login-error")
+				c.Append("HX-Reswap", "outerHTML")
+				c.Append(header.HXAcceptable, "true")
+				c.Status(fiber.StatusUnauthorized)
+				return c.Render(partial.NoticeSectionError, partial.BindLoginErr, layout.None)
+			}
+			c.Append("HX-Redirect", route.LoginTwoFactor)
+			return nil
 		}
-		if err = sess.Save(); err != nil {
+
+		if err := logIn(i, qtx, sess, p); err != nil {
 			c.Append("HX-Retarget", "#login-error")
 			c.Append("HX-Reswap", "outerHTML")
 			c.Append(header.HXAcceptable, "true")
@@ -155,3 +151,144 @@ func LoginPage() fiber.Handler {
 		return c.Render(view.Login, view.Bind(c), layout.Standalone)
 	}
 }
+
+func LoginTwoFactor(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Code string `form:"code"`
+	}
+	return func(c *fiber.Ctx) error {
+		tfpid := c.Locals(twofactor.PendingSessionKey)
+		if tfpid == nil {
+			c.Append("HX-Redirect", route.Login)
+			return nil
+		}
+		pid := tfpid.(int64)
+
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		if err := twofactor.Verify(context.Background(), qtx, pid, in.Code, time.Now()); err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+
+		p, err := qtx.GetPlayer(context.Background(), pid)
+		if err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+
+		sess, err := i.Sessions.Get(c)
+		if err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+
+		if err := logIn(i, qtx, sess, p); err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Append("HX-Retarget", "#login-two-factor-error")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Append(header.HXAcceptable, "true")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindLoginTwoFactorErr, layout.None)
+		}
+
+		c.Append("HX-Redirect", route.Home)
+		return nil
+	}
+}
+
+func LoginTwoFactorPage() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Locals("pid") != nil {
+			return c.Redirect(route.Home)
+		}
+		if c.Locals(twofactor.PendingSessionKey) == nil {
+			return c.Redirect(route.Login)
+		}
+
+		return c.Render(view.LoginTwoFactor, view.Bind(c), layout.Standalone)
+	}
+}
+
+// rehash upgrades a player's password hash if it was made with params below the target
+func rehash(q *query.Queries, p query.Player, pw string) error {
+	needs, err := password.NeedsRehash(p.PwHash)
+	if err != nil {
+		return err
+	}
+	if !needs {
+		return nil
+	}
+
+	pwHash, err := password.Hash(pw)
+	if err != nil {
+		return err
+	}
+	_, err = q.UpdatePlayerPassword(context.Background(), query.UpdatePlayerPasswordParams{
+		PwHash: pwHash,
+		ID:     p.ID,
+	})
+	return err
+}
+
+// logIn finishes logging a player in once they've proven who they are
+func logIn(i *service.Interfaces, q *query.Queries, sess *session.Session, p query.Player) error {
+	if err := username.Cache(i.Redis, p.ID, p.Username); err != nil {
+		return err
+	}
+
+	settings, err := q.GetPlayerSettings(context.Background(), p.ID)
+	if err != nil {
+		// TODO: ErrNoRows here means a player got created without settings
+		return err
+	}
+
+	sess.Delete(twofactor.PendingSessionKey)
+	sess.Set("pid", p.ID)
+	theme := sess.Get("theme")
+	if theme != nil {
+		if err := q.UpdatePlayerSettingsTheme(context.Background(), query.UpdatePlayerSettingsThemeParams{
+			PID:   p.ID,
+			Theme: theme.(string),
+		}); err != nil {
+			return err
+		}
+	} else {
+		sess.Set("theme", settings.Theme)
+	}
+	return sess.Save()
+}
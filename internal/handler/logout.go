@@ -1,11 +1,15 @@
 package handler
 
 import (
+	"context"
+
 	fiber "github.com/gofiber/fiber/v2"
 
 	"petrichormud.com/app/internal/layout"
+	"petrichormud.com/app/internal/player/session"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
+	"petrichormud.com/app/internal/util"
 	"petrichormud.com/app/internal/view"
 )
 
@@ -16,6 +20,13 @@ func Logout(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
+		pid, err := util.GetPID(c)
+		if err == nil {
+			if err := session.Forget(context.Background(), i.Redis, pid, sess.ID()); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+		}
 		sess.Destroy()
 
 		c.Append("HX-Redirect", route.Logout)
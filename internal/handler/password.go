@@ -12,10 +12,12 @@ import (
 	fiber "github.com/gofiber/fiber/v2"
 	redis "github.com/redis/go-redis/v9"
 
+	"petrichormud.com/app/internal/email"
 	"petrichormud.com/app/internal/header"
 	"petrichormud.com/app/internal/layout"
 	"petrichormud.com/app/internal/partial"
 	"petrichormud.com/app/internal/player/password"
+	"petrichormud.com/app/internal/player/session"
 	"petrichormud.com/app/internal/player/username"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/route"
@@ -91,6 +93,7 @@ func ChangePassword(i *service.Interfaces) fiber.Handler {
 			}
 			return c.Render(partial.NoticeSectionError, b, layout.None)
 		}
+		defer tx.Rollback()
 		qtx := i.Queries.WithTx(tx)
 
 		p, err := qtx.GetPlayer(context.Background(), pid)
@@ -163,6 +166,81 @@ func ChangePassword(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, b, layout.None)
 		}
 
+		pwHash, err := password.Hash(in.Password)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			b := fiber.Map{
+				"NoticeSectionID": "profile-password-notice",
+				"SectionClass":    "pt-4",
+				"NoticeText": []string{
+					"Something's gone terribly wrong.",
+				},
+				"NoticeIcon":    true,
+				"RefreshButton": true,
+			}
+			return c.Render(partial.NoticeSectionError, b, layout.None)
+		}
+
+		if _, err := qtx.UpdatePlayerPassword(context.Background(), query.UpdatePlayerPasswordParams{
+			ID:     pid,
+			PwHash: pwHash,
+		}); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			b := fiber.Map{
+				"NoticeSectionID": "profile-password-notice",
+				"SectionClass":    "pt-4",
+				"NoticeText": []string{
+					"Something's gone terribly wrong.",
+				},
+				"NoticeIcon":    true,
+				"RefreshButton": true,
+			}
+			return c.Render(partial.NoticeSectionError, b, layout.None)
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			b := fiber.Map{
+				"NoticeSectionID": "profile-password-notice",
+				"SectionClass":    "pt-4",
+				"NoticeText": []string{
+					"Something's gone terribly wrong.",
+				},
+				"NoticeIcon":    true,
+				"RefreshButton": true,
+			}
+			return c.Render(partial.NoticeSectionError, b, layout.None)
+		}
+
+		// Anyone else holding a session for this player shouldn't keep it past a password change
+		sess, err := i.Sessions.Get(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			b := fiber.Map{
+				"NoticeSectionID": "profile-password-notice",
+				"SectionClass":    "pt-4",
+				"NoticeText": []string{
+					"Something's gone terribly wrong.",
+				},
+				"NoticeIcon":    true,
+				"RefreshButton": true,
+			}
+			return c.Render(partial.NoticeSectionError, b, layout.None)
+		}
+		if err := session.RevokeAll(context.Background(), i.Redis, i.Sessions.Storage, pid, sess.ID()); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			b := fiber.Map{
+				"NoticeSectionID": "profile-password-notice",
+				"SectionClass":    "pt-4",
+				"NoticeText": []string{
+					"Something's gone terribly wrong.",
+				},
+				"NoticeIcon":    true,
+				"RefreshButton": true,
+			}
+			return c.Render(partial.NoticeSectionError, b, layout.None)
+		}
+
 		b := fiber.Map{
 			"NoticeSectionID": "profile-password-notice",
 			"SectionClass":    "pt-4",
@@ -287,8 +365,8 @@ func RecoverPassword(i *service.Interfaces) fiber.Handler {
 
 		emailAddresses := []string{}
 		for i := 0; i < len(emails); i++ {
-			email := emails[i]
-			emailAddresses = append(emailAddresses, email.Address)
+			e := emails[i]
+			emailAddresses = append(emailAddresses, e.Address)
 		}
 
 		if !slices.Contains(emailAddresses, in.Email) {
@@ -305,13 +383,19 @@ func RecoverPassword(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		err = password.SetupRecovery(i, p.ID, in.Email)
+		to := in.Email
+		if primary, ok := email.Primary(emails); ok {
+			to = primary.Address
+		}
+		err = password.SetupRecovery(i, p.ID, to)
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			c.Append(header.HXAcceptable, "true")
 			return c.Render(partial.NoticeSectionError, partial.BindRecoverPasswordErrInternal, layout.None)
 		}
 
+		// The success page echoes the address that was typed in rather than the one the link went to,
+		// so it can't be used to look up a player's primary email
 		id, err := password.SetupRecoverySuccess(i, in.Email)
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
@@ -445,6 +529,19 @@ func ResetPassword(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindResetPasswordErr, layout.None)
 		}
 
+		// A reset ends every session the player has, other than the one making the reset
+		sess, err := i.Sessions.Get(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			return c.Render(partial.NoticeSectionError, partial.BindResetPasswordErr, layout.None)
+		}
+		if err := session.RevokeAll(context.Background(), i.Redis, i.Sessions.Storage, pid, sess.ID()); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			return c.Render(partial.NoticeSectionError, partial.BindResetPasswordErr, layout.None)
+		}
+
 		c.Append("HX-Redirect", route.ResetPasswordSuccess)
 		return nil
 	}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
 
 	fiber "github.com/gofiber/fiber/v2"
 
 	"petrichormud.com/app/internal/layout"
 	"petrichormud.com/app/internal/partial"
 	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/player/permission"
+	"petrichormud.com/app/internal/player/twofactor"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
@@ -88,12 +92,22 @@ func PlayerPermissionsPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
-		if !perms.HasPermissionInSet(player.ShowPermissionViewPermissions) {
+		if !perms.CanManagePermissions() {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
 
-		return c.Render(view.PlayerPermissions, view.Bind(c), layout.Main)
+		required, err := twofactor.Required(context.Background(), i.Queries)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
+		b := view.Bind(c)
+		b["ShowTwoFactorRequirement"] = perms.HasPermission(player.PermissionGrantAll.Name)
+		b["TwoFactorRequired"] = required
+		b["TwoFactorRequirementPath"] = route.PlayerTwoFactor
+		return c.Render(view.PlayerPermissions, b, layout.Main)
 	}
 }
 
@@ -125,7 +139,7 @@ func PlayerPermissionsDetailPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
-		if !iperms.HasPermissionInSet(player.ShowPermissionViewPermissions) {
+		if !iperms.CanManagePermissions() {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -160,12 +174,39 @@ func PlayerPermissionsDetailPage(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		proles, err := qtx.ListPlayerRoles(context.Background(), p.ID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
 		if err = tx.Commit(); err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
 
+		// The toggles only reflect direct grants; anything else comes from a role
 		perms := player.NewPermissions(p.ID, pperms)
+		rperms := player.NewPermissionsWithRoles(p.ID, pperms, proles)
+		allRoles := []fiber.Map{}
+		for _, role := range player.AllRoles {
+			granted := rperms.HasRole(role.Name)
+			disabled := true
+			if granted {
+				disabled = !iperms.CanRevokeRole(role.Name)
+			} else {
+				disabled = !iperms.CanGrantRole(role.Name)
+			}
+			allRoles = append(allRoles, fiber.Map{
+				"Name":     role.Name,
+				"Title":    role.Title,
+				"About":    role.About,
+				"Link":     route.PlayerRolesTogglePath(strconv.FormatInt(p.ID, 10), role.Name),
+				"Granted":  granted,
+				"Disabled": disabled,
+			})
+		}
+
 		allPerms := []fiber.Map{}
 		for _, perm := range player.AllPermissions {
 			granted := perms.Permissions[perm.Name]
@@ -185,16 +226,167 @@ func PlayerPermissionsDetailPage(i *service.Interfaces) fiber.Handler {
 				"Granted":  granted,
 				"Disabled": disabled,
 			}
+			via := []string{}
+			for _, role := range rperms.RolesWithPermission(perm.Name) {
+				via = append(via, role.Title)
+			}
+			if len(via) > 0 {
+				pm["Via"] = strings.Join(via, ", ")
+			}
 			allPerms = append(allPerms, pm)
 		}
 
+		history, err := permission.History(context.Background(), i.Queries, permission.HistoryFilter{
+			PID:   sql.NullInt64{Int64: p.ID, Valid: true},
+			Limit: permission.DefaultHistoryLimit,
+		})
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		filters := url.Values{}
+		filters.Set("target", p.Username)
+
 		b := view.Bind(c)
 		b["Username"] = u
+		b["Roles"] = allRoles
 		b["Permissions"] = allPerms
+		b["History"] = permission.BindHistory(history)
+		b["HistoryPath"] = fmt.Sprintf("%s?%s", route.PlayerHistory, filters.Encode())
+		b["ExportCSVPath"] = route.PlayerHistoryExportPath(permission.HistoryFormatCSV, filters)
+		b["ExportJSONPath"] = route.PlayerHistoryExportPath(permission.HistoryFormatJSON, filters)
 		return c.Render(view.PlayerPermissionsDetail, b)
 	}
 }
 
+func PlayerHistoryPage(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Issuer     string `query:"issuer"`
+		Target     string `query:"target"`
+		Permission string `query:"permission"`
+		Format     string `query:"format"`
+	}
+	return func(c *fiber.Ctx) error {
+		if _, err := util.GetPID(c); err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+		if !perms.CanManagePermissions() {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		in := new(input)
+		if err := c.QueryParser(in); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		f := permission.HistoryFilter{
+			Limit: permission.DefaultHistoryLimit,
+		}
+		export := false
+		switch in.Format {
+		case "":
+		case permission.HistoryFormatCSV, permission.HistoryFormatJSON:
+			export = true
+		default:
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		filters := url.Values{}
+		if len(in.Permission) > 0 {
+			if !player.IsValidPermissionName(in.Permission) && !player.IsValidRoleName(in.Permission) {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			f.Name = sql.NullString{String: in.Permission, Valid: true}
+			filters.Set("permission", in.Permission)
+		}
+
+		// A filter naming a player that doesn't exist can't match anything
+		found := true
+		if len(in.Issuer) > 0 {
+			filters.Set("issuer", in.Issuer)
+			p, err := i.Queries.GetPlayerByUsername(context.Background(), in.Issuer)
+			if err != nil && err != sql.ErrNoRows {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+			found = found && err == nil
+			f.IPID = sql.NullInt64{Int64: p.ID, Valid: true}
+		}
+		if len(in.Target) > 0 {
+			filters.Set("target", in.Target)
+			p, err := i.Queries.GetPlayerByUsername(context.Background(), in.Target)
+			if err != nil && err != sql.ErrNoRows {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+			found = found && err == nil
+			f.PID = sql.NullInt64{Int64: p.ID, Valid: true}
+		}
+
+		history := []permission.HistoryEntry{}
+		if found {
+			if export {
+				history, err = permission.ExportHistory(context.Background(), i.Queries, f)
+			} else {
+				history, err = permission.History(context.Background(), i.Queries, f)
+			}
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+		}
+
+		switch in.Format {
+		case permission.HistoryFormatJSON:
+			return c.JSON(history)
+		case permission.HistoryFormatCSV:
+			c.Set(fiber.HeaderContentType, "text/csv")
+			c.Set(fiber.HeaderContentDisposition, `attachment; filename="permission-history.csv"`)
+			if err := permission.WriteHistoryCSV(c, history); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+			return nil
+		}
+
+		options := []fiber.Map{}
+		for _, role := range player.AllRoles {
+			options = append(options, fiber.Map{
+				"Name":     role.Name,
+				"Title":    fmt.Sprintf("%s (Role)", role.Title),
+				"Selected": role.Name == in.Permission,
+			})
+		}
+		for _, perm := range player.AllPermissions {
+			options = append(options, fiber.Map{
+				"Name":     perm.Name,
+				"Title":    perm.Title,
+				"Selected": perm.Name == in.Permission,
+			})
+		}
+
+		b := view.Bind(c)
+		b["Issuer"] = in.Issuer
+		b["Target"] = in.Target
+		b["PermissionOptions"] = options
+		b["History"] = permission.BindHistory(history)
+		b["ExportCSVPath"] = route.PlayerHistoryExportPath(permission.HistoryFormatCSV, filters)
+		b["ExportJSONPath"] = route.PlayerHistoryExportPath(permission.HistoryFormatJSON, filters)
+		return c.Render(view.PlayerPermissionsHistory, b)
+	}
+}
+
 func TogglePlayerPermission(i *service.Interfaces) fiber.Handler {
 	type input struct {
 		Grant bool `form:"issued"`
@@ -211,7 +403,7 @@ func TogglePlayerPermission(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionGrantAll.Name) {
+		if !perms.CanManagePermissions() {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -257,9 +449,10 @@ func TogglePlayerPermission(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		perms = player.NewPermissions(pid, pperms)
+		// The issuer's permissions decide what can be granted; the target's decide what already is
+		tperms := player.NewPermissions(pid, pperms)
 		perm := player.AllPermissionsByName[ptag]
-		_, granted := perms.Permissions[perm.Name]
+		_, granted := tperms.Permissions[perm.Name]
 
 		if r.Grant && granted {
 			if err = tx.Commit(); err != nil {
@@ -301,6 +494,11 @@ func TogglePlayerPermission(i *service.Interfaces) fiber.Handler {
 				c.Status(fiber.StatusInternalServerError)
 				return nil
 			}
+
+			if err := permission.Invalidate(i.Redis, pid); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
 			return nil
 		}
 
@@ -332,6 +530,11 @@ func TogglePlayerPermission(i *service.Interfaces) fiber.Handler {
 				c.Status(fiber.StatusInternalServerError)
 				return nil
 			}
+
+			if err := permission.Invalidate(i.Redis, pid); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
 			return nil
 		}
 
@@ -353,3 +556,129 @@ func TogglePlayerPermission(i *service.Interfaces) fiber.Handler {
 		return nil
 	}
 }
+
+func TogglePlayerRole(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Grant bool `form:"issued"`
+	}
+	return func(c *fiber.Ctx) error {
+		ipid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+		if !perms.CanManagePermissions() {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		pid, err := util.GetID(c, "id")
+		if err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		ptag := c.Params("tag")
+		if !player.IsValidRoleName(ptag) {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+		role := player.AllRolesByName[ptag]
+
+		r := new(input)
+		if err = c.BodyParser(r); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		if r.Grant && !perms.CanGrantRole(role.Name) {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+		if !r.Grant && !perms.CanRevokeRole(role.Name) {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		proles, err := qtx.ListPlayerRoles(context.Background(), pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		granted := false
+		for _, prole := range proles {
+			if prole.Name == role.Name {
+				granted = true
+			}
+		}
+
+		if r.Grant == granted {
+			c.Status(fiber.StatusConflict)
+			return nil
+		}
+
+		if r.Grant {
+			if err := qtx.CreatePlayerRoleIssuedChangeHistory(context.Background(), query.CreatePlayerRoleIssuedChangeHistoryParams{
+				IPID: ipid,
+				PID:  pid,
+				Name: role.Name,
+			}); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+
+			if _, err := qtx.CreatePlayerRole(context.Background(), query.CreatePlayerRoleParams{
+				IPID: ipid,
+				PID:  pid,
+				Name: role.Name,
+			}); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+		} else {
+			if err := qtx.CreatePlayerRoleRevokedChangeHistory(context.Background(), query.CreatePlayerRoleRevokedChangeHistoryParams{
+				IPID: ipid,
+				PID:  pid,
+				Name: role.Name,
+			}); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+
+			if err := qtx.DeletePlayerRole(context.Background(), query.DeletePlayerRoleParams{
+				PID:  pid,
+				Name: role.Name,
+			}); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := permission.Invalidate(i.Redis, pid); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		return nil
+	}
+}
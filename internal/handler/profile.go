@@ -7,6 +7,9 @@ import (
 
 	"petrichormud.com/app/internal/email"
 	"petrichormud.com/app/internal/layout"
+	"petrichormud.com/app/internal/partial"
+	"petrichormud.com/app/internal/player/session"
+	"petrichormud.com/app/internal/player/twofactor"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 	"petrichormud.com/app/internal/util"
@@ -28,12 +31,54 @@ func ProfilePage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
+		sess, err := i.Sessions.Get(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
+		sessions, err := session.List(context.Background(), i.Redis, i.Sessions.Storage, pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
+		tfenabled, err := twofactor.Enabled(context.Background(), i.Queries, pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
 		b := view.Bind(c)
 		b["Emails"] = emails
+		b["Sessions"] = bindSessions(sessions, sess.ID())
+		b["RevokeAllSessionsPath"] = route.ProfileSessions
 		b["VerifiedEmails"] = email.Verified(emails)
 		b["GravatarEmail"] = "othertest@quack.ninja"
 		b["GravatarHash"] = email.GravatarHash("after.alec@gmail.com")
 		b["ChangePasswordPath"] = route.PlayerPasswordPath(pid)
+		b["TwoFactorEnabled"] = tfenabled
+		b["TwoFactorRestricted"] = c.Locals("tfrestricted") != nil
+		b["TwoFactorRestrictedNotice"] = partial.BindProfileTwoFactorRestricted
+		b["SetupTwoFactorPath"] = route.ProfileTwoFactor
+		b["DisableTwoFactorPath"] = route.ProfileTwoFactorDisable
 		return c.Render(view.Profile, b, layout.Main)
 	}
 }
+
+const SessionDateLayout = "January 2, 2006 15:04"
+
+func bindSessions(sessions []session.Session, current string) []fiber.Map {
+	b := []fiber.Map{}
+	for _, sess := range sessions {
+		b = append(b, fiber.Map{
+			"Created":    sess.CreatedAt.Format(SessionDateLayout),
+			"Seen":       sess.SeenAt.Format(SessionDateLayout),
+			"IP":         sess.IP,
+			"UserAgent":  sess.UserAgent,
+			"Current":    sess.SID == current,
+			"RevokePath": route.ProfileSessionPath(sess.Handle),
+		})
+	}
+	return b
+}
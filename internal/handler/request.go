@@ -3,14 +3,17 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
 
+	"petrichormud.com/app/internal/actor"
 	"petrichormud.com/app/internal/header"
 	"petrichormud.com/app/internal/layout"
 	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/request"
+	"petrichormud.com/app/internal/request/comment"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 	"petrichormud.com/app/internal/util"
@@ -70,6 +73,15 @@ func CreateRequest(i *service.Interfaces) fiber.Handler {
 }
 
 func CreateCharacterApplication(i *service.Interfaces) fiber.Handler {
+	return createRequestOfType(i, request.TypeCharacterApplication)
+}
+
+func CreateRoomProposal(i *service.Interfaces) fiber.Handler {
+	return createRequestOfType(i, request.TypeRoomProposal)
+}
+
+// createRequestOfType starts a new request of a fixed type for the logged-in player
+func createRequestOfType(i *service.Interfaces, t string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		pid, err := util.GetPID(c)
 		if err != nil {
@@ -88,7 +100,7 @@ func CreateCharacterApplication(i *service.Interfaces) fiber.Handler {
 		// TODO: Limit new requests by type
 
 		rid, err := request.New(qtx, request.NewParams{
-			Type: request.TypeCharacterApplication,
+			Type: t,
 			PID:  pid,
 		})
 		if err != nil {
@@ -184,7 +196,7 @@ func RequestFieldPage(i *service.Interfaces) fiber.Handler {
 				c.Status(fiber.StatusForbidden)
 				return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 			}
-			if !perms.Permissions[player.PermissionReviewCharacterApplications.Name] {
+			if !request.CanReview(&perms, req.Type) {
 				c.Status(fiber.StatusForbidden)
 				return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 			}
@@ -195,13 +207,35 @@ func RequestFieldPage(i *service.Interfaces) fiber.Handler {
 			return c.Redirect(route.RequestPath(rid))
 		}
 
+		och, err := i.Queries.GetOpenRequestChangeRequestForRequestField(context.Background(), field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		var openchange *query.OpenRequestChangeRequest = nil
+		if och.ID != 0 {
+			openchange = &och
+		}
+
+		ch, err := i.Queries.GetRequestChangeRequestByFieldID(context.Background(), field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		var change *query.RequestChangeRequest = nil
+		if ch.ID != 0 {
+			change = &ch
+		}
+
 		bfvp := request.BindFieldViewParams{
-			PID:     pid,
-			Request: &req,
-			Field:   &field,
+			PID:        pid,
+			Request:    &req,
+			Field:      &field,
+			OpenChange: openchange,
+			Change:     change,
 		}
 		if request.FieldRequiresSubfields(req.Type, field.Type) {
-			subfields, err := qtx.ListRequestSubfieldsForField(context.Background(), field.ID)
+			subfields, err := i.Queries.ListRequestSubfieldsForField(context.Background(), field.ID)
 			if err != nil {
 				if err == sql.ErrNoRows {
 				} else {
@@ -212,6 +246,11 @@ func RequestFieldPage(i *service.Interfaces) fiber.Handler {
 			bfvp.Subfields = subfields
 		}
 
+		if err := request.LoadFieldView(context.Background(), i.Queries, &bfvp); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
 		b := view.Bind(c)
 		b, err = request.BindFieldView(i.Templates, b, bfvp)
 		if err != nil {
@@ -260,13 +299,23 @@ func RequestPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
+		deleted, err := request.IsDeleted(qtx, rid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		if deleted {
+			c.Status(fiber.StatusNotFound)
+			return c.Render(view.NotFound, view.Bind(c), layout.Standalone)
+		}
+
 		if req.PID != pid {
 			perms, err := util.GetPermissions(c)
 			if err != nil {
 				c.Status(fiber.StatusForbidden)
 				return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 			}
-			if !perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+			if !request.CanReview(&perms, req.Type) {
 				c.Status(fiber.StatusForbidden)
 				return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 			}
@@ -340,10 +389,17 @@ func RequestPage(i *service.Interfaces) fiber.Handler {
 			}
 
 			if nufo.Field == nil {
+				history, err := qtx.ListRequestStatusHistoryForRequest(context.Background(), rid)
+				if err != nil {
+					c.Status(fiber.StatusInternalServerError)
+					return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+				}
+
 				b, err = request.BindOverview(i.Templates, b, request.BindOverviewParams{
 					PID:      pid,
 					Request:  &req,
 					FieldMap: fieldmap,
+					History:  history,
 				})
 				if err != nil {
 					c.Status(fiber.StatusInternalServerError)
@@ -459,6 +515,11 @@ func RequestPage(i *service.Interfaces) fiber.Handler {
 				bfvp.Subfields = subfields
 			}
 
+			if err := request.LoadFieldView(context.Background(), i.Queries, &bfvp); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+
 			b, err = request.BindFieldView(i.Templates, b, bfvp)
 			if err != nil {
 				c.Status(fiber.StatusInternalServerError)
@@ -491,10 +552,17 @@ func RequestPage(i *service.Interfaces) fiber.Handler {
 			changemap[change.RFID] = change
 		}
 
+		history, err := qtx.ListRequestStatusHistoryForRequest(context.Background(), rid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
 		b, err = request.BindOverview(i.Templates, b, request.BindOverviewParams{
 			PID:      pid,
 			Request:  &req,
 			FieldMap: fieldmap,
+			History:  history,
 		})
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
@@ -771,9 +839,10 @@ func CreateRequestSubfield(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if err := qtx.CreateRequestSubfield(context.Background(), query.CreateRequestSubfieldParams{
-			RFID:  field.ID,
+		if err := request.CreateSubfield(context.Background(), qtx, request.CreateSubfieldParams{
+			Field: &field,
 			Value: in.Value,
+			PID:   pid,
 		}); err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
@@ -918,9 +987,10 @@ func UpdateRequestSubfield(i *service.Interfaces) fiber.Handler {
 			}
 		}
 
-		if err := qtx.UpdateRequestSubfield(context.Background(), query.UpdateRequestSubfieldParams{
-			ID:    subfield.ID,
-			Value: in.Value,
+		if err := request.UpdateSubfield(context.Background(), qtx, request.UpdateSubfieldParams{
+			Subfield: &subfield,
+			Value:    in.Value,
+			PID:      pid,
 		}); err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
@@ -1038,7 +1108,10 @@ func DeleteRequestSubfield(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if err := qtx.DeleteRequestSubfield(context.Background(), subfield.ID); err != nil {
+		if err := request.DeleteSubfield(context.Background(), qtx, request.DeleteSubfieldParams{
+			Subfield: &subfield,
+			PID:      pid,
+		}); err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
@@ -1094,10 +1167,17 @@ func UpdateRequestStatus(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
 		status, err := request.NextStatus(request.NextStatusParams{
-			Query:   qtx,
-			Request: &req,
-			PID:     pid,
+			Query:       qtx,
+			Request:     &req,
+			Permissions: &perms,
+			PID:         pid,
 		})
 		if err != nil {
 			if err == request.ErrNextStatusForbidden {
@@ -1109,17 +1189,37 @@ func UpdateRequestStatus(i *service.Interfaces) fiber.Handler {
 		}
 
 		if status == request.StatusFulfilled {
-			// TODO: Return Forbidden, Conflict, etc depending on the error
-			if err := request.Fulfill(qtx, pid, &req); err != nil {
+			if err := request.Fulfill(c.UserContext(), tx, i.Queries, request.FulfillParams{
+				Permissions: &perms,
+				RID:         rid,
+				PID:         pid,
+			}); err != nil {
+				if err == request.ErrNextStatusForbidden {
+					c.Status(fiber.StatusForbidden)
+					return nil
+				}
+				if err == request.ErrActorImageExists || err == request.ErrCharacterNameReserved {
+					c.Status(fiber.StatusConflict)
+					return nil
+				}
 				c.Status(fiber.StatusInternalServerError)
 				return nil
 			}
 		} else {
 			if err := request.UpdateStatus(qtx, request.UpdateStatusParams{
-				Request: &req,
-				PID:     pid,
-				Status:  status,
+				Request:     &req,
+				Permissions: &perms,
+				PID:         pid,
+				Status:      status,
 			}); err != nil {
+				if err == request.ErrNextStatusForbidden {
+					c.Status(fiber.StatusForbidden)
+					return nil
+				}
+				if err == request.ErrCharacterNameReserved {
+					c.Status(fiber.StatusConflict)
+					return nil
+				}
 				c.Status(fiber.StatusInternalServerError)
 				return nil
 			}
@@ -1179,7 +1279,7 @@ func UpdateRequestFieldStatus(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if !perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+		if !perms.HasPermissionInSet(request.ReviewPermissions()) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1300,7 +1400,8 @@ func UpdateRequestFieldStatus(i *service.Interfaces) fiber.Handler {
 	}
 }
 
-func DeleteRequest(i *service.Interfaces) fiber.Handler {
+// DeleteRequestStatus ends a request early: the author cancels it, and a reviewer rejects it
+func DeleteRequestStatus(i *service.Interfaces) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		pid, err := util.GetPID(c)
 		if err != nil {
@@ -1340,30 +1441,162 @@ func DeleteRequest(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		var status string
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
 
-		if req.PID != pid {
-			if req.Status != request.StatusSubmitted {
+		status := request.StatusRejected
+		if req.PID == pid {
+			status = request.StatusCanceled
+		}
+
+		if err = request.UpdateStatus(qtx, request.UpdateStatusParams{
+			Request:     &req,
+			Permissions: &perms,
+			PID:         pid,
+			Status:      status,
+		}); err != nil {
+			if err == request.ErrNextStatusForbidden || err == request.ErrInvalidTransition {
 				c.Status(fiber.StatusForbidden)
 				return nil
 			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
 
-			status = request.StatusRejected
-		} else {
-			if req.Status == request.StatusArchived || req.Status == request.StatusCanceled {
-				// TODO: Figure out deleting an archived or canceled request
-				c.Status(fiber.StatusForbidden)
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
+
+func DeleteRequest(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			if err == util.ErrNoPID {
+				c.Status(fiber.StatusUnauthorized)
 				return nil
 			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
 
-			status = request.StatusCanceled
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			if err == util.ErrNoID {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
 		}
 
-		if err = request.UpdateStatus(qtx, request.UpdateStatusParams{
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		req, err := qtx.GetRequest(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if req.PID != pid {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		if err = request.Delete(qtx, request.DeleteParams{
 			Request: &req,
 			PID:     pid,
-			Status:  status,
 		}); err != nil {
+			if err == request.ErrNotDeletable {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRedirect, request.ListPath(req.Type))
+		return nil
+	}
+}
+
+func RestoreRequest(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			if err == util.ErrNoPID {
+				c.Status(fiber.StatusUnauthorized)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			if err == util.ErrNoID {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		req, err := qtx.GetRequest(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if req.PID != pid {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		if err = request.Restore(qtx, rid); err != nil {
+			if err == request.ErrNotDeleted {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			if err == request.ErrNotRestorable {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
@@ -1373,6 +1606,7 @@ func DeleteRequest(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		c.Append(header.HXRefresh, header.True)
 		return nil
 	}
 }
@@ -1405,7 +1639,7 @@ func CreateRequestChangeRequest(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+		if !perms.HasPermissionInSet(request.ReviewPermissions()) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1537,7 +1771,7 @@ func DeleteRequestChangeRequest(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+		if !perms.HasPermissionInSet(request.ReviewPermissions()) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1631,7 +1865,7 @@ func EditRequestChangeRequest(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+		if !perms.HasPermissionInSet(request.ReviewPermissions()) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1720,6 +1954,9 @@ func CharactersPage(i *service.Interfaces) fiber.Handler {
 		// TODO: Get this into a standard API on the request package
 		summaries := []request.SummaryForQueue{}
 		for _, req := range reqs {
+			if req.Type != request.TypeCharacterApplication {
+				continue
+			}
 			fields, err := qtx.ListRequestFieldsForRequest(context.Background(), req.ID)
 			if err != nil {
 				c.Status(fiber.StatusInternalServerError)
@@ -1739,6 +1976,45 @@ func CharactersPage(i *service.Interfaces) fiber.Handler {
 			summaries = append(summaries, summary)
 		}
 
+		deletedreqs, err := qtx.ListDeletedRequestsForPlayer(context.Background(), query.ListDeletedRequestsForPlayerParams{
+			PID:       pid,
+			Type:      request.TypeCharacterApplication,
+			CreatedAt: request.RestorableSince(time.Now()),
+		})
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c))
+		}
+
+		deleted := []request.DeletedSummary{}
+		for _, row := range deletedreqs {
+			fields, err := qtx.ListRequestFieldsForRequest(context.Background(), row.Request.ID)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c))
+			}
+			summary, err := request.NewDeletedSummary(request.NewDeletedSummaryParams{
+				Request:  &row.Request,
+				Deletion: &row.RequestDeletion,
+				FieldMap: request.FieldMap(fields),
+			})
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c))
+			}
+			deleted = append(deleted, summary)
+		}
+
+		rows, err := qtx.ListCharactersForPlayer(context.Background(), pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c))
+		}
+		characters := []actor.CharacterSummary{}
+		for _, row := range rows {
+			characters = append(characters, actor.NewCharacterSummary(&row))
+		}
+
 		if err = tx.Commit(); err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return nil
@@ -1746,13 +2022,15 @@ func CharactersPage(i *service.Interfaces) fiber.Handler {
 
 		b := view.Bind(c)
 		b["RequestsPath"] = route.Requests
+		b["Characters"] = characters
 		b["CharacterApplicationSummaries"] = summaries
-		b["HasCharacterApplications"] = len(reqs) > 0
+		b["HasCharacterApplications"] = len(summaries) > 0
+		b["DeletedCharacterApplicationSummaries"] = deleted
 		return c.Render(view.Characters, b)
 	}
 }
 
-func CharacterApplicationsQueuePage(i *service.Interfaces) fiber.Handler {
+func RoomProposalsPage(i *service.Interfaces) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		pid, err := util.GetPID(c)
 		if err != nil {
@@ -1765,10 +2043,6 @@ func CharacterApplicationsQueuePage(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusInternalServerError)
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
-		if !perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
-			c.Status(fiber.StatusForbidden)
-			return nil
-		}
 
 		tx, err := i.Database.Begin()
 		if err != nil {
@@ -1778,15 +2052,7 @@ func CharacterApplicationsQueuePage(i *service.Interfaces) fiber.Handler {
 		defer tx.Rollback()
 		qtx := i.Queries.WithTx(tx)
 
-		// TODO: Make this a "List Open Requests By Type" query
-		reqs, err := qtx.ListRequestsByTypeAndStatus(context.Background(), query.ListRequestsByTypeAndStatusParams{
-			Type: request.TypeCharacterApplication,
-			// TODO: Move this to a standard list
-			Statuses: []string{
-				request.StatusSubmitted,
-				request.StatusInReview,
-			},
-		})
+		reqs, err := qtx.ListRequestsForPlayer(context.Background(), pid)
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
@@ -1794,39 +2060,609 @@ func CharacterApplicationsQueuePage(i *service.Interfaces) fiber.Handler {
 
 		summaries := []request.SummaryForQueue{}
 		for _, req := range reqs {
-			if req.PID == pid {
+			if req.Type != request.TypeRoomProposal {
 				continue
 			}
-
 			fields, err := qtx.ListRequestFieldsForRequest(context.Background(), req.ID)
 			if err != nil {
 				c.Status(fiber.StatusInternalServerError)
 				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 			}
-			fieldmap := request.FieldMap(fields)
 			summary, err := request.NewSummaryForQueue(request.NewSummaryForQueueParams{
 				Query:               qtx,
 				Request:             &req,
-				FieldMap:            fieldmap,
+				FieldMap:            request.FieldMap(fields),
 				PID:                 pid,
 				ReviewerPermissions: &perms,
 			})
 			if err != nil {
 				c.Status(fiber.StatusInternalServerError)
-				return c.Render(view.InternalServerError, view.Bind(c))
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 			}
 			summaries = append(summaries, summary)
 		}
 
-		if err = tx.Commit(); err != nil {
+		deletedreqs, err := qtx.ListDeletedRequestsForPlayer(context.Background(), query.ListDeletedRequestsForPlayerParams{
+			PID:       pid,
+			Type:      request.TypeRoomProposal,
+			CreatedAt: request.RestorableSince(time.Now()),
+		})
+		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
-			return nil
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
-		b := view.Bind(c)
-		if len(summaries) > 0 {
-			b["CharacterApplicationSummaries"] = summaries
-		}
-		return c.Render(view.CharacterApplicationQueue, b)
+		deleted := []request.DeletedSummary{}
+		for _, row := range deletedreqs {
+			fields, err := qtx.ListRequestFieldsForRequest(context.Background(), row.Request.ID)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+			summary, err := request.NewDeletedSummary(request.NewDeletedSummaryParams{
+				Request:  &row.Request,
+				Deletion: &row.RequestDeletion,
+				FieldMap: request.FieldMap(fields),
+			})
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+			deleted = append(deleted, summary)
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		b := view.Bind(c)
+		b["RoomProposalSummaries"] = summaries
+		b["DeletedRoomProposalSummaries"] = deleted
+		b["HasRoomProposals"] = len(summaries) > 0
+		if request.CanReview(&perms, request.TypeRoomProposal) {
+			b["QueuePath"] = route.RoomProposalsQueue
+		}
+		return c.Render(view.RoomProposals, b)
+	}
+}
+
+func ClaimRequest(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			if err == util.ErrNoPID {
+				c.Status(fiber.StatusUnauthorized)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			if err == util.ErrNoID {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		req, err := qtx.GetRequestForUpdate(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err = request.Claim(qtx, request.ClaimParams{
+			Request:     &req,
+			Permissions: &perms,
+			PID:         pid,
+		}); err != nil {
+			if err == request.ErrNextStatusForbidden {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
+			if err == request.ErrClaimed || err == request.ErrNotClaimable {
+				c.Status(fiber.StatusConflict)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
+
+func UnclaimRequest(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			if err == util.ErrNoPID {
+				c.Status(fiber.StatusUnauthorized)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			if err == util.ErrNoID {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		req, err := qtx.GetRequestForUpdate(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err = request.Unclaim(qtx, request.ClaimParams{
+			Request:     &req,
+			Permissions: &perms,
+			PID:         pid,
+		}); err != nil {
+			if err == request.ErrNotClaimant || err == request.ErrNextStatusForbidden {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
+			if err == request.ErrNotClaimable {
+				c.Status(fiber.StatusConflict)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
+
+func AssignRequestReviewer(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Username string `form:"username"`
+	}
+
+	return func(c *fiber.Ctx) error {
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		pid, err := util.GetPID(c)
+		if err != nil {
+			if err == util.ErrNoPID {
+				c.Status(fiber.StatusUnauthorized)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			if err == util.ErrNoID {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if !perms.HasPermission(player.PermissionAssignRequestReviewers.Name) {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		assignee, err := qtx.GetPlayerByUsername(context.Background(), in.Username)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		assigneeperms, err := qtx.ListPlayerPermissions(context.Background(), assignee.ID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		assigneeroles, err := qtx.ListPlayerRoles(context.Background(), assignee.ID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		aperms := player.NewPermissionsWithRoles(assignee.ID, assigneeperms, assigneeroles)
+
+		req, err := qtx.GetRequestForUpdate(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if req.PID == pid {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		if err = request.Assign(qtx, request.AssignParams{
+			Request:             &req,
+			Permissions:         &perms,
+			AssigneePermissions: &aperms,
+		}); err != nil {
+			if err == request.ErrNextStatusForbidden {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
+			if err == request.ErrInvalidAssignee {
+				c.Status(fiber.StatusBadRequest)
+				return nil
+			}
+			if err == request.ErrNotClaimable {
+				c.Status(fiber.StatusConflict)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
+
+func CharacterApplicationsQueuePage(i *service.Interfaces) fiber.Handler {
+	return requestQueuePage(i, request.TypeCharacterApplication, route.CharacterApplications, view.CharacterApplicationQueue)
+}
+
+func RoomProposalsQueuePage(i *service.Interfaces) fiber.Handler {
+	return requestQueuePage(i, request.TypeRoomProposal, route.RoomProposalsQueue, view.RoomProposalQueue)
+}
+
+// requestQueuePage renders one request type's review queue, at the path it's served from
+func requestQueuePage(i *service.Interfaces, t, path, v string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		if !request.CanReview(&perms, t) {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		filter := request.QueueFilter{
+			Status:   c.Query("status"),
+			Reviewer: c.Query("reviewer"),
+			Author:   c.Query("author"),
+			Age:      c.QueryInt("age"),
+			Page:     c.QueryInt("page", 1),
+		}
+		if filter.Page < 1 {
+			filter.Page = 1
+		}
+		if !filter.IsAuthorValid() {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		reviewer, err := queueFilterPlayer(qtx, filter.Reviewer, pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		author, err := queueFilterPlayer(qtx, filter.Author, pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
+		rows, more, err := request.ListQueue(qtx, request.ListQueueParams{
+			Now:      time.Now(),
+			Type:     t,
+			Status:   filter.Status,
+			Reviewer: reviewer,
+			Author:   author,
+			MinAge:   time.Duration(filter.Age) * 24 * time.Hour,
+			Page:     filter.Page,
+			PID:      pid,
+		})
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
+		summaries := []request.SummaryForQueue{}
+		for _, row := range rows {
+			fields, err := qtx.ListRequestFieldsForRequest(context.Background(), row.Request.ID)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+			fieldmap := request.FieldMap(fields)
+			summary, err := request.NewSummaryForQueue(request.NewSummaryForQueueParams{
+				Query:               qtx,
+				Request:             &row.Request,
+				FieldMap:            fieldmap,
+				SubmittedAt:         row.SubmittedAt,
+				PID:                 pid,
+				ReviewerPermissions: &perms,
+			})
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c))
+			}
+			summaries = append(summaries, summary)
+		}
+
+		if err = tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		b := view.Bind(c)
+		if len(summaries) > 0 {
+			b["Summaries"] = summaries
+		}
+		b["Filter"] = filter
+		b["QueueStatuses"] = request.QueueStatusOptions()
+		if filter.Page > 1 {
+			b["PrevPagePath"] = filter.PagePath(path, filter.Page-1)
+		}
+		if more {
+			b["NextPagePath"] = filter.PagePath(path, filter.Page+1)
+		}
+		return c.Render(v, b)
+	}
+}
+
+// queueFilterPlayer resolves a queue's reviewer or author filter to a PID
+func queueFilterPlayer(q *query.Queries, filter string, pid int64) (sql.NullInt64, error) {
+	switch filter {
+	case request.QueueFilterAny:
+		return sql.NullInt64{}, nil
+	case request.QueueFilterMe:
+		return sql.NullInt64{Int64: pid, Valid: true}, nil
+	case request.QueueFilterUnclaimed:
+		return sql.NullInt64{Int64: 0, Valid: true}, nil
+	}
+
+	p, err := q.GetPlayerByUsername(context.Background(), filter)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// Nobody matches a player that doesn't exist
+			return sql.NullInt64{Int64: -1, Valid: true}, nil
+		}
+		return sql.NullInt64{}, err
+	}
+	return sql.NullInt64{Int64: p.ID, Valid: true}, nil
+}
+
+func CreateRequestFieldComment(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Text string `form:"text"`
+	}
+	return updateRequestFieldComments(i, func(c *fiber.Ctx, q *query.Queries, field *query.RequestField, pid int64) error {
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			return comment.ErrInvalidText
+		}
+
+		return comment.Post(context.Background(), q, comment.PostParams{
+			Text: in.Text,
+			RFID: field.ID,
+			PID:  pid,
+		})
+	})
+}
+
+func ResolveRequestFieldComments(i *service.Interfaces) fiber.Handler {
+	return updateRequestFieldComments(i, func(_ *fiber.Ctx, q *query.Queries, field *query.RequestField, pid int64) error {
+		return comment.Resolve(context.Background(), q, field.ID, pid)
+	})
+}
+
+func ReopenRequestFieldComments(i *service.Interfaces) fiber.Handler {
+	return updateRequestFieldComments(i, func(_ *fiber.Ctx, q *query.Queries, field *query.RequestField, _ int64) error {
+		return comment.Reopen(context.Background(), q, field.ID)
+	})
+}
+
+type requestFieldCommentsUpdate func(c *fiber.Ctx, q *query.Queries, field *query.RequestField, pid int64) error
+
+// updateRequestFieldComments runs an update against a field's comment thread. Only the request's
+// author and players who can review it take part, and only while the request is open for comments.
+func updateRequestFieldComments(i *service.Interfaces, update requestFieldCommentsUpdate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return nil
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		ft := c.Params("field")
+		if len(ft) == 0 {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		req, err := qtx.GetRequest(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		deleted, err := request.IsDeleted(qtx, rid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if deleted {
+			c.Status(fiber.StatusNotFound)
+			return nil
+		}
+
+		if !request.IsFieldTypeValid(req.Type, ft) {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		if req.PID != pid {
+			perms, err := util.GetPermissions(c)
+			if err != nil {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
+			if !request.CanReview(&perms, req.Type) {
+				c.Status(fiber.StatusForbidden)
+				return nil
+			}
+		}
+
+		if !comment.IsOpen(req.Status) {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		field, err := qtx.GetRequestFieldByType(context.Background(), query.GetRequestFieldByTypeParams{
+			RID:  rid,
+			Type: ft,
+		})
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := update(c, qtx, &field, pid); err != nil {
+			switch err {
+			case comment.ErrInvalidText, comment.ErrEmptyThread:
+				c.Status(fiber.StatusBadRequest)
+			default:
+				c.Status(fiber.StatusInternalServerError)
+			}
+			return nil
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
 	}
 }
@@ -8,21 +8,25 @@ import (
 
 	fiber "github.com/gofiber/fiber/v2"
 
+	"petrichormud.com/app/internal/config"
 	"petrichormud.com/app/internal/header"
 	"petrichormud.com/app/internal/layout"
 	"petrichormud.com/app/internal/partial"
 	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/room"
+	"petrichormud.com/app/internal/room/analysis"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 	"petrichormud.com/app/internal/util"
 	"petrichormud.com/app/internal/view"
+	"petrichormud.com/app/internal/zone"
 )
 
 func RoomsPage(i *service.Interfaces) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		if !util.IsLoggedIn(c) {
+		pid, err := util.GetPID(c)
+		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return c.Render(view.Login, view.Bind(c), layout.Standalone)
 		}
@@ -33,19 +37,67 @@ func RoomsPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 		}
 
-		if !perms.HasPermission(player.PermissionViewAllRooms.Name) {
+		// Builders limited to their own zones only get to see the rooms in them
+		viewAll := perms.HasPermission(player.PermissionViewAllRooms.Name)
+		if !viewAll && !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 		}
 
-		records, err := i.Queries.ListRooms(context.Background())
+		allZones, err := i.Queries.ListZones(context.Background())
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		zones := []query.Zone{}
+		for _, z := range allZones {
+			ok, err := zone.CanView(i.Queries, &perms, pid, z.ID)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+			if ok {
+				zones = append(zones, z)
+			}
+		}
+
+		filter := c.Query("zone")
+		var records []query.Room
+		if zid, ok := zone.ParseFilter(filter); ok {
+			records, err = i.Queries.ListRoomsByZone(context.Background(), zid)
+		} else {
+			filter = ""
+			records, err = i.Queries.ListRooms(context.Background())
+		}
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
+		zoneNames := map[int64]string{}
+		for _, z := range zones {
+			zoneNames[z.ID] = z.Name
+		}
+
+		buildable := map[int64]bool{}
+		for _, record := range records {
+			if _, ok := buildable[record.ZID]; ok {
+				continue
+			}
+			ok, err := zone.CanBuild(i.Queries, &perms, pid, record.ZID)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+			buildable[record.ZID] = ok
+		}
+
 		pageRooms := []fiber.Map{}
 		for _, record := range records {
+			if !viewAll && !buildable[record.ZID] {
+				continue
+			}
+
 			var sb strings.Builder
 			fmt.Fprintf(&sb, "[%d] %s", record.ID, record.Title)
 			pageRoom := fiber.Map{
@@ -53,9 +105,10 @@ func RoomsPage(i *service.Interfaces) fiber.Handler {
 				"Size":       record.Size,
 				"SizeString": room.SizeToString(record.Size),
 				"Path":       route.RoomPath(record.ID),
+				"Zone":       zoneNames[record.ZID],
 			}
 
-			if perms.HasPermission(player.PermissionCreateRoom.Name) {
+			if buildable[record.ZID] {
 				pageRoom["EditPath"] = route.EditRoomPath(record.ID)
 			}
 
@@ -64,6 +117,16 @@ func RoomsPage(i *service.Interfaces) fiber.Handler {
 
 		b := view.Bind(c)
 		b["Rooms"] = pageRooms
+		b["ZoneFilter"] = filter
+		if zid, ok := zone.ParseFilter(filter); ok {
+			// New rooms go into the zone being looked at
+			b["NewRoomZone"] = zid
+		}
+		b["ZoneFilterOptions"] = zone.BindFilterOptions(zones, filter)
+		b["CanCreateRoom"] = zone.IsBuilder(&perms)
+		if perms.HasPermission(player.PermissionCreateRoom.Name) {
+			b["ReportPath"] = route.RoomsReport
+		}
 		b["PageHeader"] = fiber.Map{
 			"Title":    "Rooms",
 			"SubTitle": "Individual rooms, where their exits and individual properties are assigned",
@@ -72,9 +135,85 @@ func RoomsPage(i *service.Interfaces) fiber.Handler {
 	}
 }
 
+func RoomsReportPage(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !util.IsLoggedIn(c) {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusForbidden)
+			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
+		}
+
+		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+			c.Status(fiber.StatusForbidden)
+			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
+		}
+
+		g, err := analysis.Load(i.Queries)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+
+		origin := int64(c.QueryInt("origin", int(config.OriginRoomID())))
+		from := int64(c.QueryInt("from"))
+		to := int64(c.QueryInt("to"))
+
+		b := view.Bind(c)
+		b["PageHeader"] = fiber.Map{
+			"Title":    "Rooms Report",
+			"SubTitle": "How the rooms of the world connect, and the ones that don't",
+		}
+		b["Filter"] = fiber.Map{
+			"Origin": origin,
+			"From":   from,
+			"To":     to,
+		}
+		b["RoomCount"] = len(g.IDs)
+		b["OriginFound"] = g.HasRoom(origin)
+		b["Components"] = g.BindComponents()
+		b["Unreachable"] = g.BindRooms(g.Unreachable(origin))
+		b["Orphans"] = g.BindRooms(g.Orphans())
+		b["DeadEnds"] = g.BindRooms(g.DeadEnds())
+
+		if g.HasRoom(origin) {
+			issues, err := room.ValidateGeometry(room.ValidateGeometryParams{
+				Queries: i.Queries,
+				ID:      origin,
+			})
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+			b["GeometryIssues"] = g.BindGeometryIssues(issues)
+		}
+
+		if from != 0 && to != 0 {
+			steps, err := g.ShortestPath(from, to)
+			switch err {
+			case nil:
+				b["Path"] = g.BindPath(steps)
+			case analysis.ErrRoomNotFound:
+				b["PathError"] = "One of those rooms doesn't exist."
+			case analysis.ErrNoPath:
+				b["PathError"] = "There's no way to walk between those rooms."
+			default:
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+			}
+		}
+
+		return c.Render(view.RoomsReport, b)
+	}
+}
+
 func RoomPage(i *service.Interfaces) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		_, err := util.GetPID(c)
+		pid, err := util.GetPID(c)
 		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return c.Render(view.Login, view.Bind(c), layout.Standalone)
@@ -86,7 +225,7 @@ func RoomPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 		}
 
-		if !perms.HasPermission(player.PermissionViewAllRooms.Name) {
+		if !perms.HasPermission(player.PermissionViewAllRooms.Name) && !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 		}
@@ -107,6 +246,16 @@ func RoomPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
+		ok, err := zone.CanView(i.Queries, &perms, pid, record.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
+		}
+
 		b := view.Bind(c)
 		b["NavBack"] = fiber.Map{
 			"Path":  route.Rooms,
@@ -124,17 +273,28 @@ func RoomPage(i *service.Interfaces) fiber.Handler {
 	}
 }
 
+// exitDirection picks out the direction of an exit sent in a form, where a keyword stands in for a standard direction
+func exitDirection(dir, keyword string) (string, bool) {
+	if len(keyword) > 0 {
+		return keyword, room.IsExitKeywordValid(keyword)
+	}
+	return dir, room.IsDirectionValid(dir)
+}
+
 func NewRoom(i *service.Interfaces) fiber.Handler {
 	type input struct {
 		Direction string `form:"direction"`
+		Keyword   string `form:"keyword"`
+		Return    string `form:"return"`
 		LinkID    int64  `form:"id"`
+		ZoneID    int64  `form:"zone"`
 		TwoWay    bool   `form:"two-way"`
 	}
 
 	const sectionID string = "edit-room-exits-create-error"
 
 	return func(c *fiber.Ctx) error {
-		_, err := util.GetPID(c)
+		pid, err := util.GetPID(c)
 		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			c.Append(header.HXAcceptable, "true")
@@ -166,7 +326,7 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 			}), layout.None)
 		}
 
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			c.Append(header.HXAcceptable, "true")
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -181,6 +341,22 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 			}), layout.None)
 		}
 
+		in := new(input)
+		if err := c.BodyParser(in); err != nil && err != fiber.ErrUnprocessableEntity {
+			c.Status(fiber.StatusBadRequest)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+				SectionID:    sectionID,
+				SectionClass: "pt-2",
+				NoticeText: []string{
+					"Something's gone terribly wrong.",
+				},
+				RefreshButton: true,
+				NoticeIcon:    true,
+			}), layout.None)
+		}
+
 		tx, err := i.Database.Begin()
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
@@ -199,11 +375,72 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 		defer tx.Rollback()
 		qtx := i.Queries.WithTx(tx)
 
-		result, err := qtx.CreateRoom(context.Background(), query.CreateRoomParams{
-			Title:       room.DefaultTitle,
-			Description: room.DefaultDescription,
-			Size:        room.DefaultSize,
-		})
+		// A room linked from another starts out in that room's zone
+		zid := in.ZoneID
+		linkrm := query.Room{}
+		if in.LinkID != 0 {
+			linkrm, err = qtx.GetRoom(context.Background(), in.LinkID)
+			if err != nil {
+				if err == sql.ErrNoRows {
+					c.Status(fiber.StatusNotFound)
+					c.Append(header.HXAcceptable, "true")
+					c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+					return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+						SectionID:    sectionID,
+						SectionClass: "pt-2",
+						NoticeText: []string{
+							"Something's gone terribly wrong.",
+						},
+						RefreshButton: true,
+						NoticeIcon:    true,
+					}), layout.None)
+				}
+				c.Status(fiber.StatusInternalServerError)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+					SectionID:    sectionID,
+					SectionClass: "pt-2",
+					NoticeText: []string{
+						"Something's gone terribly wrong.",
+					},
+					RefreshButton: true,
+					NoticeIcon:    true,
+				}), layout.None)
+			}
+			zid = linkrm.ZID
+		} else if zid != 0 {
+			if _, err := qtx.GetZone(context.Background(), zid); err != nil {
+				if err == sql.ErrNoRows {
+					c.Status(fiber.StatusNotFound)
+					c.Append(header.HXAcceptable, "true")
+					c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+					return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+						SectionID:    sectionID,
+						SectionClass: "pt-2",
+						NoticeText: []string{
+							"That zone no longer exists.",
+						},
+						RefreshButton: true,
+						NoticeIcon:    true,
+					}), layout.None)
+				}
+				c.Status(fiber.StatusInternalServerError)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+					SectionID:    sectionID,
+					SectionClass: "pt-2",
+					NoticeText: []string{
+						"Something's gone terribly wrong.",
+					},
+					RefreshButton: true,
+					NoticeIcon:    true,
+				}), layout.None)
+			}
+		}
+
+		ok, err := zone.CanBuild(qtx, &perms, pid, zid)
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			c.Append(header.HXAcceptable, "true")
@@ -218,8 +455,27 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 				NoticeIcon:    true,
 			}), layout.None)
 		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+				SectionID:    sectionID,
+				SectionClass: "pt-2",
+				NoticeText: []string{
+					"You don't have the permission(s) necessary to build in that zone.",
+				},
+				RefreshButton: true,
+				NoticeIcon:    true,
+			}), layout.None)
+		}
 
-		rid, err := result.LastInsertId()
+		result, err := qtx.CreateRoom(context.Background(), query.CreateRoomParams{
+			Title:       room.DefaultTitle,
+			Description: room.DefaultDescription,
+			Size:        room.DefaultSize,
+			ZID:         zid,
+		})
 		if err != nil {
 			c.Status(fiber.StatusInternalServerError)
 			c.Append(header.HXAcceptable, "true")
@@ -235,9 +491,9 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 			}), layout.None)
 		}
 
-		in := new(input)
-		if err := c.BodyParser(in); err != nil && err != fiber.ErrUnprocessableEntity {
-			c.Status(fiber.StatusBadRequest)
+		rid, err := result.LastInsertId()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
 			c.Append(header.HXAcceptable, "true")
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
@@ -257,35 +513,7 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 			// 2. There isn't a setpiece that leads to the proposed destination room
 			// etc
 
-			rm, err := qtx.GetRoom(context.Background(), in.LinkID)
-			if err != nil {
-				if err == sql.ErrNoRows {
-					c.Status(fiber.StatusNotFound)
-					c.Append(header.HXAcceptable, "true")
-					c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
-					return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
-						SectionID:    sectionID,
-						SectionClass: "pt-2",
-						NoticeText: []string{
-							"Something's gone terribly wrong.",
-						},
-						RefreshButton: true,
-						NoticeIcon:    true,
-					}), layout.None)
-				}
-				c.Status(fiber.StatusInternalServerError)
-				c.Append(header.HXAcceptable, "true")
-				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
-				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
-					SectionID:    sectionID,
-					SectionClass: "pt-2",
-					NoticeText: []string{
-						"Something's gone terribly wrong.",
-					},
-					RefreshButton: true,
-					NoticeIcon:    true,
-				}), layout.None)
-			}
+			rm := linkrm
 
 			exitrm, err := qtx.GetRoom(context.Background(), rid)
 			if err != nil {
@@ -317,7 +545,8 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 				}), layout.None)
 			}
 
-			if !room.IsDirectionValid(in.Direction) {
+			dir, ok := exitDirection(in.Direction, in.Keyword)
+			if !ok {
 				c.Status(fiber.StatusBadRequest)
 				c.Append(header.HXAcceptable, "true")
 				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -336,9 +565,23 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 				Queries:   qtx,
 				ID:        in.LinkID,
 				To:        rid,
-				Direction: in.Direction,
+				Direction: dir,
+				Return:    in.Return,
 				TwoWay:    in.TwoWay,
 			}); err != nil {
+				if err == room.ErrNoReturnDirection || err == room.ErrInvalidDirection {
+					c.Status(fiber.StatusBadRequest)
+					c.Append(header.HXAcceptable, "true")
+					c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+					return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(partial.BindNoticeSectionParams{
+						SectionID:    sectionID,
+						SectionClass: "pt-2",
+						NoticeText: []string{
+							"A two-way exit with a keyword needs a way back, like out.",
+						},
+						NoticeIcon: true,
+					}), layout.None)
+				}
 				c.Status(fiber.StatusInternalServerError)
 				c.Append(header.HXAcceptable, "true")
 				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -438,18 +681,10 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 				}), layout.None)
 			}
 
-			grid := gridGraph.BindMatrix(room.BindMatrixParams{
-				Matrix:  room.EmptyBindMatrix(5),
-				Row:     2,
-				Col:     2,
-				Shallow: false,
-			})
-			grid = room.AnnotateMatrixExits(grid)
-
 			c.Status(fiber.StatusCreated)
-			b := exitGraph.BindExit(in.Direction)
+			b := exitGraph.BindExit(dir)
 			b["Exits"] = exitGraph.BindExits()
-			b["RoomGrid"] = grid
+			b = gridGraph.BindGrid(b, 0)
 			return c.Render(partial.EditRoomExitEdit, b, layout.EditRoomExitsSelect)
 		}
 
@@ -477,7 +712,7 @@ func NewRoom(i *service.Interfaces) fiber.Handler {
 
 func EditRoomPage(i *service.Interfaces) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		_, err := util.GetPID(c)
+		pid, err := util.GetPID(c)
 		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return c.Render(view.Login, view.Bind(c), layout.Standalone)
@@ -495,7 +730,7 @@ func EditRoomPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 		}
 
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
 		}
@@ -518,6 +753,16 @@ func EditRoomPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			return c.Render(view.Forbidden, view.Bind(c), layout.Standalone)
+		}
+
 		graph, err := room.BuildGraph(room.BuildGraphParams{
 			Queries: qtx,
 			Room:    &rm,
@@ -532,13 +777,6 @@ func EditRoomPage(i *service.Interfaces) fiber.Handler {
 			return c.Render(view.InternalServerError, view.Bind(c), layout.Standalone)
 		}
 
-		grid := graph.BindMatrix(room.BindMatrixParams{
-			Matrix:  room.EmptyBindMatrix(5),
-			Row:     2,
-			Col:     2,
-			Shallow: false,
-		})
-		grid = room.AnnotateMatrixExits(grid)
 		exits := graph.BindExits()
 
 		b := view.Bind(c)
@@ -552,7 +790,7 @@ func EditRoomPage(i *service.Interfaces) fiber.Handler {
 			"Title":    room.TitleWithID(rm.Title, rm.ID),
 			"SubTitle": "Update room properties here",
 		}
-		b["RoomGrid"] = grid
+		b = graph.BindGrid(b, 0)
 		b["Title"] = rm.Title
 		b["TitlePath"] = route.RoomTitlePath(rm.ID)
 		b["Description"] = rm.Description
@@ -560,23 +798,16 @@ func EditRoomPage(i *service.Interfaces) fiber.Handler {
 		b["Size"] = rm.Size
 		b["SizePath"] = route.RoomSizePath(rm.ID)
 		b = room.BindSizeRadioGroup(b, &rm)
-		// TODO: I don't think these individual dirs are needed
-		b["North"] = rm.North
-		b["Northeast"] = rm.Northeast
-		b["East"] = rm.East
-		b["Southeast"] = rm.Southeast
-		b["South"] = rm.South
-		b["Southwest"] = rm.Southwest
-		b["West"] = rm.West
-		b["Northwest"] = rm.Northwest
 		b["Exits"] = exits
+		b["NewExit"] = graph.BindNewExit()
 		return c.Render(view.EditRoom, b)
 	}
 }
 
 func RoomGrid(i *service.Interfaces) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		if !util.IsLoggedIn(c) {
+		pid, err := util.GetPID(c)
+		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return nil
 		}
@@ -587,7 +818,7 @@ func RoomGrid(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -615,6 +846,16 @@ func RoomGrid(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
 		graph, err := room.BuildGraph(room.BuildGraphParams{
 			Queries: qtx,
 			Room:    &rm,
@@ -624,16 +865,11 @@ func RoomGrid(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		grid := graph.BindMatrix(room.BindMatrixParams{
-			Matrix:  room.EmptyBindMatrix(5),
-			Row:     2,
-			Col:     2,
-			Shallow: false,
-		})
-		grid = room.AnnotateMatrixExits(grid)
+		// The grid shows one level at a time, counted up and down from this room
+		level := c.QueryInt("level", 0)
 
 		b := fiber.Map{}
-		b["RoomGrid"] = grid
+		b = graph.BindGrid(b, level)
 		return c.Render(partial.RoomGrid, b, layout.None)
 	}
 }
@@ -641,8 +877,11 @@ func RoomGrid(i *service.Interfaces) fiber.Handler {
 func EditRoomExit(i *service.Interfaces) fiber.Handler {
 	type input struct {
 		Direction string `form:"direction"`
+		Keyword   string `form:"keyword"`
+		Return    string `form:"return"`
 		LinkID    int64  `form:"id"`
 		TwoWay    bool   `form:"two-way"`
+		New       bool   `form:"new"`
 	}
 
 	// TODO: Get this in a shared constant
@@ -688,6 +927,27 @@ func EditRoomExit(i *service.Interfaces) fiber.Handler {
 		NoticeIcon:    true,
 	}
 
+	noReturnNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    sectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"A two-way exit with a keyword needs a way back, like out.",
+		},
+		NoticeIcon: true,
+	}
+
+	// Adding a keyword exit has its own form, outside of the existing exits
+	const newSectionID string = "edit-room-exits-new-error"
+
+	conflictNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    newSectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"This room already has an exit with that keyword.",
+		},
+		NoticeIcon: true,
+	}
+
 	return func(c *fiber.Ctx) error {
 		in := new(input)
 		if err := c.BodyParser(in); err != nil && err != fiber.ErrUnprocessableEntity {
@@ -697,7 +957,8 @@ func EditRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 
-		if !room.IsDirectionValid(in.Direction) {
+		dir, ok := exitDirection(in.Direction, in.Keyword)
+		if !ok {
 			c.Status(fiber.StatusBadRequest)
 			c.Append(header.HXAcceptable, "true")
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -711,7 +972,7 @@ func EditRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 
-		_, err := util.GetPID(c)
+		pid, err := util.GetPID(c)
 		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			c.Append(header.HXAcceptable, "true")
@@ -735,7 +996,7 @@ func EditRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			c.Append(header.HXAcceptable, "true")
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -777,17 +1038,62 @@ func EditRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 
-		if err := room.Link(room.LinkParams{
-			Queries:   qtx,
-			ID:        rid,
-			To:        in.LinkID,
-			Direction: in.Direction,
-			TwoWay:    in.TwoWay,
-		}); err != nil {
+		// A two-way link writes the exit back too, so it needs the other room's zone as well
+		zids := []int64{rm.ZID}
+		if in.TwoWay {
+			zids = append(zids, exitrm.ZID)
+		}
+		for _, zid := range zids {
+			ok, err := zone.CanBuild(qtx, &perms, pid, zid)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+			}
+			if !ok {
+				c.Status(fiber.StatusForbidden)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(noPermissionNoticeParams), layout.None)
+			}
+		}
+
+		if in.New {
+			exits, err := qtx.ListRoomExits(context.Background(), rid)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+			}
+			if room.ExitID(exits, dir) != 0 {
+				c.Status(fiber.StatusConflict)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(newSectionID))
+				c.Append("HX-Reswap", "outerHTML")
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(conflictNoticeParams), layout.None)
+			}
+		}
+
+		if err := room.Link(room.LinkParams{
+			Queries:   qtx,
+			ID:        rid,
+			To:        in.LinkID,
+			Direction: dir,
+			Return:    in.Return,
+			TwoWay:    in.TwoWay,
+		}); err != nil {
 			if err == room.ErrLinkSelf {
 				c.Status(fiber.StatusBadRequest)
 				return nil
 			}
+			if err == room.ErrNoReturnDirection || err == room.ErrInvalidDirection {
+				c.Status(fiber.StatusBadRequest)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(noReturnNoticeParams), layout.None)
+			}
 			c.Status(fiber.StatusInternalServerError)
 			return nil
 		}
@@ -885,18 +1191,10 @@ func EditRoomExit(i *service.Interfaces) fiber.Handler {
 			}), layout.None)
 		}
 
-		grid := graph.BindMatrix(room.BindMatrixParams{
-			Matrix:  room.EmptyBindMatrix(5),
-			Row:     2,
-			Col:     2,
-			Shallow: false,
-		})
-		grid = room.AnnotateMatrixExits(grid)
-
 		c.Status(fiber.StatusOK)
-		b := graph.BindExit(in.Direction)
+		b := graph.BindExit(dir)
 		b["Exits"] = graph.BindExits()
-		b["RoomGrid"] = grid
+		b = graph.BindGrid(b, 0)
 		return c.Render(partial.EditRoomExitEdit, b, layout.EditRoomExitsSelect)
 	}
 }
@@ -946,7 +1244,7 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 	}
 
 	return func(c *fiber.Ctx) error {
-		_, err := util.GetPID(c)
+		pid, err := util.GetPID(c)
 		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			c.Append(header.HXAcceptable, "true")
@@ -963,7 +1261,7 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 		}
 
 		dir := c.Params("exit")
-		if !room.IsDirectionValid(dir) {
+		if !room.IsExitValid(dir) {
 			c.Status(fiber.StatusBadRequest)
 			c.Append(header.HXAcceptable, "true")
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -978,7 +1276,7 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			c.Append(header.HXAcceptable, "true")
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
@@ -1007,7 +1305,29 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 
-		exitID := room.ExitID(&rm, dir)
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(noPermissionNoticeParams), layout.None)
+		}
+
+		exits, err := qtx.ListRoomExits(context.Background(), rm.ID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		exitID := room.ExitID(exits, dir)
 		exitrm, err := qtx.GetRoom(context.Background(), exitID)
 		if err != nil {
 			if err == sql.ErrNoRows {
@@ -1021,7 +1341,14 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
-		exitDir, err := room.ExitDirection(&exitrm, rid)
+		exitRoomExits, err := qtx.ListRoomExits(context.Background(), exitrm.ID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+		exitDir, err := room.ExitDirection(exitRoomExits, rid)
 		if err != nil && err != room.ErrExitIDNotFound {
 			c.Status(fiber.StatusInternalServerError)
 			c.Append(header.HXAcceptable, "true")
@@ -1029,6 +1356,21 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
 		}
 		if err != room.ErrExitIDNotFound {
+			// Clearing the exit back edits the other room, so it needs that room's zone too
+			ok, err := zone.CanBuild(qtx, &perms, pid, exitrm.ZID)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+			}
+			if !ok {
+				c.Status(fiber.StatusForbidden)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(noPermissionNoticeParams), layout.None)
+			}
+
 			if err := room.Unlink(room.UnlinkParams{
 				Queries:   qtx,
 				ID:        exitID,
@@ -1086,18 +1428,217 @@ func ClearRoomExit(i *service.Interfaces) fiber.Handler {
 			}), layout.None)
 		}
 
-		grid := graph.BindMatrix(room.BindMatrixParams{
-			Matrix:  room.EmptyBindMatrix(5),
-			Row:     2,
-			Col:     2,
-			Shallow: false,
+		c.Status(fiber.StatusOK)
+		b := graph.BindEmptyExit(dir)
+		b["Exits"] = graph.BindExits()
+		b = graph.BindGrid(b, 0)
+		return c.Render(partial.EditRoomExitEdit, b, layout.EditRoomExitsSelect)
+	}
+}
+
+func EditRoomExitFlags(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Door   bool `form:"door"`
+		Hidden bool `form:"hidden"`
+		Locked bool `form:"locked"`
+	}
+
+	// TODO: Get constant for common section IDs
+	const sectionID string = "edit-room-exits-edit-error"
+
+	internalServerErrorNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    sectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"Something's gone terribly wrong.",
+		},
+		RefreshButton: true,
+		NoticeIcon:    true,
+	}
+
+	sessionExpiredNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    sectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"It looks like your session may have expired.",
+		},
+		RefreshButton: true,
+		NoticeIcon:    true,
+	}
+
+	noPermissionNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    sectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"You don't have the permission required to edit this room.",
+		},
+		RefreshButton: true,
+		NoticeIcon:    true,
+	}
+
+	notFoundNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    sectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"The exit you're looking for no longer exists.",
+		},
+		RefreshButton: true,
+		NoticeIcon:    true,
+	}
+
+	lockWithoutDoorNoticeParams := partial.BindNoticeSectionParams{
+		SectionID:    sectionID,
+		SectionClass: "pt-2",
+		NoticeText: []string{
+			"Only an exit with a door can be locked.",
+		},
+		NoticeIcon: true,
+	}
+
+	return func(c *fiber.Ctx) error {
+		in := new(input)
+		if err := c.BodyParser(in); err != nil && err != fiber.ErrUnprocessableEntity {
+			c.Status(fiber.StatusBadRequest)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(sessionExpiredNoticeParams), layout.None)
+		}
+
+		rid, err := util.GetID(c, "id")
+		if err != nil {
+			c.Status(fiber.StatusBadRequest)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		dir := c.Params("exit")
+		if !room.IsExitValid(dir) {
+			c.Status(fiber.StatusBadRequest)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusForbidden)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		if !zone.IsBuilder(&perms) {
+			c.Status(fiber.StatusForbidden)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(noPermissionNoticeParams), layout.None)
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		rm, err := qtx.GetRoom(context.Background(), rid)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(notFoundNoticeParams), layout.None)
+			}
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(noPermissionNoticeParams), layout.None)
+		}
+
+		if _, err := qtx.GetRoomExit(context.Background(), query.GetRoomExitParams{
+			RID:       rid,
+			Direction: dir,
+		}); err != nil {
+			if err == sql.ErrNoRows {
+				c.Status(fiber.StatusNotFound)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(notFoundNoticeParams), layout.None)
+			}
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		if err := room.UpdateExitFlags(room.UpdateExitFlagsParams{
+			Queries:   qtx,
+			ID:        rid,
+			Direction: dir,
+			Door:      in.Door,
+			Hidden:    in.Hidden,
+			Locked:    in.Locked,
+		}); err != nil {
+			if err == room.ErrLockWithoutDoor {
+				c.Status(fiber.StatusBadRequest)
+				c.Append(header.HXAcceptable, "true")
+				c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+				return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(lockWithoutDoorNoticeParams), layout.None)
+			}
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		graph, err := room.BuildGraph(room.BuildGraphParams{
+			Queries: qtx,
+			Room:    &rm,
 		})
-		grid = room.AnnotateMatrixExits(grid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			c.Append(header.HXAcceptable, "true")
+			c.Append("HX-Retarget", util.PrependHTMLID(sectionID))
+			return c.Render(partial.NoticeSectionError, partial.BindNoticeSection(internalServerErrorNoticeParams), layout.None)
+		}
 
 		c.Status(fiber.StatusOK)
-		b := graph.BindEmptyExit(dir)
+		b := graph.BindExit(dir)
 		b["Exits"] = graph.BindExits()
-		b["RoomGrid"] = grid
+		b = graph.BindGrid(b, 0)
 		return c.Render(partial.EditRoomExitEdit, b, layout.EditRoomExitsSelect)
 	}
 }
@@ -1119,7 +1660,8 @@ func EditRoomTitle(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if !util.IsLoggedIn(c) {
+		pid, err := util.GetPID(c)
+		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return nil
 		}
@@ -1129,7 +1671,7 @@ func EditRoomTitle(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1158,6 +1700,16 @@ func EditRoomTitle(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
 		if rm.Title == in.Title {
 			c.Status(fiber.StatusConflict)
 			return nil
@@ -1219,7 +1771,8 @@ func EditRoomDescription(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if !util.IsLoggedIn(c) {
+		pid, err := util.GetPID(c)
+		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return nil
 		}
@@ -1229,7 +1782,7 @@ func EditRoomDescription(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1258,6 +1811,16 @@ func EditRoomDescription(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
 		// TODO: Add Conflict tests for edit room title, description and size
 		if rm.Description == in.Description {
 			c.Status(fiber.StatusConflict)
@@ -1316,7 +1879,8 @@ func EditRoomSize(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
-		if !util.IsLoggedIn(c) {
+		pid, err := util.GetPID(c)
+		if err != nil {
 			c.Status(fiber.StatusUnauthorized)
 			return nil
 		}
@@ -1326,7 +1890,7 @@ func EditRoomSize(i *service.Interfaces) fiber.Handler {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
-		if !perms.HasPermission(player.PermissionCreateRoom.Name) {
+		if !zone.IsBuilder(&perms) {
 			c.Status(fiber.StatusForbidden)
 			return nil
 		}
@@ -1355,6 +1919,16 @@ func EditRoomSize(i *service.Interfaces) fiber.Handler {
 			return nil
 		}
 
+		ok, err := zone.CanBuild(qtx, &perms, pid, rm.ZID)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if !ok {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
 		// TODO: Add Conflict tests for edit room title, description and size
 		if rm.Size == in.Size {
 			c.Status(fiber.StatusConflict)
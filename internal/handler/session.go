@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/header"
+	"petrichormud.com/app/internal/layout"
+	"petrichormud.com/app/internal/player/session"
+	"petrichormud.com/app/internal/route"
+	"petrichormud.com/app/internal/service"
+	"petrichormud.com/app/internal/util"
+	"petrichormud.com/app/internal/view"
+)
+
+func RevokeSession(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		handle := c.Params("id")
+		if len(handle) == 0 {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		sess, err := i.Sessions.Get(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		// Logging out the current session here is the same as logging out
+		if handle == session.Handle(sess.ID()) {
+			if err := session.Forget(context.Background(), i.Redis, pid, sess.ID()); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+			if err := sess.Destroy(); err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+			c.Append("HX-Redirect", route.Logout)
+			return nil
+		}
+
+		ok, err := session.Revoke(context.Background(), i.Redis, i.Sessions.Storage, pid, handle)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if !ok {
+			c.Status(fiber.StatusNotFound)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
+
+func RevokeAllSessions(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		sess, err := i.Sessions.Get(c)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := session.RevokeAll(context.Background(), i.Redis, i.Sessions.Storage, pid, sess.ID()); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if err := session.Forget(context.Background(), i.Redis, pid, sess.ID()); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		if err := sess.Destroy(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append("HX-Redirect", route.Logout)
+		return nil
+	}
+}
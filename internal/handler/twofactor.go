@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/header"
+	"petrichormud.com/app/internal/layout"
+	"petrichormud.com/app/internal/partial"
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/player/twofactor"
+	"petrichormud.com/app/internal/route"
+	"petrichormud.com/app/internal/service"
+	"petrichormud.com/app/internal/util"
+	"petrichormud.com/app/internal/view"
+)
+
+func SetupTwoFactor(i *service.Interfaces) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		p, err := qtx.GetPlayer(context.Background(), pid)
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		secret, err := twofactor.Enroll(context.Background(), qtx, pid)
+		if err != nil {
+			if err == twofactor.ErrAlreadyEnabled {
+				c.Status(fiber.StatusConflict)
+				return nil
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		uri := twofactor.URI(p.Username, secret)
+		b := fiber.Map{
+			"Secret":              secret,
+			"URI":                 uri,
+			"EnableTwoFactorPath": route.ProfileTwoFactorEnable,
+		}
+		// The key can still be entered by hand, so a failed QR code doesn't stop setup
+		qr, err := twofactor.QRCode(uri)
+		if err != nil {
+			log.Printf("twofactor: error rendering QR code: %v", err)
+		} else {
+			b["QRCode"] = qr
+		}
+
+		return c.Render(partial.ProfileTwoFactorSetup, b, layout.None)
+	}
+}
+
+func EnableTwoFactor(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Code string `form:"code"`
+	}
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		codes, err := twofactor.Confirm(context.Background(), qtx, pid, in.Code, time.Now())
+		if err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			switch err {
+			case twofactor.ErrInvalidCode:
+				c.Append(header.HXAcceptable, "true")
+				c.Status(fiber.StatusUnauthorized)
+				return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInvalid, layout.None)
+			case twofactor.ErrNotEnrolled, twofactor.ErrAlreadyEnabled:
+				c.Status(fiber.StatusConflict)
+				return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+			default:
+				c.Status(fiber.StatusInternalServerError)
+				return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+
+		if err := twofactor.InvalidateEnabled(i.Redis, pid); err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+
+		return c.Render(partial.ProfileTwoFactorRecoveryCodes, fiber.Map{
+			"RecoveryCodes": codes,
+		}, layout.None)
+	}
+}
+
+func DisableTwoFactor(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Code string `form:"code"`
+	}
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		// Turning it off takes a code, so a session left open somewhere isn't enough to do it
+		if err := twofactor.Verify(context.Background(), qtx, pid, in.Code, time.Now()); err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			if err == twofactor.ErrInvalidCode {
+				c.Append(header.HXAcceptable, "true")
+				c.Status(fiber.StatusUnauthorized)
+				return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInvalid, layout.None)
+			}
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+
+		if err := twofactor.Disable(context.Background(), qtx, pid); err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+
+		if err := twofactor.InvalidateEnabled(i.Redis, pid); err != nil {
+			c.Append("HX-Retarget", "#profile-two-factor-notice")
+			c.Append("HX-Reswap", "outerHTML")
+			c.Status(fiber.StatusInternalServerError)
+			return c.Render(partial.NoticeSectionError, partial.BindProfileTwoFactorErrInternal, layout.None)
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
+
+func RequireTwoFactor(i *service.Interfaces) fiber.Handler {
+	type input struct {
+		Required bool `form:"required"`
+	}
+	return func(c *fiber.Ctx) error {
+		pid, err := util.GetPID(c)
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized)
+			return c.Render(view.Login, view.Bind(c), layout.Standalone)
+		}
+
+		perms, err := util.GetPermissions(c)
+		if err != nil {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+		if !perms.HasPermission(player.PermissionGrantAll.Name) {
+			c.Status(fiber.StatusForbidden)
+			return nil
+		}
+
+		in := new(input)
+		if err := c.BodyParser(in); err != nil {
+			c.Status(fiber.StatusBadRequest)
+			return nil
+		}
+
+		tx, err := i.Database.Begin()
+		if err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+		defer tx.Rollback()
+		qtx := i.Queries.WithTx(tx)
+
+		// Turning the requirement on without it set up would hold back the issuer's own permissions
+		if in.Required {
+			enabled, err := twofactor.Enabled(context.Background(), qtx, pid)
+			if err != nil {
+				c.Status(fiber.StatusInternalServerError)
+				return nil
+			}
+			if !enabled {
+				c.Status(fiber.StatusConflict)
+				return nil
+			}
+		}
+
+		if err := twofactor.SetRequired(context.Background(), qtx, in.Required); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		if err := twofactor.InvalidateRequired(i.Redis); err != nil {
+			c.Status(fiber.StatusInternalServerError)
+			return nil
+		}
+
+		c.Append(header.HXRefresh, header.True)
+		return nil
+	}
+}
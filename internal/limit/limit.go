@@ -0,0 +1,154 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const LimitTokenKey = "limit"
+
+const (
+	KindIP      string = "ip"
+	KindAccount string = "account"
+	// KindPlayer is for limits keyed by PID, so they can't collide with a username
+	KindPlayer string = "pid"
+)
+
+// Params describe a sliding window of failed attempts. Going over MaxAttempts
+// inside the Window locks the key out. Each lockout remembered inside Memory
+// doubles the next one, up to MaxLockout.
+type Params struct {
+	Name        string
+	MaxAttempts int64
+	Window      time.Duration
+	Lockout     time.Duration
+	MaxLockout  time.Duration
+	Memory      time.Duration
+}
+
+var Login Params = Params{
+	Name:        "login",
+	MaxAttempts: 5,
+	Window:      15 * time.Minute,
+	Lockout:     time.Minute,
+	MaxLockout:  time.Hour,
+	Memory:      24 * time.Hour,
+}
+
+var Recover Params = Params{
+	Name:        "recover",
+	MaxAttempts: 5,
+	Window:      time.Hour,
+	Lockout:     5 * time.Minute,
+	MaxLockout:  24 * time.Hour,
+	Memory:      24 * time.Hour,
+}
+
+// TwoFactor covers every check of a two-factor code, while logging in or turning it off
+var TwoFactor Params = Params{
+	Name:        "two-factor",
+	MaxAttempts: 5,
+	Window:      15 * time.Minute,
+	Lockout:     time.Minute,
+	MaxLockout:  time.Hour,
+	Memory:      24 * time.Hour,
+}
+
+// LockoutDuration is how long the nth lockout lasts
+func LockoutDuration(p Params, lockouts int64) time.Duration {
+	d := p.Lockout
+	for n := int64(1); n < lockouts; n++ {
+		d *= 2
+		if d >= p.MaxLockout {
+			return p.MaxLockout
+		}
+	}
+	if d > p.MaxLockout {
+		return p.MaxLockout
+	}
+	return d
+}
+
+// Key identifies what's being limited, i.e. an IP or the account being tried
+func Key(p Params, kind, value string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", LimitTokenKey, p.Name, kind, strings.ToLower(strings.TrimSpace(value)))
+}
+
+func AttemptsKey(key string) string {
+	return fmt.Sprintf("%s:attempts", key)
+}
+
+func LockKey(key string) string {
+	return fmt.Sprintf("%s:lock", key)
+}
+
+func LockoutsKey(key string) string {
+	return fmt.Sprintf("%s:lockouts", key)
+}
+
+// Locked returns the longest lockout left on any of the keys, or zero if none are locked
+func Locked(ctx context.Context, r *redis.Client, keys ...string) (time.Duration, error) {
+	var remaining time.Duration
+	for _, key := range keys {
+		ttl, err := r.PTTL(ctx, LockKey(key)).Result()
+		if err != nil {
+			return 0, err
+		}
+		if ttl > remaining {
+			remaining = ttl
+		}
+	}
+	return remaining, nil
+}
+
+// Fail records a failed attempt against the key. If that puts it over the limit,
+// the key is locked out and the length of the lockout is returned.
+func Fail(ctx context.Context, r *redis.Client, p Params, key string, now time.Time) (time.Duration, error) {
+	attempts := AttemptsKey(key)
+	pipe := r.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, attempts, "-inf", strconv.FormatInt(now.Add(-p.Window).UnixMilli(), 10))
+	pipe.ZAdd(ctx, attempts, redis.Z{
+		Score:  float64(now.UnixMilli()),
+		Member: strconv.FormatInt(now.UnixNano(), 10),
+	})
+	count := pipe.ZCard(ctx, attempts)
+	pipe.PExpire(ctx, attempts, p.Window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	if count.Val() < p.MaxAttempts {
+		return 0, nil
+	}
+
+	lockouts, err := r.Incr(ctx, LockoutsKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if err := r.PExpire(ctx, LockoutsKey(key), p.Memory).Err(); err != nil {
+		return 0, err
+	}
+
+	d := LockoutDuration(p, lockouts)
+	if err := r.Set(ctx, LockKey(key), lockouts, d).Err(); err != nil {
+		return 0, err
+	}
+	if err := r.Del(ctx, attempts).Err(); err != nil {
+		return 0, err
+	}
+	return d, nil
+}
+
+// Reset forgets the failed attempts against a key, but not its past lockouts
+func Reset(ctx context.Context, r *redis.Client, key string) error {
+	return r.Del(ctx, AttemptsKey(key)).Err()
+}
+
+// Clear forgets everything about a key, including any lockout in progress
+func Clear(ctx context.Context, r *redis.Client, key string) error {
+	return r.Del(ctx, AttemptsKey(key), LockKey(key), LockoutsKey(key)).Err()
+}
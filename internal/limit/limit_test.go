@@ -0,0 +1,28 @@
+package limit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	require.Equal(t, "limit:login:account:testify", Key(Login, KindAccount, " Testify "))
+	require.Equal(t, "limit:login:account:testify:lock", LockKey(Key(Login, KindAccount, "testify")))
+	require.Equal(t, "limit:two-factor:pid:69", Key(TwoFactor, KindPlayer, "69"))
+}
+
+func TestLockoutDurationDoubles(t *testing.T) {
+	require.Equal(t, Login.Lockout, LockoutDuration(Login, 0))
+	require.Equal(t, Login.Lockout, LockoutDuration(Login, 1))
+	require.Equal(t, 2*Login.Lockout, LockoutDuration(Login, 2))
+	require.Equal(t, 4*Login.Lockout, LockoutDuration(Login, 3))
+}
+
+func TestLockoutDurationCapped(t *testing.T) {
+	require.Equal(t, Login.MaxLockout, LockoutDuration(Login, 100))
+
+	p := Params{Lockout: 2 * time.Hour, MaxLockout: time.Hour}
+	require.Equal(t, time.Hour, LockoutDuration(p, 1))
+}
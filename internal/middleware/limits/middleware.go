@@ -0,0 +1,152 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/header"
+	"petrichormud.com/app/internal/layout"
+	"petrichormud.com/app/internal/limit"
+	"petrichormud.com/app/internal/partial"
+	"petrichormud.com/app/internal/player/twofactor"
+	"petrichormud.com/app/internal/service"
+)
+
+type Config struct {
+	Limit limit.Params
+	// Field is the form field naming the account being tried
+	Field string
+	// Local names the account from a request local instead, i.e. when it's been stashed in the session
+	Local string
+	// Kind is the kind of key the account is limited under. It's limit.KindAccount if left empty.
+	Kind string
+	// FailureStatus is the response status that counts as a failed attempt. Leave it
+	// at zero to count every attempt, i.e. for recovery, which never reports failure.
+	FailureStatus   int
+	NoticeSectionID string
+	NoticeTarget    string
+}
+
+var Login Config = Config{
+	Limit:           limit.Login,
+	Field:           "username",
+	FailureStatus:   fiber.StatusUnauthorized,
+	NoticeSectionID: "login-error",
+	NoticeTarget:    "#login-error",
+}
+
+var LoginTwoFactor Config = Config{
+	Limit:           limit.TwoFactor,
+	Local:           twofactor.PendingSessionKey,
+	Kind:            limit.KindPlayer,
+	FailureStatus:   fiber.StatusUnauthorized,
+	NoticeSectionID: "login-two-factor-error",
+	NoticeTarget:    "#login-two-factor-error",
+}
+
+var DisableTwoFactor Config = Config{
+	Limit:           limit.TwoFactor,
+	Local:           "pid",
+	Kind:            limit.KindPlayer,
+	FailureStatus:   fiber.StatusUnauthorized,
+	NoticeSectionID: "profile-two-factor-notice",
+	NoticeTarget:    "#profile-two-factor-notice",
+}
+
+var RecoverPassword Config = Config{
+	Limit:           limit.Recover,
+	Field:           "username",
+	NoticeSectionID: "recover-password-err",
+	NoticeTarget:    "#recover-password-err",
+}
+
+var RecoverUsername Config = Config{
+	Limit:           limit.Recover,
+	Field:           "email",
+	NoticeSectionID: "recover-username-error",
+	NoticeTarget:    "#recover-username-error",
+}
+
+// New limits failed attempts against a route, both per IP and per account
+func New(i *service.Interfaces, cfg Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		keys := []string{limit.Key(cfg.Limit, limit.KindIP, c.IP())}
+		account := accountName(c, cfg)
+		kind := accountKind(cfg)
+		if len(account) > 0 {
+			keys = append(keys, limit.Key(cfg.Limit, kind, account))
+		}
+
+		remaining, err := limit.Locked(context.Background(), i.Redis, keys...)
+		if err != nil {
+			// Failing open here keeps a Redis outage from locking everyone out
+			log.Printf("limit: error checking %s lockout: %v", cfg.Limit.Name, err)
+			return c.Next()
+		}
+		if remaining > 0 {
+			log.Printf("limit: refused locked out %s attempt from %s for %q", cfg.Limit.Name, c.IP(), account)
+			return tooManyAttempts(c, cfg, remaining)
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		status := c.Response().StatusCode()
+		if cfg.FailureStatus != 0 && status != cfg.FailureStatus {
+			if status == fiber.StatusOK && len(account) > 0 {
+				if err := limit.Reset(context.Background(), i.Redis, limit.Key(cfg.Limit, kind, account)); err != nil {
+					log.Printf("limit: error resetting %s attempts: %v", cfg.Limit.Name, err)
+				}
+			}
+			return nil
+		}
+
+		now := time.Now()
+		for _, key := range keys {
+			d, err := limit.Fail(context.Background(), i.Redis, cfg.Limit, key, now)
+			if err != nil {
+				log.Printf("limit: error recording %s attempt: %v", cfg.Limit.Name, err)
+				continue
+			}
+			if d > 0 {
+				log.Printf("limit: locked out %s for %s", key, d)
+			}
+		}
+		return nil
+	}
+}
+
+func accountName(c *fiber.Ctx, cfg Config) string {
+	if len(cfg.Field) > 0 {
+		return c.FormValue(cfg.Field)
+	}
+	if len(cfg.Local) > 0 {
+		if v := c.Locals(cfg.Local); v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+func accountKind(cfg Config) string {
+	if len(cfg.Kind) > 0 {
+		return cfg.Kind
+	}
+	return limit.KindAccount
+}
+
+func tooManyAttempts(c *fiber.Ctx, cfg Config, remaining time.Duration) error {
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(remaining.Seconds()))))
+	c.Append("HX-Retarget", cfg.NoticeTarget)
+	c.Append("HX-Reswap", "outerHTML")
+	c.Append(header.HXAcceptable, "true")
+	c.Status(fiber.StatusTooManyRequests)
+	return c.Render(partial.NoticeSectionTooManyAttempts, partial.BindTooManyAttempts(cfg.NoticeSectionID, remaining), layout.None)
+}
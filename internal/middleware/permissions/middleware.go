@@ -1,12 +1,12 @@
 package permissions
 
 import (
-	"context"
+	"log"
 
 	fiber "github.com/gofiber/fiber/v2"
 
-	"petrichormud.com/app/internal/player"
-	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/player/permission"
+	"petrichormud.com/app/internal/player/twofactor"
 	"petrichormud.com/app/internal/service"
 )
 
@@ -17,22 +17,25 @@ func New(i *service.Interfaces) fiber.Handler {
 			return c.Next()
 		}
 
-		// TODO: Cache this in Redis here and on login
-		// TODO: This will require an API for updating the value in Redis on change
-		ps, err := i.Queries.ListPlayerPermissions(context.Background(), pid.(int64))
+		perms, err := permission.Get(i, pid.(int64))
 		if err != nil {
 			// TODO: Split up the bind variables into different middleware
 			// That way the non-permissions required variables can be loaded here
 			// And we can return a generic 500 here by returning early
 			return c.Next()
 		}
-		if len(ps) == 0 {
-			perms := player.NewPermissions(pid.(int64), []query.PlayerPermission{})
-			c.Locals("perms", perms)
-			return c.Next()
+
+		perms, restricted, err := twofactor.Restrict(i, perms)
+		if err != nil {
+			// Holding back everything past the basics is the safe way to fail here
+			log.Printf("permissions: error checking two-factor requirement: %v", err)
+			perms = perms.Basic()
+			restricted = true
+		}
+		if restricted {
+			c.Locals("tfrestricted", true)
 		}
 
-		perms := player.NewPermissions(pid.(int64), ps)
 		c.Locals("perms", perms)
 		return c.Next()
 	}
@@ -1,9 +1,16 @@
 package session
 
 import (
+	"context"
+	"log"
+	"time"
+
 	fiber "github.com/gofiber/fiber/v2"
 
+	"petrichormud.com/app/internal/config"
 	"petrichormud.com/app/internal/constant"
+	playersession "petrichormud.com/app/internal/player/session"
+	"petrichormud.com/app/internal/player/twofactor"
 	"petrichormud.com/app/internal/service"
 )
 
@@ -19,6 +26,21 @@ func New(i *service.Interfaces) fiber.Handler {
 		pid := sess.Get("pid")
 		if pid != nil {
 			c.Locals("pid", pid)
+			if err := playersession.Seen(context.Background(), i.Redis, playersession.SeenParams{
+				SID:       sess.ID(),
+				IP:        c.IP(),
+				UserAgent: c.Get(fiber.HeaderUserAgent),
+				PID:       pid.(int64),
+				Now:       time.Now(),
+				TTL:       config.SessionExpiration,
+			}); err != nil {
+				log.Printf("session: error recording activity: %v", err)
+			}
+		}
+
+		tfpid := sess.Get(twofactor.PendingSessionKey)
+		if tfpid != nil {
+			c.Locals(twofactor.PendingSessionKey, tfpid)
 		}
 
 		theme := sess.Get("theme")
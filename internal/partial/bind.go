@@ -3,7 +3,9 @@ package partial
 import (
 	"fmt"
 	"html/template"
+	"math"
 	"strings"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
 	"petrichormud.com/app/internal/email"
@@ -18,6 +20,41 @@ var BindLoginErr = fiber.Map{
 	},
 }
 
+var BindLoginTwoFactorErr = fiber.Map{
+	"NoticeSectionID": "login-two-factor-error",
+	"SectionClass":    "pt-4",
+	"NoticeText": []string{
+		"The code you entered couldn't be verified.",
+		"Please try again with a fresh code, or one of your recovery codes.",
+	},
+}
+
+var BindProfileTwoFactorErrInvalid = fiber.Map{
+	"NoticeSectionID": "profile-two-factor-notice",
+	"SectionClass":    "pt-4",
+	"NoticeText": []string{
+		"That code couldn't be verified.",
+		"Please try again with a fresh code from your authenticator app.",
+	},
+}
+
+var BindProfileTwoFactorErrInternal = fiber.Map{
+	"NoticeSectionID": "profile-two-factor-notice",
+	"SectionClass":    "pt-4",
+	"NoticeText": []string{
+		"Something's gone terribly wrong.",
+	},
+	"RefreshButton": true,
+}
+
+var BindProfileTwoFactorRestricted = fiber.Map{
+	"NoticeIcon": true,
+	"NoticeText": []string{
+		"Staff are required to use two-factor authentication.",
+		"Your permissions are on hold until you set it up.",
+	},
+}
+
 var BindRegisterErrInternal = fiber.Map{
 	"NoticeSectionID": "register-err",
 	"SectionClass":    "pt-4",
@@ -343,3 +380,22 @@ func BindNoticeSection(p BindNoticeSectionParams) fiber.Map {
 		"Warn":            p.Warn,
 	}
 }
+
+func BindTooManyAttempts(id string, wait time.Duration) fiber.Map {
+	return fiber.Map{
+		"NoticeSectionID": id,
+		"SectionClass":    "pt-4",
+		"RetryAfter":      RetryAfterText(wait),
+	}
+}
+
+func RetryAfterText(wait time.Duration) string {
+	if wait > 2*time.Hour {
+		return fmt.Sprintf("%d hours", int(math.Ceil(wait.Hours())))
+	}
+	minutes := int(math.Ceil(wait.Minutes()))
+	if minutes <= 1 {
+		return "a minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
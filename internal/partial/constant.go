@@ -6,6 +6,12 @@ const (
 	ActorImageReservedErr string = "partial-actor-image-reserved-err"
 )
 
+const (
+	CharacterNameFree        string = "partial-character-name-free"
+	CharacterNameReserved    string = "partial-character-name-reserved"
+	CharacterNameReservedErr string = "partial-character-name-reserved-err"
+)
+
 const (
 	ActorImageEditShortDescription string = "partial-actor-image-edit-short-description"
 	ActorImageEditDescription      string = "partial-actor-image-edit-description"
@@ -19,10 +25,11 @@ const (
 const LoginErr string = "partial-login-err"
 
 const (
-	NoticeSectionError   string = "partial-notice-section-error"
-	NoticeSectionWarn    string = "partial-notice-section-warn"
-	NoticeSectionInfo    string = "partial-notice-section-info"
-	NoticeSectionSuccess string = "partial-notice-section-success"
+	NoticeSectionError           string = "partial-notice-section-error"
+	NoticeSectionWarn            string = "partial-notice-section-warn"
+	NoticeSectionInfo            string = "partial-notice-section-info"
+	NoticeSectionSuccess         string = "partial-notice-section-success"
+	NoticeSectionTooManyAttempts string = "partial-notice-section-too-many-attempts"
 )
 
 const PlayerPermissionsSearchResults string = "partial-player-permissions-search-results"
@@ -45,6 +52,11 @@ const (
 	ProfileEmailEditSuccess         string = "partial-profile-email-edit-success"
 )
 
+const (
+	ProfileTwoFactorSetup         string = "partial-profile-two-factor-setup"
+	ProfileTwoFactorRecoveryCodes string = "partial-profile-two-factor-recovery-codes"
+)
+
 const (
 	RecoverUsernameErrInternal string = "partial-recover-username-err-internal"
 	RecoverUsernameErrInvalid  string = "partial-recover-username-err-invalid"
@@ -100,6 +112,7 @@ const (
 	RequestOverviewActionReview     string = "partial-request-overview-action-review"
 	RequestOverviewActionReject     string = "partial-request-overview-action-reject"
 	RequestOverviewActionFulfill    string = "partial-request-overview-action-fulfill"
+	RequestOverviewActionDelete     string = "partial-request-overview-action-delete"
 )
 
 // TODO: Create a tool that generates these? Maybe part of the CLI?
@@ -124,4 +137,13 @@ const (
 	RequestFieldFormCharacterApplicationDescription      string = "partial-request-field-form-character-application-desc"
 	RequestFieldFormCharacterApplicationBackstory        string = "partial-request-field-form-character-application-backstory"
 	RequestFieldFormCharacterApplicationKeywords         string = "partial-request-field-form-character-application-keywords"
+	RequestFieldHelpRoomProposalTitle                    string = "partial-request-field-help-room-proposal-title"
+	RequestFieldHelpRoomProposalDescription              string = "partial-request-field-help-room-proposal-desc"
+	RequestFieldHelpRoomProposalSize                     string = "partial-request-field-help-room-proposal-size"
+	RequestFieldDataRoomProposalTitle                    string = "partial-request-field-data-room-proposal-title"
+	RequestFieldDataRoomProposalDescription              string = "partial-request-field-data-room-proposal-desc"
+	RequestFieldDataRoomProposalSize                     string = "partial-request-field-data-room-proposal-size"
+	RequestFieldFormRoomProposalTitle                    string = "partial-request-field-form-room-proposal-title"
+	RequestFieldFormRoomProposalDescription              string = "partial-request-field-form-room-proposal-desc"
+	RequestFieldFormRoomProposalSize                     string = "partial-request-field-form-room-proposal-size"
 )
@@ -16,39 +16,58 @@ var (
 	ErrIncompatibleVersion = errors.New("incompatible version of argon2")
 )
 
-type params struct {
-	memory      uint32
-	iterations  uint32
-	parallelism uint8
-	saltLength  uint32
-	keyLength   uint32
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+var DefaultParams Params = Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Target is what new hashes are made with. Stored hashes made with anything weaker get rehashed on login.
+var Target Params = DefaultParams
+
+// String is the parameter section of an encoded hash, i.e. "m=65536,t=3,p=2"
+func (p Params) String() string {
+	return fmt.Sprintf("m=%d,t=%d,p=%d", p.Memory, p.Iterations, p.Parallelism)
+}
+
+// Below reports whether any of these params are weaker than the target's
+func (p Params) Below(target Params) bool {
+	return p.Memory < target.Memory ||
+		p.Iterations < target.Iterations ||
+		p.Parallelism < target.Parallelism ||
+		p.SaltLength < target.SaltLength ||
+		p.KeyLength < target.KeyLength
 }
 
 func Hash(password string) (encodedHash string, err error) {
-	p := &params{
-		memory:      64 * 1024,
-		iterations:  3,
-		parallelism: 2,
-		saltLength:  16,
-		keyLength:   32,
-	}
+	return HashWithParams(password, Target)
+}
 
-	salt, err := GenerateRandomBytes(p.saltLength)
+func HashWithParams(password string, p Params) (encodedHash string, err error) {
+	salt, err := GenerateRandomBytes(p.SaltLength)
 	if err != nil {
 		return "", err
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, p.keyLength)
+	hash := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
 
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
 	b64Hash := base64.RawStdEncoding.EncodeToString(hash)
 
 	encodedHash = fmt.Sprintf(
-		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		"$argon2id$v=%d$%s$%s$%s",
 		argon2.Version,
-		p.memory,
-		p.iterations,
-		p.parallelism,
+		p,
 		b64Salt,
 		b64Hash,
 	)
@@ -65,10 +84,10 @@ func Verify(password, encodedHash string) (match bool, err error) {
 	otherHash := argon2.IDKey(
 		[]byte(password),
 		salt,
-		p.iterations,
-		p.memory,
-		p.parallelism,
-		p.keyLength,
+		p.Iterations,
+		p.Memory,
+		p.Parallelism,
+		p.KeyLength,
 	)
 
 	if subtle.ConstantTimeCompare(hash, otherHash) == 1 {
@@ -78,7 +97,16 @@ func Verify(password, encodedHash string) (match bool, err error) {
 	return false, nil
 }
 
-func DecodeHash(encodedHash string) (p *params, salt, hash []byte, err error) {
+// NeedsRehash reports whether a stored hash was made with params below the target
+func NeedsRehash(encodedHash string) (bool, error) {
+	p, _, _, err := DecodeHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+	return p.Below(Target), nil
+}
+
+func DecodeHash(encodedHash string) (p *Params, salt, hash []byte, err error) {
 	vals := strings.Split(encodedHash, "$")
 	if len(vals) != 6 {
 		return nil, nil, nil, ErrInvalidHash
@@ -93,8 +121,8 @@ func DecodeHash(encodedHash string) (p *params, salt, hash []byte, err error) {
 		return nil, nil, nil, ErrIncompatibleVersion
 	}
 
-	p = &params{}
-	_, err = fmt.Sscanf(vals[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism)
+	p = &Params{}
+	_, err = fmt.Sscanf(vals[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -103,13 +131,13 @@ func DecodeHash(encodedHash string) (p *params, salt, hash []byte, err error) {
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	p.saltLength = uint32(len(salt))
+	p.SaltLength = uint32(len(salt))
 
 	hash, err = base64.RawStdEncoding.Strict().DecodeString(vals[5])
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	p.keyLength = uint32(len(hash))
+	p.KeyLength = uint32(len(hash))
 
 	return p, salt, hash, nil
 }
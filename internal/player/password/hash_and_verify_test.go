@@ -21,3 +21,42 @@ func TestHashAndVerify(t *testing.T) {
 		t.Errorf("Password verification returned false")
 	}
 }
+
+func TestNeedsRehash(t *testing.T) {
+	weak := DefaultParams
+	weak.Memory = 16 * 1024
+	weak.Iterations = 1
+
+	hash, err := HashWithParams("test", weak)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verified, err := Verify("test", hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified {
+		t.Errorf("Password verification with weaker params returned false")
+	}
+
+	rehash, err := NeedsRehash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rehash {
+		t.Errorf("Hash below the target params wasn't flagged for rehash")
+	}
+
+	hash, err = Hash("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rehash, err = NeedsRehash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rehash {
+		t.Errorf("Hash at the target params was flagged for rehash")
+	}
+}
@@ -0,0 +1,36 @@
+package password
+
+import "sort"
+
+// ParamsCount is how many hashes were made with one set of params
+type ParamsCount struct {
+	Params Params
+	Count  int
+}
+
+// CountParams tallies hashes by the params they were made with, most common first.
+// It also returns how many couldn't be decoded at all.
+func CountParams(hashes []string) ([]ParamsCount, int) {
+	counts := map[Params]int{}
+	invalid := 0
+	for _, hash := range hashes {
+		p, _, _, err := DecodeHash(hash)
+		if err != nil {
+			invalid++
+			continue
+		}
+		counts[*p]++
+	}
+
+	result := []ParamsCount{}
+	for p, count := range counts {
+		result = append(result, ParamsCount{Params: p, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Params.String() < result[j].Params.String()
+	})
+	return result, invalid
+}
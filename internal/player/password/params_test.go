@@ -0,0 +1,34 @@
+package password
+
+import (
+	"testing"
+)
+
+func TestCountParams(t *testing.T) {
+	weak := DefaultParams
+	weak.Memory = 16 * 1024
+	weak.Iterations = 1
+
+	hashes := []string{"not-a-hash"}
+	for _, p := range []Params{weak, weak, DefaultParams} {
+		hash, err := HashWithParams("test", p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	counts, invalid := CountParams(hashes)
+	if invalid != 1 {
+		t.Errorf("Expected 1 invalid hash, got %d", invalid)
+	}
+	if len(counts) != 2 {
+		t.Fatalf("Expected 2 param sets, got %d", len(counts))
+	}
+	if counts[0].Params != weak || counts[0].Count != 2 {
+		t.Errorf("Expected the weaker params to be counted twice, got %v", counts[0])
+	}
+	if counts[1].Params != DefaultParams || counts[1].Count != 1 {
+		t.Errorf("Expected the default params to be counted once, got %v", counts[1])
+	}
+}
@@ -27,6 +27,18 @@ var PermissionReviewCharacterApplications Permission = Permission{
 	About: "Enable this player to review Character Applications.",
 }
 
+var PermissionReviewRoomProposals Permission = Permission{
+	Name:  "review-room-proposals",
+	Title: "Review Room Proposals",
+	About: "Enable this player to review and build proposed Rooms.",
+}
+
+var PermissionAssignRequestReviewers Permission = Permission{
+	Name:  "assign-request-reviewers",
+	Title: "Assign Request Reviewers",
+	About: "Assign requests to a reviewer, overriding any existing claim.",
+}
+
 var PermissionViewAllRooms Permission = Permission{
 	Name:  "view-all-rooms",
 	Title: "View All Rooms",
@@ -39,6 +51,12 @@ var PermissionCreateRoom Permission = Permission{
 	About: "Create a new room, but not connect it to the grid.",
 }
 
+var PermissionBuildZoneRooms Permission = Permission{
+	Name:  "build-zone-rooms",
+	Title: "Build Zone Rooms",
+	About: "Create and edit rooms, but only in the zones this player owns or builds in.",
+}
+
 var PermissionViewAllActorImages Permission = Permission{
 	Name:  "view-all-actor-images",
 	Title: "View All Actor Images",
@@ -59,8 +77,11 @@ var AllPermissions []Permission = []Permission{
 	PermissionGrantAll,
 	PermissionRevokeAll,
 	PermissionReviewCharacterApplications,
+	PermissionReviewRoomProposals,
+	PermissionAssignRequestReviewers,
 	PermissionViewAllRooms,
 	PermissionCreateRoom,
+	PermissionBuildZoneRooms,
 	PermissionViewAllActorImages,
 	PermissionCreateActorImage,
 }
@@ -70,6 +91,12 @@ var RootPermissions []Permission = []Permission{
 	PermissionRevokeAll,
 }
 
+// BasicPermissions only let a player look around, so they're kept when the rest are held back
+var BasicPermissions []Permission = []Permission{
+	PermissionViewAllRooms,
+	PermissionViewAllActorImages,
+}
+
 func permissionsByName(permissions []Permission) map[string]Permission {
 	permissionsbyname := make(map[string]Permission)
 	for _, permission := range permissions {
@@ -79,13 +106,18 @@ func permissionsByName(permissions []Permission) map[string]Permission {
 }
 
 var (
-	AllPermissionsByName  = permissionsByName(AllPermissions)
-	RootPermissionsByName = permissionsByName(RootPermissions)
+	AllPermissionsByName   = permissionsByName(AllPermissions)
+	RootPermissionsByName  = permissionsByName(RootPermissions)
+	BasicPermissionsByName = permissionsByName(BasicPermissions)
 )
 
+// Permissions holds every permission a player has, whether granted directly or
+// through one of their roles
 type Permissions struct {
 	Permissions     map[string]bool
+	Roles           map[string]bool
 	PermissionsList []string
+	RolesList       []string
 	PID             int64
 }
 
@@ -108,6 +140,62 @@ func NewPermissions(pid int64, perms []query.PlayerPermission) Permissions {
 		PID:             pid,
 		PermissionsList: list,
 		Permissions:     permissionsmap,
+		RolesList:       []string{},
+		Roles:           map[string]bool{},
+	}
+}
+
+// NewPermissionsWithRoles builds a player's permissions and adds in the bundle from each of their roles
+func NewPermissionsWithRoles(pid int64, perms []query.PlayerPermission, roles []query.PlayerRole) Permissions {
+	permissions := NewPermissions(pid, perms)
+	for _, r := range roles {
+		role, ok := AllRolesByName[r.Name]
+		if !ok {
+			continue
+		}
+		if permissions.Roles[role.Name] {
+			continue
+		}
+		permissions.Roles[role.Name] = true
+		permissions.RolesList = append(permissions.RolesList, role.Name)
+		for _, name := range role.Permissions {
+			if permissions.Permissions[name] {
+				continue
+			}
+			permissions.Permissions[name] = true
+			permissions.PermissionsList = append(permissions.PermissionsList, name)
+		}
+	}
+	return permissions
+}
+
+// IsBasic reports whether every permission a player has is a basic one
+func (p *Permissions) IsBasic() bool {
+	for _, name := range p.PermissionsList {
+		if _, ok := BasicPermissionsByName[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Basic returns a copy of the player's permissions with only the basic ones, and no roles
+func (p *Permissions) Basic() Permissions {
+	list := []string{}
+	permissionsmap := map[string]bool{}
+	for _, name := range p.PermissionsList {
+		if _, ok := BasicPermissionsByName[name]; !ok {
+			continue
+		}
+		list = append(list, name)
+		permissionsmap[name] = true
+	}
+	return Permissions{
+		PID:             p.PID,
+		PermissionsList: list,
+		Permissions:     permissionsmap,
+		RolesList:       []string{},
+		Roles:           map[string]bool{},
 	}
 }
 
@@ -136,7 +224,37 @@ func (p *Permissions) HasAllPermissionsInSet(set []string) bool {
 	return true
 }
 
-// TODO: This is to enable adding sub-permissions to grant individual or groups of permissions
+func (p *Permissions) HasRole(name string) bool {
+	_, ok := p.Roles[name]
+	return ok
+}
+
+// RolesWithPermission returns the player's roles that bundle the permission
+func (p *Permissions) RolesWithPermission(name string) []Role {
+	roles := []Role{}
+	for _, rname := range p.RolesList {
+		role := AllRolesByName[rname]
+		if role.HasPermission(name) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// CanManagePermissions reports whether the player can grant or revoke anything at all
+func (p *Permissions) CanManagePermissions() bool {
+	if p.HasPermission(PermissionGrantAll.Name) {
+		return true
+	}
+	for _, rname := range p.RolesList {
+		role := AllRolesByName[rname]
+		if role.Delegates {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Permissions) CanGrantPermission(name string) bool {
 	if !IsValidPermissionName(name) {
 		return false
@@ -147,11 +265,12 @@ func (p *Permissions) CanGrantPermission(name string) bool {
 		return false
 	}
 
-	_, ok = p.Permissions[PermissionGrantAll.Name]
-	return ok
+	if p.HasPermission(PermissionGrantAll.Name) {
+		return true
+	}
+	return p.delegates(name)
 }
 
-// TODO: This is to enable adding sub-permissions to grant individual or groups of permissions
 func (p *Permissions) CanRevokePermission(name string) bool {
 	if !IsValidPermissionName(name) {
 		return false
@@ -162,8 +281,48 @@ func (p *Permissions) CanRevokePermission(name string) bool {
 		return false
 	}
 
-	_, ok = p.Permissions[PermissionGrantAll.Name]
-	return ok
+	if p.HasPermission(PermissionGrantAll.Name) {
+		return true
+	}
+	return p.delegates(name)
+}
+
+// CanGrantRole reports whether the player can grant a role. Outside of grant-all,
+// this takes a delegating role whose bundle covers the other role's bundle.
+// Delegating roles themselves can only come from grant-all.
+func (p *Permissions) CanGrantRole(name string) bool {
+	role, ok := AllRolesByName[name]
+	if !ok {
+		return false
+	}
+
+	if p.HasPermission(PermissionGrantAll.Name) {
+		return true
+	}
+
+	if role.Delegates {
+		return false
+	}
+	for _, perm := range role.Permissions {
+		if !p.delegates(perm) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Permissions) CanRevokeRole(name string) bool {
+	return p.CanGrantRole(name)
+}
+
+func (p *Permissions) delegates(name string) bool {
+	for _, rname := range p.RolesList {
+		role := AllRolesByName[rname]
+		if role.Delegates && role.HasPermission(name) {
+			return true
+		}
+	}
+	return false
 }
 
 func IsValidPermissionName(name string) bool {
@@ -0,0 +1,148 @@
+package permission
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	redis "github.com/redis/go-redis/v9"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/service"
+)
+
+const (
+	PermissionsTokenKey = "perms"
+	GenerationTokenKey  = "permsgen"
+)
+
+const RolesSeparator = ";"
+
+const ThirtyTwoHoursInNanoseconds = 32 * 60 * 60 * 1000 * 1000 * 1000
+
+// Get returns a player's permissions from the cache, loading and caching them on a miss.
+// A hit doesn't extend the entry's lifetime, so a stale entry can't outlive its TTL.
+func Get(i *service.Interfaces, pid int64) (player.Permissions, error) {
+	cached, err := i.Redis.Get(context.Background(), Key(pid)).Result()
+	if err == nil {
+		ps, rs := Decode(cached)
+		return player.NewPermissionsWithRoles(pid, ps, rs), nil
+	}
+	if err != redis.Nil {
+		return player.Permissions{}, err
+	}
+
+	// Read the generation before the database, so an Invalidate that lands after the read shows up as a change
+	gen, err := generation(i.Redis, pid)
+	if err != nil {
+		return player.Permissions{}, err
+	}
+
+	ps, err := i.Queries.ListPlayerPermissions(context.Background(), pid)
+	if err != nil {
+		return player.Permissions{}, err
+	}
+	rs, err := i.Queries.ListPlayerRoles(context.Background(), pid)
+	if err != nil {
+		return player.Permissions{}, err
+	}
+	names := []string{}
+	for _, p := range ps {
+		names = append(names, p.Name)
+	}
+	roles := []string{}
+	for _, r := range rs {
+		roles = append(roles, r.Name)
+	}
+	if err := fill(i.Redis, pid, gen, Encode(names, roles)); err != nil {
+		return player.Permissions{}, err
+	}
+	return player.NewPermissionsWithRoles(pid, ps, rs), nil
+}
+
+// fill caches a value loaded from the database, unless the player's permissions were
+// invalidated since the load started. Those are left for the next request to load.
+func fill(r *redis.Client, pid int64, gen, value string) error {
+	genkey := GenerationKey(pid)
+	err := r.Watch(context.Background(), func(tx *redis.Tx) error {
+		current, err := tx.Get(context.Background(), genkey).Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if current != gen {
+			return nil
+		}
+		_, err = tx.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			pipe.Set(context.Background(), Key(pid), value, ThirtyTwoHoursInNanoseconds)
+			return nil
+		})
+		return err
+	}, genkey)
+	if err == redis.TxFailedErr {
+		return nil
+	}
+	return err
+}
+
+func generation(r *redis.Client, pid int64) (string, error) {
+	gen, err := r.Get(context.Background(), GenerationKey(pid)).Result()
+	if err != nil && err != redis.Nil {
+		return "", err
+	}
+	return gen, nil
+}
+
+// Cache stores a player's directly granted permissions and their roles. Roles are
+// expanded on the way out, so changes to a role's bundle apply without invalidating.
+func Cache(r *redis.Client, pid int64, names, roles []string) error {
+	err := r.Set(context.Background(), Key(pid), Encode(names, roles), ThirtyTwoHoursInNanoseconds).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Invalidate drops a player's cached permissions. Call it after the change to their
+// permissions has been committed, so the next load can't pick up the old set. Bumping
+// the generation first keeps a load that's already in flight from caching the old set.
+func Invalidate(r *redis.Client, pid int64) error {
+	_, err := r.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.Incr(context.Background(), GenerationKey(pid))
+		pipe.Expire(context.Background(), GenerationKey(pid), ThirtyTwoHoursInNanoseconds)
+		pipe.Del(context.Background(), Key(pid))
+		return nil
+	})
+	return err
+}
+
+// Encode packs permission and role names into a single cached value. An empty value is
+// still a hit, so players without permissions don't fall through to the database.
+func Encode(names, roles []string) string {
+	return strings.Join(names, ",") + RolesSeparator + strings.Join(roles, ",")
+}
+
+func Decode(cached string) ([]query.PlayerPermission, []query.PlayerRole) {
+	perms := []query.PlayerPermission{}
+	roles := []query.PlayerRole{}
+	cachedperms, cachedroles, _ := strings.Cut(cached, RolesSeparator)
+	if len(cachedperms) > 0 {
+		for _, name := range strings.Split(cachedperms, ",") {
+			perms = append(perms, query.PlayerPermission{Name: name})
+		}
+	}
+	if len(cachedroles) > 0 {
+		for _, name := range strings.Split(cachedroles, ",") {
+			roles = append(roles, query.PlayerRole{Name: name})
+		}
+	}
+	return perms, roles
+}
+
+func Key(pid int64) string {
+	return fmt.Sprintf("%s:%d", PermissionsTokenKey, pid)
+}
+
+func GenerationKey(pid int64) string {
+	return fmt.Sprintf("%s:%d", GenerationTokenKey, pid)
+}
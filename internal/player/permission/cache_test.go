@@ -0,0 +1,93 @@
+package permission
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	redis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/service"
+)
+
+func TestKey(t *testing.T) {
+	require.Equal(t, "perms:69", Key(69))
+}
+
+func TestEncodeDecode(t *testing.T) {
+	names := []string{player.PermissionGrantAll.Name, player.PermissionCreateRoom.Name}
+	ps, rs := Decode(Encode(names, []string{}))
+	perms := player.NewPermissionsWithRoles(69, ps, rs)
+	require.Equal(t, names, perms.PermissionsList)
+	require.Empty(t, perms.RolesList)
+}
+
+func TestEncodeDecodeRoles(t *testing.T) {
+	ps, rs := Decode(Encode([]string{}, []string{player.RoleReviewer.Name}))
+	perms := player.NewPermissionsWithRoles(69, ps, rs)
+	require.True(t, perms.HasRole(player.RoleReviewer.Name))
+	require.True(t, perms.HasPermission(player.PermissionReviewRoomProposals.Name))
+}
+
+func TestDecodeEmpty(t *testing.T) {
+	ps, rs := Decode(Encode([]string{}, []string{}))
+	require.Empty(t, ps)
+	require.Empty(t, rs)
+}
+
+func TestCache(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	var pid int64 = 69
+
+	if err := Cache(i.Redis, pid, []string{player.PermissionCreateRoom.Name}, []string{}); err != nil {
+		t.Fatal(err)
+	}
+
+	perms, err := Get(&i, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, perms.HasPermission(player.PermissionCreateRoom.Name))
+
+	if err := Invalidate(i.Redis, pid); err != nil {
+		t.Fatal(err)
+	}
+
+	perms, err = Get(&i, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, perms.HasPermission(player.PermissionCreateRoom.Name))
+}
+
+func TestGenerationKey(t *testing.T) {
+	require.Equal(t, "permsgen:69", GenerationKey(69))
+}
+
+func TestInvalidateDuringFill(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	var pid int64 = 69
+
+	gen, err := generation(i.Redis, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A revoke lands between the load and the fill
+	if err := Invalidate(i.Redis, pid); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fill(i.Redis, pid, gen, Encode([]string{player.PermissionCreateRoom.Name}, []string{})); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = i.Redis.Get(context.Background(), Key(pid)).Result()
+	require.Equal(t, redis.Nil, err)
+}
@@ -0,0 +1,243 @@
+package permission
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+const (
+	HistoryKindPermission string = "permission"
+	HistoryKindRole       string = "role"
+)
+
+const (
+	HistoryActionGranted string = "granted"
+	HistoryActionRevoked string = "revoked"
+)
+
+const (
+	HistoryFormatCSV  string = "csv"
+	HistoryFormatJSON string = "json"
+)
+
+const (
+	DefaultHistoryLimit   int32 = 100
+	ExportHistoryPageSize int32 = 1000
+)
+
+const (
+	HistoryDateLayout    string = "January 2, 2006 15:04"
+	HistoryUnknownPlayer string = "Unknown"
+)
+
+// HistoryEntry is one grant or revocation of a permission or role
+type HistoryEntry struct {
+	CreatedAt time.Time `json:"created_at"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Action    string    `json:"action"`
+	Issuer    string    `json:"issuer"`
+	Target    string    `json:"target"`
+	IPID      int64     `json:"ipid"`
+	PID       int64     `json:"pid"`
+}
+
+// HistoryFilter narrows the history down. Any field left invalid matches everything.
+type HistoryFilter struct {
+	IPID  sql.NullInt64
+	PID   sql.NullInt64
+	Name  sql.NullString
+	Limit int32
+}
+
+// History loads permission and role changes matching the filter, newest first
+func History(ctx context.Context, q *query.Queries, f HistoryFilter) ([]HistoryEntry, error) {
+	perms, err := listPermissionHistory(ctx, q, f, 0)
+	if err != nil {
+		return []HistoryEntry{}, err
+	}
+	roles, err := listRoleHistory(ctx, q, f, 0)
+	if err != nil {
+		return []HistoryEntry{}, err
+	}
+	return NewHistory(perms, roles, int(f.Limit)), nil
+}
+
+// ExportHistory loads every permission and role change matching the filter, newest first.
+// The filter's limit is ignored; changes are read a page at a time until they run out.
+func ExportHistory(ctx context.Context, q *query.Queries, f HistoryFilter) ([]HistoryEntry, error) {
+	f.Limit = ExportHistoryPageSize
+
+	perms := []query.ListPlayerPermissionChangeHistoryRow{}
+	for offset := int32(0); ; offset += f.Limit {
+		rows, err := listPermissionHistory(ctx, q, f, offset)
+		if err != nil {
+			return []HistoryEntry{}, err
+		}
+		perms = append(perms, rows...)
+		if len(rows) < int(f.Limit) {
+			break
+		}
+	}
+
+	roles := []query.ListPlayerRoleChangeHistoryRow{}
+	for offset := int32(0); ; offset += f.Limit {
+		rows, err := listRoleHistory(ctx, q, f, offset)
+		if err != nil {
+			return []HistoryEntry{}, err
+		}
+		roles = append(roles, rows...)
+		if len(rows) < int(f.Limit) {
+			break
+		}
+	}
+
+	return NewHistory(perms, roles, 0), nil
+}
+
+func listPermissionHistory(ctx context.Context, q *query.Queries, f HistoryFilter, offset int32) ([]query.ListPlayerPermissionChangeHistoryRow, error) {
+	if f.Name.Valid && !player.IsValidPermissionName(f.Name.String) {
+		return []query.ListPlayerPermissionChangeHistoryRow{}, nil
+	}
+	return q.ListPlayerPermissionChangeHistory(ctx, query.ListPlayerPermissionChangeHistoryParams{
+		IPID:   f.IPID,
+		PID:    f.PID,
+		Name:   f.Name,
+		Limit:  f.Limit,
+		Offset: offset,
+	})
+}
+
+func listRoleHistory(ctx context.Context, q *query.Queries, f HistoryFilter, offset int32) ([]query.ListPlayerRoleChangeHistoryRow, error) {
+	if f.Name.Valid && !player.IsValidRoleName(f.Name.String) {
+		return []query.ListPlayerRoleChangeHistoryRow{}, nil
+	}
+	return q.ListPlayerRoleChangeHistory(ctx, query.ListPlayerRoleChangeHistoryParams{
+		IPID:   f.IPID,
+		PID:    f.PID,
+		Name:   f.Name,
+		Limit:  f.Limit,
+		Offset: offset,
+	})
+}
+
+// NewHistory merges permission and role changes into a single list, newest first
+func NewHistory(perms []query.ListPlayerPermissionChangeHistoryRow, roles []query.ListPlayerRoleChangeHistoryRow, limit int) []HistoryEntry {
+	entries := []HistoryEntry{}
+	for _, row := range perms {
+		h := row.PlayerPermissionChangeHistory
+		entries = append(entries, HistoryEntry{
+			CreatedAt: h.CreatedAt,
+			Kind:      HistoryKindPermission,
+			Name:      h.Name,
+			Action:    historyAction(h.Revoked),
+			Issuer:    historyUsername(row.Issuer),
+			Target:    historyUsername(row.Target),
+			IPID:      h.IPID,
+			PID:       h.PID,
+		})
+	}
+	for _, row := range roles {
+		h := row.PlayerRoleChangeHistory
+		entries = append(entries, HistoryEntry{
+			CreatedAt: h.CreatedAt,
+			Kind:      HistoryKindRole,
+			Name:      h.Name,
+			Action:    historyAction(h.Revoked),
+			Issuer:    historyUsername(row.Issuer),
+			Target:    historyUsername(row.Target),
+			IPID:      h.IPID,
+			PID:       h.PID,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+func historyAction(revoked bool) string {
+	if revoked {
+		return HistoryActionRevoked
+	}
+	return HistoryActionGranted
+}
+
+func historyUsername(u sql.NullString) string {
+	if !u.Valid {
+		return HistoryUnknownPlayer
+	}
+	return u.String
+}
+
+// Title returns the display name for the permission or role the entry is about
+func (e *HistoryEntry) Title() string {
+	if e.Kind == HistoryKindRole {
+		role, ok := player.AllRolesByName[e.Name]
+		if ok {
+			return role.Title
+		}
+		return e.Name
+	}
+	perm, ok := player.AllPermissionsByName[e.Name]
+	if ok {
+		return perm.Title
+	}
+	return e.Name
+}
+
+func BindHistory(entries []HistoryEntry) []fiber.Map {
+	b := []fiber.Map{}
+	for _, entry := range entries {
+		b = append(b, fiber.Map{
+			"Date":    entry.CreatedAt.Format(HistoryDateLayout),
+			"Kind":    entry.Kind,
+			"Name":    entry.Name,
+			"Title":   entry.Title(),
+			"Revoked": entry.Action == HistoryActionRevoked,
+			"Issuer":  entry.Issuer,
+			"Target":  entry.Target,
+		})
+	}
+	return b
+}
+
+var historyCSVHeader = []string{"created_at", "kind", "name", "action", "issuer", "target", "ipid", "pid"}
+
+func WriteHistoryCSV(w io.Writer, entries []HistoryEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(historyCSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		record := []string{
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			entry.Kind,
+			entry.Name,
+			entry.Action,
+			entry.Issuer,
+			entry.Target,
+			strconv.FormatInt(entry.IPID, 10),
+			strconv.FormatInt(entry.PID, 10),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
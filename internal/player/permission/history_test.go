@@ -0,0 +1,85 @@
+package permission
+
+import (
+	"bytes"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+func TestNewHistoryMergesNewestFirst(t *testing.T) {
+	now := time.Now()
+	perms := []query.ListPlayerPermissionChangeHistoryRow{
+		{
+			PlayerPermissionChangeHistory: query.PlayerPermissionChangeHistory{
+				CreatedAt: now.Add(-2 * time.Hour),
+				Name:      player.PermissionCreateRoom.Name,
+				IPID:      1,
+				PID:       2,
+			},
+			Issuer: sql.NullString{String: "issuer", Valid: true},
+			Target: sql.NullString{String: "target", Valid: true},
+		},
+		{
+			PlayerPermissionChangeHistory: query.PlayerPermissionChangeHistory{
+				CreatedAt: now,
+				Name:      player.PermissionCreateRoom.Name,
+				IPID:      1,
+				PID:       2,
+				Revoked:   true,
+			},
+		},
+	}
+	roles := []query.ListPlayerRoleChangeHistoryRow{
+		{
+			PlayerRoleChangeHistory: query.PlayerRoleChangeHistory{
+				CreatedAt: now.Add(-time.Hour),
+				Name:      player.RoleBuilder.Name,
+				IPID:      1,
+				PID:       2,
+			},
+		},
+	}
+
+	entries := NewHistory(perms, roles, 0)
+	require.Len(t, entries, 3)
+	require.Equal(t, HistoryActionRevoked, entries[0].Action)
+	require.Equal(t, HistoryUnknownPlayer, entries[0].Issuer)
+	require.Equal(t, HistoryKindRole, entries[1].Kind)
+	require.Equal(t, player.RoleBuilder.Title, entries[1].Title())
+	require.Equal(t, "issuer", entries[2].Issuer)
+	require.Equal(t, HistoryActionGranted, entries[2].Action)
+
+	require.Len(t, NewHistory(perms, roles, 2), 2)
+}
+
+func TestWriteHistoryCSV(t *testing.T) {
+	entries := []HistoryEntry{
+		{
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Kind:      HistoryKindPermission,
+			Name:      player.PermissionGrantAll.Name,
+			Action:    HistoryActionGranted,
+			Issuer:    "issuer",
+			Target:    "target",
+			IPID:      1,
+			PID:       2,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteHistoryCSV(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, "created_at,kind,name,action,issuer,target,ipid,pid", lines[0])
+	require.Equal(t, "2024-01-02T03:04:05Z,permission,grant-all,granted,issuer,target,1,2", lines[1])
+}
@@ -32,3 +32,81 @@ func NewPermissionsBuildsCorrectPermissions(t *testing.T) {
 	require.True(t, permissions.HasPermission(PermissionGrantAll.Name))
 	require.False(t, permissions.HasPermission(PermissionRevokeAll.Name))
 }
+
+func TestAllHardCodedRolesBundleValidPermissions(t *testing.T) {
+	for _, role := range AllRoles {
+		require.True(t, IsValidRoleName(role.Name))
+		for _, name := range role.Permissions {
+			require.True(t, IsValidPermissionName(name))
+			_, root := RootPermissionsByName[name]
+			require.False(t, root)
+		}
+	}
+}
+
+func TestNewPermissionsWithRolesAddsBundle(t *testing.T) {
+	pid := int64(1)
+	permissions := NewPermissionsWithRoles(pid, []query.PlayerPermission{
+		{PID: pid, Name: PermissionCreateRoom.Name},
+	}, []query.PlayerRole{
+		{PID: pid, Name: RoleBuilder.Name},
+		{PID: pid, Name: "not-a-role"},
+	})
+
+	require.True(t, permissions.HasRole(RoleBuilder.Name))
+	require.False(t, permissions.HasRole("not-a-role"))
+	require.True(t, permissions.HasPermission(PermissionViewAllRooms.Name))
+	require.False(t, permissions.HasPermission(PermissionReviewRoomProposals.Name))
+	require.Len(t, permissions.PermissionsList, len(RoleBuilder.Permissions))
+	require.Len(t, permissions.RolesWithPermission(PermissionCreateRoom.Name), 1)
+}
+
+func TestCanGrantPermissionWithDelegatingRole(t *testing.T) {
+	pid := int64(1)
+	admin := NewPermissionsWithRoles(pid, []query.PlayerPermission{}, []query.PlayerRole{
+		{PID: pid, Name: RoleAdmin.Name},
+	})
+	require.True(t, admin.CanManagePermissions())
+	require.True(t, admin.CanGrantPermission(PermissionCreateRoom.Name))
+	require.True(t, admin.CanRevokePermission(PermissionCreateRoom.Name))
+	require.False(t, admin.CanGrantPermission(PermissionGrantAll.Name))
+
+	builder := NewPermissionsWithRoles(pid, []query.PlayerPermission{}, []query.PlayerRole{
+		{PID: pid, Name: RoleBuilder.Name},
+	})
+	require.False(t, builder.CanManagePermissions())
+	require.False(t, builder.CanGrantPermission(PermissionCreateRoom.Name))
+}
+
+func TestCanGrantRole(t *testing.T) {
+	pid := int64(1)
+	admin := NewPermissionsWithRoles(pid, []query.PlayerPermission{}, []query.PlayerRole{
+		{PID: pid, Name: RoleAdmin.Name},
+	})
+	require.True(t, admin.CanGrantRole(RoleBuilder.Name))
+	require.True(t, admin.CanGrantRole(RoleReviewer.Name))
+	require.False(t, admin.CanGrantRole(RoleAdmin.Name))
+
+	root := NewPermissions(pid, []query.PlayerPermission{
+		{PID: pid, Name: PermissionGrantAll.Name},
+	})
+	require.True(t, root.CanGrantRole(RoleAdmin.Name))
+	require.False(t, root.CanGrantRole("not-a-role"))
+}
+
+func TestBasicHoldsBackStaffPermissions(t *testing.T) {
+	pid := int64(1)
+	permissions := NewPermissionsWithRoles(pid, []query.PlayerPermission{
+		{PID: pid, Name: PermissionGrantAll.Name},
+	}, []query.PlayerRole{
+		{PID: pid, Name: RoleBuilder.Name},
+	})
+	require.False(t, permissions.IsBasic())
+
+	basic := permissions.Basic()
+	require.True(t, basic.IsBasic())
+	require.True(t, basic.HasPermission(PermissionViewAllRooms.Name))
+	require.False(t, basic.HasPermission(PermissionGrantAll.Name))
+	require.False(t, basic.HasPermission(PermissionCreateRoom.Name))
+	require.False(t, basic.HasRole(RoleBuilder.Name))
+}
@@ -0,0 +1,81 @@
+package player
+
+// A Role is a named bundle of permissions. Roles are granted to players in
+// the same way as individual permissions.
+type Role struct {
+	Name        string
+	Title       string
+	About       string
+	Permissions []string
+	// Delegates lets a player with this role grant and revoke the permissions
+	// in its bundle, along with any role whose bundle fits inside it.
+	Delegates bool
+}
+
+var RoleBuilder Role = Role{
+	Name:  "builder",
+	Title: "Builder",
+	About: "View and create rooms and actor images.",
+	Permissions: []string{
+		PermissionViewAllRooms.Name,
+		PermissionCreateRoom.Name,
+		PermissionViewAllActorImages.Name,
+		PermissionCreateActorImage.Name,
+	},
+}
+
+var RoleReviewer Role = Role{
+	Name:  "reviewer",
+	Title: "Reviewer",
+	About: "Review Character Applications and Room Proposals.",
+	Permissions: []string{
+		PermissionReviewCharacterApplications.Name,
+		PermissionReviewRoomProposals.Name,
+	},
+}
+
+var RoleAdmin Role = Role{
+	Name:  "admin",
+	Title: "Admin",
+	About: "Everything a Builder and Reviewer can do, plus assigning reviewers. Can grant any of these to other players.",
+	Permissions: []string{
+		PermissionReviewCharacterApplications.Name,
+		PermissionReviewRoomProposals.Name,
+		PermissionAssignRequestReviewers.Name,
+		PermissionViewAllRooms.Name,
+		PermissionCreateRoom.Name,
+		PermissionViewAllActorImages.Name,
+		PermissionCreateActorImage.Name,
+	},
+	Delegates: true,
+}
+
+var AllRoles []Role = []Role{
+	RoleBuilder,
+	RoleReviewer,
+	RoleAdmin,
+}
+
+func rolesByName(roles []Role) map[string]Role {
+	rolesbyname := make(map[string]Role)
+	for _, role := range roles {
+		rolesbyname[role.Name] = role
+	}
+	return rolesbyname
+}
+
+var AllRolesByName = rolesByName(AllRoles)
+
+func (r *Role) HasPermission(name string) bool {
+	for _, perm := range r.Permissions {
+		if perm == name {
+			return true
+		}
+	}
+	return false
+}
+
+func IsValidRoleName(name string) bool {
+	_, ok := AllRolesByName[name]
+	return ok
+}
@@ -0,0 +1,183 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	SessionsTokenKey = "sessions"
+	SessionTokenKey  = "session"
+)
+
+const (
+	fieldPID       = "pid"
+	fieldCreatedAt = "created"
+	fieldSeenAt    = "seen"
+	fieldIP        = "ip"
+	fieldUserAgent = "ua"
+)
+
+// Store is the storage behind the session store, i.e. Sessions.Storage
+type Store interface {
+	Get(key string) ([]byte, error)
+	Delete(key string) error
+}
+
+// Session is one place a player is logged in
+type Session struct {
+	CreatedAt time.Time
+	SeenAt    time.Time
+	Handle    string
+	IP        string
+	UserAgent string
+	SID       string
+}
+
+type SeenParams struct {
+	SID       string
+	IP        string
+	UserAgent string
+	PID       int64
+	Now       time.Time
+	TTL       time.Duration
+}
+
+// Seen records activity on a session, adding it to the player's index if it isn't there yet
+func Seen(ctx context.Context, r *redis.Client, p SeenParams) error {
+	key := Key(p.SID)
+	now := strconv.FormatInt(p.Now.Unix(), 10)
+	pipe := r.TxPipeline()
+	pipe.HSetNX(ctx, key, fieldCreatedAt, now)
+	pipe.HSet(ctx, key,
+		fieldPID, strconv.FormatInt(p.PID, 10),
+		fieldSeenAt, now,
+		fieldIP, p.IP,
+		fieldUserAgent, p.UserAgent,
+	)
+	pipe.Expire(ctx, key, p.TTL)
+	pipe.SAdd(ctx, IndexKey(p.PID), p.SID)
+	pipe.Expire(ctx, IndexKey(p.PID), p.TTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// List returns a player's sessions, most recently seen first. Sessions that have
+// expired out from under the index are dropped from it along the way.
+func List(ctx context.Context, r *redis.Client, s Store, pid int64) ([]Session, error) {
+	sids, err := r.SMembers(ctx, IndexKey(pid)).Result()
+	if err != nil {
+		return []Session{}, err
+	}
+
+	sessions := []Session{}
+	for _, sid := range sids {
+		fields, err := r.HGetAll(ctx, Key(sid)).Result()
+		if err != nil {
+			return []Session{}, err
+		}
+		raw, err := s.Get(sid)
+		if err != nil {
+			return []Session{}, err
+		}
+		if raw == nil || len(fields) == 0 || fields[fieldPID] != strconv.FormatInt(pid, 10) {
+			if err := Forget(ctx, r, pid, sid); err != nil {
+				return []Session{}, err
+			}
+			continue
+		}
+		sessions = append(sessions, Session{
+			CreatedAt: unix(fields[fieldCreatedAt]),
+			SeenAt:    unix(fields[fieldSeenAt]),
+			Handle:    Handle(sid),
+			IP:        fields[fieldIP],
+			UserAgent: fields[fieldUserAgent],
+			SID:       sid,
+		})
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].SeenAt.After(sessions[j].SeenAt)
+	})
+	return sessions, nil
+}
+
+// Revoke ends one of a player's sessions, found by its handle. It reports whether there was one to end.
+func Revoke(ctx context.Context, r *redis.Client, s Store, pid int64, handle string) (bool, error) {
+	sessions, err := List(ctx, r, s, pid)
+	if err != nil {
+		return false, err
+	}
+	for _, sess := range sessions {
+		if sess.Handle != handle {
+			continue
+		}
+		if err := end(ctx, r, s, pid, sess.SID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// RevokeAll ends every one of a player's sessions other than except, which can be empty
+func RevokeAll(ctx context.Context, r *redis.Client, s Store, pid int64, except string) error {
+	sids, err := r.SMembers(ctx, IndexKey(pid)).Result()
+	if err != nil {
+		return err
+	}
+	for _, sid := range sids {
+		if sid == except {
+			continue
+		}
+		if err := end(ctx, r, s, pid, sid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Forget drops a session from the index without touching the store, i.e. on logout
+func Forget(ctx context.Context, r *redis.Client, pid int64, sid string) error {
+	pipe := r.TxPipeline()
+	pipe.Del(ctx, Key(sid))
+	pipe.SRem(ctx, IndexKey(pid), sid)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func end(ctx context.Context, r *redis.Client, s Store, pid int64, sid string) error {
+	if err := s.Delete(sid); err != nil {
+		return err
+	}
+	return Forget(ctx, r, pid, sid)
+}
+
+// Handle stands in for a session ID anywhere it would be shown, since the ID is as good as a password
+func Handle(sid string) string {
+	sum := sha256.Sum256([]byte(sid))
+	return hex.EncodeToString(sum[:8])
+}
+
+func unix(v string) time.Time {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(n, 0)
+}
+
+func IndexKey(pid int64) string {
+	return fmt.Sprintf("%s:%d", SessionsTokenKey, pid)
+}
+
+func Key(sid string) string {
+	return fmt.Sprintf("%s:%s", SessionTokenKey, sid)
+}
@@ -0,0 +1,20 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeys(t *testing.T) {
+	require.Equal(t, "sessions:69", IndexKey(69))
+	require.Equal(t, "session:abc", Key("abc"))
+}
+
+func TestHandle(t *testing.T) {
+	handle := Handle("abc")
+	require.Len(t, handle, 16)
+	require.Equal(t, handle, Handle("abc"))
+	require.NotEqual(t, handle, Handle("abd"))
+	require.NotContains(t, handle, "abc")
+}
@@ -0,0 +1,74 @@
+package twofactor
+
+import (
+	"context"
+	"fmt"
+
+	redis "github.com/redis/go-redis/v9"
+
+	"petrichormud.com/app/internal/service"
+)
+
+const (
+	RequiredTokenKey = "tfreq"
+	EnabledTokenKey  = "tf"
+)
+
+const ThirtyTwoHoursInNanoseconds = 32 * 60 * 60 * 1000 * 1000 * 1000
+
+func RequiredKey() string {
+	return RequiredTokenKey
+}
+
+func EnabledKey(pid int64) string {
+	return fmt.Sprintf("%s:%d", EnabledTokenKey, pid)
+}
+
+// CachedRequired is Required, read from the cache and loaded into it on a miss
+func CachedRequired(i *service.Interfaces) (bool, error) {
+	return cachedFlag(i.Redis, RequiredKey(), func() (bool, error) {
+		return Required(context.Background(), i.Queries)
+	})
+}
+
+// CachedEnabled is Enabled, read from the cache and loaded into it on a miss
+func CachedEnabled(i *service.Interfaces, pid int64) (bool, error) {
+	return cachedFlag(i.Redis, EnabledKey(pid), func() (bool, error) {
+		return Enabled(context.Background(), i.Queries, pid)
+	})
+}
+
+func cachedFlag(r *redis.Client, key string, load func() (bool, error)) (bool, error) {
+	cached, err := r.Get(context.Background(), key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			return false, err
+		}
+		flag, err := load()
+		if err != nil {
+			return false, err
+		}
+		if err := r.Set(context.Background(), key, encodeFlag(flag), ThirtyTwoHoursInNanoseconds).Err(); err != nil {
+			return false, err
+		}
+		return flag, nil
+	}
+	return cached == encodeFlag(true), nil
+}
+
+func encodeFlag(flag bool) string {
+	if flag {
+		return "1"
+	}
+	return "0"
+}
+
+// InvalidateRequired drops the cached requirement. Call it after the change has been committed.
+func InvalidateRequired(r *redis.Client) error {
+	return r.Del(context.Background(), RequiredKey()).Err()
+}
+
+// InvalidateEnabled drops a player's cached enabled flag. Call it after the change has been committed.
+func InvalidateEnabled(r *redis.Client, pid int64) error {
+	return r.Del(context.Background(), EnabledKey(pid)).Err()
+}
@@ -0,0 +1,17 @@
+package twofactor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnabledKey(t *testing.T) {
+	require.Equal(t, "tf:69", EnabledKey(69))
+	require.Equal(t, "tfreq", RequiredKey())
+}
+
+func TestEncodeFlag(t *testing.T) {
+	require.Equal(t, "1", encodeFlag(true))
+	require.Equal(t, "0", encodeFlag(false))
+}
@@ -0,0 +1,67 @@
+package twofactor
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	RecoveryCodeCount  int = 10
+	recoveryCodeLength int = 10
+)
+
+// Recovery codes skip the characters that are easy to misread
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// GenerateRecoveryCodes returns a fresh set of one-time recovery codes, formatted like "abcde-fghjk"
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := []string{}
+	for n := 0; n < RecoveryCodeCount; n++ {
+		var sb strings.Builder
+		for i := 0; i < recoveryCodeLength; i++ {
+			if i == recoveryCodeLength/2 {
+				sb.WriteString("-")
+			}
+			c, err := rand.Int(rand.Reader, big.NewInt(int64(len(recoveryCodeAlphabet))))
+			if err != nil {
+				return []string{}, err
+			}
+			sb.WriteByte(recoveryCodeAlphabet[c.Int64()])
+		}
+		codes = append(codes, sb.String())
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode puts a code the way it's typed into the form it's hashed in
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, " ", "")
+	code = strings.ReplaceAll(code, "-", "")
+	if len(code) != recoveryCodeLength {
+		return code
+	}
+	return fmt.Sprintf("%s-%s", code[:recoveryCodeLength/2], code[recoveryCodeLength/2:])
+}
+
+// IsRecoveryCode tells recovery codes apart from authenticator codes
+func IsRecoveryCode(code string) bool {
+	normalized := NormalizeRecoveryCode(code)
+	if len(normalized) != recoveryCodeLength+1 {
+		return false
+	}
+	for i, c := range normalized {
+		if i == recoveryCodeLength/2 {
+			if c != '-' {
+				return false
+			}
+			continue
+		}
+		if !strings.ContainsRune(recoveryCodeAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
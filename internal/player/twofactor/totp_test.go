@@ -0,0 +1,73 @@
+package twofactor
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The SHA1 secret from the RFC 6238 test vectors
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeMatchesRFCVectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, expected := range vectors {
+		code, err := Code(rfcSecret, Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, expected, code)
+	}
+}
+
+func TestValidateAcceptsSkew(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, err := Code(rfcSecret, Step(now)-1)
+	require.NoError(t, err)
+
+	step, ok := Validate(rfcSecret, code, now)
+	require.True(t, ok)
+	require.Equal(t, Step(now)-1, step)
+
+	_, ok = Validate(rfcSecret, code, now.Add(2*Period))
+	require.False(t, ok)
+	_, ok = Validate(rfcSecret, "12345", now)
+	require.False(t, ok)
+}
+
+func TestGenerateSecretIsValid(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	_, err = Code(secret, 1)
+	require.NoError(t, err)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("tester", "ABC")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/Petrichor:tester?"))
+	require.Contains(t, uri, "secret=ABC")
+	require.Contains(t, uri, "issuer=Petrichor")
+}
+
+func TestQRCode(t *testing.T) {
+	qr, err := QRCode(URI("tester", "ABC"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(qr), "data:image/png;base64,"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, RecoveryCodeCount)
+	for _, code := range codes {
+		require.True(t, IsRecoveryCode(code))
+		require.Equal(t, code, NormalizeRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))))
+	}
+	require.False(t, IsRecoveryCode("123456"))
+}
@@ -0,0 +1,213 @@
+package twofactor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/player/password"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/service"
+)
+
+// PendingSessionKey holds the PID of a player who has passed the password step of logging in, but not this one
+const PendingSessionKey = "tfpid"
+
+// RequiredSetting is the site setting that holds back staff permissions until two-factor is set up
+const RequiredSetting = "require-staff-two-factor"
+
+var (
+	ErrNotEnrolled    = errors.New("two-factor authentication isn't set up")
+	ErrAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrInvalidCode    = errors.New("that code isn't valid")
+)
+
+// Enabled reports whether a player has finished setting up two-factor authentication
+func Enabled(ctx context.Context, q *query.Queries, pid int64) (bool, error) {
+	tf, err := q.GetPlayerTwoFactor(ctx, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return tf.Enabled, nil
+}
+
+// Enroll starts setting up two-factor authentication with a new secret.
+// It doesn't take effect until a code from the secret is confirmed.
+func Enroll(ctx context.Context, q *query.Queries, pid int64) (string, error) {
+	enabled, err := Enabled(ctx, q, pid)
+	if err != nil {
+		return "", err
+	}
+	if enabled {
+		return "", ErrAlreadyEnabled
+	}
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+	if err := q.CreatePlayerTwoFactor(ctx, query.CreatePlayerTwoFactorParams{
+		Secret: secret,
+		PID:    pid,
+	}); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// Confirm finishes setting up two-factor authentication with a first code from the
+// player's authenticator. It returns the player's recovery codes, which are only
+// ever shown this once.
+func Confirm(ctx context.Context, q *query.Queries, pid int64, code string, now time.Time) ([]string, error) {
+	tf, err := q.GetPlayerTwoFactor(ctx, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []string{}, ErrNotEnrolled
+		}
+		return []string{}, err
+	}
+	if tf.Enabled {
+		return []string{}, ErrAlreadyEnabled
+	}
+
+	step, ok := Validate(tf.Secret, code, now)
+	if !ok {
+		return []string{}, ErrInvalidCode
+	}
+	if err := q.EnablePlayerTwoFactor(ctx, query.EnablePlayerTwoFactorParams{
+		LastStep: step,
+		PID:      pid,
+	}); err != nil {
+		return []string{}, err
+	}
+
+	codes, err := GenerateRecoveryCodes()
+	if err != nil {
+		return []string{}, err
+	}
+	if err := q.DeletePlayerRecoveryCodes(ctx, pid); err != nil {
+		return []string{}, err
+	}
+	for _, code := range codes {
+		hash, err := password.Hash(code)
+		if err != nil {
+			return []string{}, err
+		}
+		if err := q.CreatePlayerRecoveryCode(ctx, query.CreatePlayerRecoveryCodeParams{
+			Hash: hash,
+			PID:  pid,
+		}); err != nil {
+			return []string{}, err
+		}
+	}
+	return codes, nil
+}
+
+// Verify checks a code from the player's authenticator, or one of their recovery
+// codes. Either one is used up by a successful check.
+func Verify(ctx context.Context, q *query.Queries, pid int64, code string, now time.Time) error {
+	tf, err := q.GetPlayerTwoFactor(ctx, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNotEnrolled
+		}
+		return err
+	}
+	if !tf.Enabled {
+		return ErrNotEnrolled
+	}
+
+	if IsRecoveryCode(code) {
+		return useRecoveryCode(ctx, q, pid, NormalizeRecoveryCode(code))
+	}
+
+	step, ok := Validate(tf.Secret, code, now)
+	if !ok || step <= tf.LastStep {
+		return ErrInvalidCode
+	}
+	return q.UpdatePlayerTwoFactorLastStep(ctx, query.UpdatePlayerTwoFactorLastStepParams{
+		LastStep: step,
+		PID:      pid,
+	})
+}
+
+func useRecoveryCode(ctx context.Context, q *query.Queries, pid int64, code string) error {
+	codes, err := q.ListUnusedPlayerRecoveryCodes(ctx, pid)
+	if err != nil {
+		return err
+	}
+	for _, rc := range codes {
+		ok, err := password.Verify(code, rc.Hash)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return q.UsePlayerRecoveryCode(ctx, rc.ID)
+		}
+	}
+	return ErrInvalidCode
+}
+
+// Disable turns off two-factor authentication and throws away the recovery codes
+func Disable(ctx context.Context, q *query.Queries, pid int64) error {
+	if err := q.DeletePlayerTwoFactor(ctx, pid); err != nil {
+		return err
+	}
+	return q.DeletePlayerRecoveryCodes(ctx, pid)
+}
+
+// Required reports whether staff need two-factor authentication for their permissions to apply
+func Required(ctx context.Context, q *query.Queries) (bool, error) {
+	setting, err := q.GetSiteSetting(ctx, RequiredSetting)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return setting.Value == "true", nil
+}
+
+func SetRequired(ctx context.Context, q *query.Queries, required bool) error {
+	value := "false"
+	if required {
+		value = "true"
+	}
+	return q.SetSiteSetting(ctx, query.SetSiteSettingParams{
+		Name:  RequiredSetting,
+		Value: value,
+	})
+}
+
+// Restrict holds back a player's permissions other than the basic ones while
+// two-factor authentication is required and they haven't set it up. It reports
+// whether anything was held back. Both flags come from the cache, since this runs
+// on every request.
+func Restrict(i *service.Interfaces, perms player.Permissions) (player.Permissions, bool, error) {
+	if perms.IsBasic() {
+		return perms, false, nil
+	}
+
+	required, err := CachedRequired(i)
+	if err != nil {
+		return perms, false, err
+	}
+	if !required {
+		return perms, false, nil
+	}
+
+	enabled, err := CachedEnabled(i, perms.PID)
+	if err != nil {
+		return perms, false, err
+	}
+	if enabled {
+		return perms, false, nil
+	}
+
+	return perms.Basic(), true, nil
+}
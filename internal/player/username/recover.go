@@ -5,6 +5,7 @@ import (
 	"os"
 	"time"
 
+	"petrichormud.com/app/internal/email"
 	"petrichormud.com/app/internal/proto/sending"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/service"
@@ -21,6 +22,11 @@ func Recover(i *service.Interfaces, e query.Email) (string, error) {
 		return "", err
 	}
 
+	to, err := email.Recipient(context.Background(), i.Queries, e.PID, e.Address)
+	if err != nil {
+		return "", err
+	}
+
 	if os.Getenv("DISABLE_SENDING_STONE") == "true" {
 		return id, nil
 	}
@@ -29,7 +35,7 @@ func Recover(i *service.Interfaces, e query.Email) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 	_, err = sender.SendUsernameRecovery(ctx, &sending.SendUsernameRecoveryRequest{
-		Email:    e.Address,
+		Email:    to,
 		Username: u,
 	})
 	if err != nil {
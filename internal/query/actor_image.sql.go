@@ -10,6 +10,15 @@ import (
 	"database/sql"
 )
 
+const clearCurrentActorImagePlayerPropertiesForPlayer = `-- name: ClearCurrentActorImagePlayerPropertiesForPlayer :exec
+UPDATE actor_images_player_properties SET current = false WHERE pid = ?
+`
+
+func (q *Queries) ClearCurrentActorImagePlayerPropertiesForPlayer(ctx context.Context, pid int64) error {
+	_, err := q.exec(ctx, q.clearCurrentActorImagePlayerPropertiesForPlayerStmt, clearCurrentActorImagePlayerPropertiesForPlayer, pid)
+	return err
+}
+
 const countCurrentActorImagePlayerPropertiesForPlayer = `-- name: CountCurrentActorImagePlayerPropertiesForPlayer :one
 SELECT COUNT(*) FROM actor_images_player_properties WHERE pid = ? AND current = true
 `
@@ -340,7 +349,7 @@ func (q *Queries) GetActorImageFurnitureProperties(ctx context.Context, aiid int
 }
 
 const getActorImagePlayerPropertiesForImage = `-- name: GetActorImagePlayerPropertiesForImage :one
-SELECT created_at, updated_at, aiid, pid, id, current FROM actor_images_player_properties WHERE aiid = ?
+SELECT created_at, updated_at, aiid, pid, id, current, retired FROM actor_images_player_properties WHERE aiid = ?
 `
 
 func (q *Queries) GetActorImagePlayerPropertiesForImage(ctx context.Context, aiid int64) (ActorImagesPlayerProperty, error) {
@@ -353,6 +362,7 @@ func (q *Queries) GetActorImagePlayerPropertiesForImage(ctx context.Context, aii
 		&i.PID,
 		&i.ID,
 		&i.Current,
+		&i.Retired,
 	)
 	return i, err
 }
@@ -558,6 +568,67 @@ func (q *Queries) ListActorImagesPrimaryHands(ctx context.Context, aiid int64) (
 	return items, nil
 }
 
+const listCharactersForPlayer = `-- name: ListCharactersForPlayer :many
+SELECT
+  actor_images_player_properties.created_at, actor_images_player_properties.updated_at, actor_images_player_properties.aiid, actor_images_player_properties.pid, actor_images_player_properties.id, actor_images_player_properties.current, actor_images_player_properties.retired, actor_images.created_at, actor_images.updated_at, actor_images.description, actor_images.short_description, actor_images.name, actor_images.gender, actor_images.id, actor_images.uniq, actor_images_character_metadata.value
+FROM
+  actor_images_player_properties
+JOIN
+  actor_images ON actor_images.id = actor_images_player_properties.aiid
+JOIN
+  actor_images_character_metadata ON actor_images_character_metadata.aiid = actor_images.id AND actor_images_character_metadata.` + "`" + `key` + "`" + ` = 'name'
+WHERE
+  actor_images_player_properties.pid = ?
+ORDER BY
+  actor_images_player_properties.current DESC, actor_images_player_properties.retired, actor_images_player_properties.created_at DESC
+`
+
+type ListCharactersForPlayerRow struct {
+	ActorImagesPlayerProperty ActorImagesPlayerProperty
+	ActorImage                ActorImage
+	Value                     string
+}
+
+func (q *Queries) ListCharactersForPlayer(ctx context.Context, pid int64) ([]ListCharactersForPlayerRow, error) {
+	rows, err := q.query(ctx, q.listCharactersForPlayerStmt, listCharactersForPlayer, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCharactersForPlayerRow
+	for rows.Next() {
+		var i ListCharactersForPlayerRow
+		if err := rows.Scan(
+			&i.ActorImagesPlayerProperty.CreatedAt,
+			&i.ActorImagesPlayerProperty.UpdatedAt,
+			&i.ActorImagesPlayerProperty.AIID,
+			&i.ActorImagesPlayerProperty.PID,
+			&i.ActorImagesPlayerProperty.ID,
+			&i.ActorImagesPlayerProperty.Current,
+			&i.ActorImagesPlayerProperty.Retired,
+			&i.ActorImage.CreatedAt,
+			&i.ActorImage.UpdatedAt,
+			&i.ActorImage.Description,
+			&i.ActorImage.ShortDescription,
+			&i.ActorImage.Name,
+			&i.ActorImage.Gender,
+			&i.ActorImage.ID,
+			&i.ActorImage.Unique,
+			&i.Value,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setActorImagePlayerPropertiesCurrent = `-- name: SetActorImagePlayerPropertiesCurrent :exec
 UPDATE actor_images_player_properties SET current = ? WHERE id = ?
 `
@@ -572,6 +643,20 @@ func (q *Queries) SetActorImagePlayerPropertiesCurrent(ctx context.Context, arg
 	return err
 }
 
+const setActorImagePlayerPropertiesRetired = `-- name: SetActorImagePlayerPropertiesRetired :exec
+UPDATE actor_images_player_properties SET retired = ? WHERE id = ?
+`
+
+type SetActorImagePlayerPropertiesRetiredParams struct {
+	Retired bool
+	ID      int64
+}
+
+func (q *Queries) SetActorImagePlayerPropertiesRetired(ctx context.Context, arg SetActorImagePlayerPropertiesRetiredParams) error {
+	_, err := q.exec(ctx, q.setActorImagePlayerPropertiesRetiredStmt, setActorImagePlayerPropertiesRetired, arg.Retired, arg.ID)
+	return err
+}
+
 const updateActorImageDescription = `-- name: UpdateActorImageDescription :exec
 UPDATE actor_images SET description = ? WHERE id = ?
 `
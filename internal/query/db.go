@@ -24,12 +24,27 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addZoneBuilderStmt, err = db.PrepareContext(ctx, addZoneBuilder); err != nil {
+		return nil, fmt.Errorf("error preparing query AddZoneBuilder: %w", err)
+	}
+	if q.batchCreatePastRequestChangeRequestStmt, err = db.PrepareContext(ctx, batchCreatePastRequestChangeRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query BatchCreatePastRequestChangeRequest: %w", err)
+	}
 	if q.batchCreateRequestChangeRequestStmt, err = db.PrepareContext(ctx, batchCreateRequestChangeRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query BatchCreateRequestChangeRequest: %w", err)
 	}
 	if q.batchDeleteOpenRequestChangeRequestStmt, err = db.PrepareContext(ctx, batchDeleteOpenRequestChangeRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query BatchDeleteOpenRequestChangeRequest: %w", err)
 	}
+	if q.batchDeleteRequestChangeRequestStmt, err = db.PrepareContext(ctx, batchDeleteRequestChangeRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query BatchDeleteRequestChangeRequest: %w", err)
+	}
+	if q.clearCurrentActorImagePlayerPropertiesForPlayerStmt, err = db.PrepareContext(ctx, clearCurrentActorImagePlayerPropertiesForPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearCurrentActorImagePlayerPropertiesForPlayer: %w", err)
+	}
+	if q.clearPrimaryEmailStmt, err = db.PrepareContext(ctx, clearPrimaryEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearPrimaryEmail: %w", err)
+	}
 	if q.countCurrentActorImagePlayerPropertiesForPlayerStmt, err = db.PrepareContext(ctx, countCurrentActorImagePlayerPropertiesForPlayer); err != nil {
 		return nil, fmt.Errorf("error preparing query CountCurrentActorImagePlayerPropertiesForPlayer: %w", err)
 	}
@@ -39,6 +54,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countOpenRequestChangeRequestsForRequestStmt, err = db.PrepareContext(ctx, countOpenRequestChangeRequestsForRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query CountOpenRequestChangeRequestsForRequest: %w", err)
 	}
+	if q.countUniqueActorImagesWithCharacterNameStmt, err = db.PrepareContext(ctx, countUniqueActorImagesWithCharacterName); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUniqueActorImagesWithCharacterName: %w", err)
+	}
+	if q.countZoneBuilderStmt, err = db.PrepareContext(ctx, countZoneBuilder); err != nil {
+		return nil, fmt.Errorf("error preparing query CountZoneBuilder: %w", err)
+	}
 	if q.createActorImageStmt, err = db.PrepareContext(ctx, createActorImage); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateActorImage: %w", err)
 	}
@@ -72,6 +93,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createActorImagePrimaryHandStmt, err = db.PrepareContext(ctx, createActorImagePrimaryHand); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateActorImagePrimaryHand: %w", err)
 	}
+	if q.createCharacterNameReservationStmt, err = db.PrepareContext(ctx, createCharacterNameReservation); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCharacterNameReservation: %w", err)
+	}
 	if q.createEmailStmt, err = db.PrepareContext(ctx, createEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateEmail: %w", err)
 	}
@@ -93,24 +117,60 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createPlayerPermissionRevokedChangeHistoryStmt, err = db.PrepareContext(ctx, createPlayerPermissionRevokedChangeHistory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePlayerPermissionRevokedChangeHistory: %w", err)
 	}
+	if q.createPlayerRecoveryCodeStmt, err = db.PrepareContext(ctx, createPlayerRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlayerRecoveryCode: %w", err)
+	}
+	if q.createPlayerRoleStmt, err = db.PrepareContext(ctx, createPlayerRole); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlayerRole: %w", err)
+	}
+	if q.createPlayerRoleIssuedChangeHistoryStmt, err = db.PrepareContext(ctx, createPlayerRoleIssuedChangeHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlayerRoleIssuedChangeHistory: %w", err)
+	}
+	if q.createPlayerRoleRevokedChangeHistoryStmt, err = db.PrepareContext(ctx, createPlayerRoleRevokedChangeHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlayerRoleRevokedChangeHistory: %w", err)
+	}
 	if q.createPlayerSettingsStmt, err = db.PrepareContext(ctx, createPlayerSettings); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePlayerSettings: %w", err)
 	}
+	if q.createPlayerTwoFactorStmt, err = db.PrepareContext(ctx, createPlayerTwoFactor); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlayerTwoFactor: %w", err)
+	}
 	if q.createRequestStmt, err = db.PrepareContext(ctx, createRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRequest: %w", err)
 	}
 	if q.createRequestChangeRequestStmt, err = db.PrepareContext(ctx, createRequestChangeRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRequestChangeRequest: %w", err)
 	}
+	if q.createRequestDeletionStmt, err = db.PrepareContext(ctx, createRequestDeletion); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRequestDeletion: %w", err)
+	}
 	if q.createRequestFieldStmt, err = db.PrepareContext(ctx, createRequestField); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRequestField: %w", err)
 	}
+	if q.createRequestFieldCommentStmt, err = db.PrepareContext(ctx, createRequestFieldComment); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRequestFieldComment: %w", err)
+	}
+	if q.createRequestFieldCommentResolutionStmt, err = db.PrepareContext(ctx, createRequestFieldCommentResolution); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRequestFieldCommentResolution: %w", err)
+	}
+	if q.createRequestFieldVersionStmt, err = db.PrepareContext(ctx, createRequestFieldVersion); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRequestFieldVersion: %w", err)
+	}
+	if q.createRequestStatusHistoryStmt, err = db.PrepareContext(ctx, createRequestStatusHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRequestStatusHistory: %w", err)
+	}
 	if q.createRequestSubfieldStmt, err = db.PrepareContext(ctx, createRequestSubfield); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRequestSubfield: %w", err)
 	}
+	if q.createRequestSubfieldVersionStmt, err = db.PrepareContext(ctx, createRequestSubfieldVersion); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRequestSubfieldVersion: %w", err)
+	}
 	if q.createRoomStmt, err = db.PrepareContext(ctx, createRoom); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateRoom: %w", err)
 	}
+	if q.createZoneStmt, err = db.PrepareContext(ctx, createZone); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateZone: %w", err)
+	}
 	if q.deleteActorImageCanStmt, err = db.PrepareContext(ctx, deleteActorImageCan); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteActorImageCan: %w", err)
 	}
@@ -132,6 +192,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteActorImagePrimaryHandStmt, err = db.PrepareContext(ctx, deleteActorImagePrimaryHand); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteActorImagePrimaryHand: %w", err)
 	}
+	if q.deleteCharacterNameReservationForRequestStmt, err = db.PrepareContext(ctx, deleteCharacterNameReservationForRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCharacterNameReservationForRequest: %w", err)
+	}
 	if q.deleteEmailStmt, err = db.PrepareContext(ctx, deleteEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEmail: %w", err)
 	}
@@ -141,15 +204,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deletePlayerPermissionStmt, err = db.PrepareContext(ctx, deletePlayerPermission); err != nil {
 		return nil, fmt.Errorf("error preparing query DeletePlayerPermission: %w", err)
 	}
+	if q.deletePlayerRecoveryCodesStmt, err = db.PrepareContext(ctx, deletePlayerRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePlayerRecoveryCodes: %w", err)
+	}
+	if q.deletePlayerRoleStmt, err = db.PrepareContext(ctx, deletePlayerRole); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePlayerRole: %w", err)
+	}
+	if q.deletePlayerTwoFactorStmt, err = db.PrepareContext(ctx, deletePlayerTwoFactor); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePlayerTwoFactor: %w", err)
+	}
 	if q.deleteRequestChangeRequestStmt, err = db.PrepareContext(ctx, deleteRequestChangeRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRequestChangeRequest: %w", err)
 	}
+	if q.deleteRequestDeletionStmt, err = db.PrepareContext(ctx, deleteRequestDeletion); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRequestDeletion: %w", err)
+	}
+	if q.deleteRequestFieldCommentResolutionStmt, err = db.PrepareContext(ctx, deleteRequestFieldCommentResolution); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRequestFieldCommentResolution: %w", err)
+	}
 	if q.deleteRequestSubfieldStmt, err = db.PrepareContext(ctx, deleteRequestSubfield); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRequestSubfield: %w", err)
 	}
+	if q.deleteRoomExitStmt, err = db.PrepareContext(ctx, deleteRoomExit); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRoomExit: %w", err)
+	}
 	if q.editOpenRequestChangeRequestStmt, err = db.PrepareContext(ctx, editOpenRequestChangeRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query EditOpenRequestChangeRequest: %w", err)
 	}
+	if q.enablePlayerTwoFactorStmt, err = db.PrepareContext(ctx, enablePlayerTwoFactor); err != nil {
+		return nil, fmt.Errorf("error preparing query EnablePlayerTwoFactor: %w", err)
+	}
 	if q.getActorImageStmt, err = db.PrepareContext(ctx, getActorImage); err != nil {
 		return nil, fmt.Errorf("error preparing query GetActorImage: %w", err)
 	}
@@ -168,6 +252,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getActorImagePlayerPropertiesForImageStmt, err = db.PrepareContext(ctx, getActorImagePlayerPropertiesForImage); err != nil {
 		return nil, fmt.Errorf("error preparing query GetActorImagePlayerPropertiesForImage: %w", err)
 	}
+	if q.getCharacterNameReservationStmt, err = db.PrepareContext(ctx, getCharacterNameReservation); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterNameReservation: %w", err)
+	}
 	if q.getEmailStmt, err = db.PrepareContext(ctx, getEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEmail: %w", err)
 	}
@@ -180,6 +267,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getHelpRelatedStmt, err = db.PrepareContext(ctx, getHelpRelated); err != nil {
 		return nil, fmt.Errorf("error preparing query GetHelpRelated: %w", err)
 	}
+	if q.getLatestRequestStatusHistoryToStmt, err = db.PrepareContext(ctx, getLatestRequestStatusHistoryTo); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLatestRequestStatusHistoryTo: %w", err)
+	}
 	if q.getOpenRequestChangeRequestStmt, err = db.PrepareContext(ctx, getOpenRequestChangeRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOpenRequestChangeRequest: %w", err)
 	}
@@ -195,18 +285,27 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPlayerSettingsStmt, err = db.PrepareContext(ctx, getPlayerSettings); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayerSettings: %w", err)
 	}
+	if q.getPlayerTwoFactorStmt, err = db.PrepareContext(ctx, getPlayerTwoFactor); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlayerTwoFactor: %w", err)
+	}
 	if q.getPlayerUsernameStmt, err = db.PrepareContext(ctx, getPlayerUsername); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayerUsername: %w", err)
 	}
 	if q.getPlayerUsernameByIdStmt, err = db.PrepareContext(ctx, getPlayerUsernameById); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPlayerUsernameById: %w", err)
 	}
+	if q.getPrimaryEmailStmt, err = db.PrepareContext(ctx, getPrimaryEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPrimaryEmail: %w", err)
+	}
 	if q.getRequestStmt, err = db.PrepareContext(ctx, getRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRequest: %w", err)
 	}
 	if q.getRequestChangeRequestByFieldIDStmt, err = db.PrepareContext(ctx, getRequestChangeRequestByFieldID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRequestChangeRequestByFieldID: %w", err)
 	}
+	if q.getRequestDeletionStmt, err = db.PrepareContext(ctx, getRequestDeletion); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRequestDeletion: %w", err)
+	}
 	if q.getRequestFieldStmt, err = db.PrepareContext(ctx, getRequestField); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRequestField: %w", err)
 	}
@@ -216,18 +315,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getRequestFieldByTypeWithChangeRequestsStmt, err = db.PrepareContext(ctx, getRequestFieldByTypeWithChangeRequests); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRequestFieldByTypeWithChangeRequests: %w", err)
 	}
+	if q.getRequestFieldCommentReadStmt, err = db.PrepareContext(ctx, getRequestFieldCommentRead); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRequestFieldCommentRead: %w", err)
+	}
+	if q.getRequestFieldCommentResolutionStmt, err = db.PrepareContext(ctx, getRequestFieldCommentResolution); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRequestFieldCommentResolution: %w", err)
+	}
+	if q.getRequestFieldVersionBeforeStmt, err = db.PrepareContext(ctx, getRequestFieldVersionBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRequestFieldVersionBefore: %w", err)
+	}
+	if q.getRequestForUpdateStmt, err = db.PrepareContext(ctx, getRequestForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRequestForUpdate: %w", err)
+	}
 	if q.getRequestSubfieldStmt, err = db.PrepareContext(ctx, getRequestSubfield); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRequestSubfield: %w", err)
 	}
 	if q.getRoomStmt, err = db.PrepareContext(ctx, getRoom); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRoom: %w", err)
 	}
+	if q.getRoomExitStmt, err = db.PrepareContext(ctx, getRoomExit); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRoomExit: %w", err)
+	}
+	if q.getSiteSettingStmt, err = db.PrepareContext(ctx, getSiteSetting); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSiteSetting: %w", err)
+	}
 	if q.getTagsForHelpFileStmt, err = db.PrepareContext(ctx, getTagsForHelpFile); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTagsForHelpFile: %w", err)
 	}
 	if q.getVerifiedEmailByAddressStmt, err = db.PrepareContext(ctx, getVerifiedEmailByAddress); err != nil {
 		return nil, fmt.Errorf("error preparing query GetVerifiedEmailByAddress: %w", err)
 	}
+	if q.getZoneStmt, err = db.PrepareContext(ctx, getZone); err != nil {
+		return nil, fmt.Errorf("error preparing query GetZone: %w", err)
+	}
 	if q.listActorImageCanStmt, err = db.PrepareContext(ctx, listActorImageCan); err != nil {
 		return nil, fmt.Errorf("error preparing query ListActorImageCan: %w", err)
 	}
@@ -246,6 +366,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listActorImagesPrimaryHandsStmt, err = db.PrepareContext(ctx, listActorImagesPrimaryHands); err != nil {
 		return nil, fmt.Errorf("error preparing query ListActorImagesPrimaryHands: %w", err)
 	}
+	if q.listAllRoomExitsStmt, err = db.PrepareContext(ctx, listAllRoomExits); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAllRoomExits: %w", err)
+	}
+	if q.listCharactersForPlayerStmt, err = db.PrepareContext(ctx, listCharactersForPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCharactersForPlayer: %w", err)
+	}
+	if q.listDeletedRequestsForPlayerStmt, err = db.PrepareContext(ctx, listDeletedRequestsForPlayer); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDeletedRequestsForPlayer: %w", err)
+	}
 	if q.listEmailsStmt, err = db.PrepareContext(ctx, listEmails); err != nil {
 		return nil, fmt.Errorf("error preparing query ListEmails: %w", err)
 	}
@@ -261,18 +390,51 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listOpenRequestChangeRequestsForRequestStmt, err = db.PrepareContext(ctx, listOpenRequestChangeRequestsForRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query ListOpenRequestChangeRequestsForRequest: %w", err)
 	}
+	if q.listPastRequestChangeRequestsForFieldStmt, err = db.PrepareContext(ctx, listPastRequestChangeRequestsForField); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPastRequestChangeRequestsForField: %w", err)
+	}
+	if q.listPlayerPasswordHashesStmt, err = db.PrepareContext(ctx, listPlayerPasswordHashes); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPlayerPasswordHashes: %w", err)
+	}
+	if q.listPlayerPermissionChangeHistoryStmt, err = db.PrepareContext(ctx, listPlayerPermissionChangeHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPlayerPermissionChangeHistory: %w", err)
+	}
 	if q.listPlayerPermissionsStmt, err = db.PrepareContext(ctx, listPlayerPermissions); err != nil {
 		return nil, fmt.Errorf("error preparing query ListPlayerPermissions: %w", err)
 	}
+	if q.listPlayerRoleChangeHistoryStmt, err = db.PrepareContext(ctx, listPlayerRoleChangeHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPlayerRoleChangeHistory: %w", err)
+	}
+	if q.listPlayerRolesStmt, err = db.PrepareContext(ctx, listPlayerRoles); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPlayerRoles: %w", err)
+	}
 	if q.listRequestChangeRequestsByFieldIDStmt, err = db.PrepareContext(ctx, listRequestChangeRequestsByFieldID); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRequestChangeRequestsByFieldID: %w", err)
 	}
+	if q.listRequestChangeRequestsForRequestStmt, err = db.PrepareContext(ctx, listRequestChangeRequestsForRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRequestChangeRequestsForRequest: %w", err)
+	}
+	if q.listRequestFieldCommentsForFieldStmt, err = db.PrepareContext(ctx, listRequestFieldCommentsForField); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRequestFieldCommentsForField: %w", err)
+	}
+	if q.listRequestFieldVersionsForFieldStmt, err = db.PrepareContext(ctx, listRequestFieldVersionsForField); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRequestFieldVersionsForField: %w", err)
+	}
 	if q.listRequestFieldsForRequestStmt, err = db.PrepareContext(ctx, listRequestFieldsForRequest); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRequestFieldsForRequest: %w", err)
 	}
 	if q.listRequestFieldsForRequestWithChangeRequestsStmt, err = db.PrepareContext(ctx, listRequestFieldsForRequestWithChangeRequests); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRequestFieldsForRequestWithChangeRequests: %w", err)
 	}
+	if q.listRequestQueueStmt, err = db.PrepareContext(ctx, listRequestQueue); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRequestQueue: %w", err)
+	}
+	if q.listRequestStatusHistoryForRequestStmt, err = db.PrepareContext(ctx, listRequestStatusHistoryForRequest); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRequestStatusHistoryForRequest: %w", err)
+	}
+	if q.listRequestSubfieldVersionsBeforeStmt, err = db.PrepareContext(ctx, listRequestSubfieldVersionsBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRequestSubfieldVersionsBefore: %w", err)
+	}
 	if q.listRequestSubfieldsForFieldStmt, err = db.PrepareContext(ctx, listRequestSubfieldsForField); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRequestSubfieldsForField: %w", err)
 	}
@@ -285,18 +447,48 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listRequestsForPlayerStmt, err = db.PrepareContext(ctx, listRequestsForPlayer); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRequestsForPlayer: %w", err)
 	}
+	if q.listRoomExitsStmt, err = db.PrepareContext(ctx, listRoomExits); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRoomExits: %w", err)
+	}
+	if q.listRoomExitsByRoomIDsStmt, err = db.PrepareContext(ctx, listRoomExitsByRoomIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRoomExitsByRoomIDs: %w", err)
+	}
 	if q.listRoomsStmt, err = db.PrepareContext(ctx, listRooms); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRooms: %w", err)
 	}
 	if q.listRoomsByIDsStmt, err = db.PrepareContext(ctx, listRoomsByIDs); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRoomsByIDs: %w", err)
 	}
+	if q.listRoomsByZoneStmt, err = db.PrepareContext(ctx, listRoomsByZone); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRoomsByZone: %w", err)
+	}
+	if q.listStaleInReviewRequestsStmt, err = db.PrepareContext(ctx, listStaleInReviewRequests); err != nil {
+		return nil, fmt.Errorf("error preparing query ListStaleInReviewRequests: %w", err)
+	}
+	if q.listUnusedPlayerRecoveryCodesStmt, err = db.PrepareContext(ctx, listUnusedPlayerRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUnusedPlayerRecoveryCodes: %w", err)
+	}
 	if q.listVerifiedEmailsStmt, err = db.PrepareContext(ctx, listVerifiedEmails); err != nil {
 		return nil, fmt.Errorf("error preparing query ListVerifiedEmails: %w", err)
 	}
+	if q.listZoneBuildersStmt, err = db.PrepareContext(ctx, listZoneBuilders); err != nil {
+		return nil, fmt.Errorf("error preparing query ListZoneBuilders: %w", err)
+	}
+	if q.listZonesStmt, err = db.PrepareContext(ctx, listZones); err != nil {
+		return nil, fmt.Errorf("error preparing query ListZones: %w", err)
+	}
+	if q.markEmailPrimaryStmt, err = db.PrepareContext(ctx, markEmailPrimary); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkEmailPrimary: %w", err)
+	}
 	if q.markEmailVerifiedStmt, err = db.PrepareContext(ctx, markEmailVerified); err != nil {
 		return nil, fmt.Errorf("error preparing query MarkEmailVerified: %w", err)
 	}
+	if q.markRequestFieldCommentsReadStmt, err = db.PrepareContext(ctx, markRequestFieldCommentsRead); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkRequestFieldCommentsRead: %w", err)
+	}
+	if q.removeZoneBuilderStmt, err = db.PrepareContext(ctx, removeZoneBuilder); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveZoneBuilder: %w", err)
+	}
 	if q.searchHelpByCategoryStmt, err = db.PrepareContext(ctx, searchHelpByCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query SearchHelpByCategory: %w", err)
 	}
@@ -318,6 +510,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setActorImagePlayerPropertiesCurrentStmt, err = db.PrepareContext(ctx, setActorImagePlayerPropertiesCurrent); err != nil {
 		return nil, fmt.Errorf("error preparing query SetActorImagePlayerPropertiesCurrent: %w", err)
 	}
+	if q.setActorImagePlayerPropertiesRetiredStmt, err = db.PrepareContext(ctx, setActorImagePlayerPropertiesRetired); err != nil {
+		return nil, fmt.Errorf("error preparing query SetActorImagePlayerPropertiesRetired: %w", err)
+	}
+	if q.setRoomExitStmt, err = db.PrepareContext(ctx, setRoomExit); err != nil {
+		return nil, fmt.Errorf("error preparing query SetRoomExit: %w", err)
+	}
+	if q.setSiteSettingStmt, err = db.PrepareContext(ctx, setSiteSetting); err != nil {
+		return nil, fmt.Errorf("error preparing query SetSiteSetting: %w", err)
+	}
 	if q.updateActorImageDescriptionStmt, err = db.PrepareContext(ctx, updateActorImageDescription); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateActorImageDescription: %w", err)
 	}
@@ -333,6 +534,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updatePlayerSettingsThemeStmt, err = db.PrepareContext(ctx, updatePlayerSettingsTheme); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdatePlayerSettingsTheme: %w", err)
 	}
+	if q.updatePlayerTwoFactorLastStepStmt, err = db.PrepareContext(ctx, updatePlayerTwoFactorLastStep); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePlayerTwoFactorLastStep: %w", err)
+	}
 	if q.updateRequestFieldStatusStmt, err = db.PrepareContext(ctx, updateRequestFieldStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateRequestFieldStatus: %w", err)
 	}
@@ -357,32 +561,14 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateRoomStmt, err = db.PrepareContext(ctx, updateRoom); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateRoom: %w", err)
 	}
+	if q.updateRoomCoordinatesStmt, err = db.PrepareContext(ctx, updateRoomCoordinates); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateRoomCoordinates: %w", err)
+	}
 	if q.updateRoomDescriptionStmt, err = db.PrepareContext(ctx, updateRoomDescription); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateRoomDescription: %w", err)
 	}
-	if q.updateRoomExitEastStmt, err = db.PrepareContext(ctx, updateRoomExitEast); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitEast: %w", err)
-	}
-	if q.updateRoomExitNorthStmt, err = db.PrepareContext(ctx, updateRoomExitNorth); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitNorth: %w", err)
-	}
-	if q.updateRoomExitNortheastStmt, err = db.PrepareContext(ctx, updateRoomExitNortheast); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitNortheast: %w", err)
-	}
-	if q.updateRoomExitNorthwestStmt, err = db.PrepareContext(ctx, updateRoomExitNorthwest); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitNorthwest: %w", err)
-	}
-	if q.updateRoomExitSouthStmt, err = db.PrepareContext(ctx, updateRoomExitSouth); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitSouth: %w", err)
-	}
-	if q.updateRoomExitSoutheastStmt, err = db.PrepareContext(ctx, updateRoomExitSoutheast); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitSoutheast: %w", err)
-	}
-	if q.updateRoomExitSouthwestStmt, err = db.PrepareContext(ctx, updateRoomExitSouthwest); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitSouthwest: %w", err)
-	}
-	if q.updateRoomExitWestStmt, err = db.PrepareContext(ctx, updateRoomExitWest); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateRoomExitWest: %w", err)
+	if q.updateRoomExitFlagsStmt, err = db.PrepareContext(ctx, updateRoomExitFlags); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateRoomExitFlags: %w", err)
 	}
 	if q.updateRoomSizeStmt, err = db.PrepareContext(ctx, updateRoomSize); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateRoomSize: %w", err)
@@ -390,11 +576,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateRoomTitleStmt, err = db.PrepareContext(ctx, updateRoomTitle); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateRoomTitle: %w", err)
 	}
+	if q.updateRoomZoneStmt, err = db.PrepareContext(ctx, updateRoomZone); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateRoomZone: %w", err)
+	}
+	if q.updateZoneOwnerStmt, err = db.PrepareContext(ctx, updateZoneOwner); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateZoneOwner: %w", err)
+	}
+	if q.usePlayerRecoveryCodeStmt, err = db.PrepareContext(ctx, usePlayerRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query UsePlayerRecoveryCode: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addZoneBuilderStmt != nil {
+		if cerr := q.addZoneBuilderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addZoneBuilderStmt: %w", cerr)
+		}
+	}
+	if q.batchCreatePastRequestChangeRequestStmt != nil {
+		if cerr := q.batchCreatePastRequestChangeRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing batchCreatePastRequestChangeRequestStmt: %w", cerr)
+		}
+	}
 	if q.batchCreateRequestChangeRequestStmt != nil {
 		if cerr := q.batchCreateRequestChangeRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing batchCreateRequestChangeRequestStmt: %w", cerr)
@@ -405,6 +610,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing batchDeleteOpenRequestChangeRequestStmt: %w", cerr)
 		}
 	}
+	if q.batchDeleteRequestChangeRequestStmt != nil {
+		if cerr := q.batchDeleteRequestChangeRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing batchDeleteRequestChangeRequestStmt: %w", cerr)
+		}
+	}
+	if q.clearCurrentActorImagePlayerPropertiesForPlayerStmt != nil {
+		if cerr := q.clearCurrentActorImagePlayerPropertiesForPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearCurrentActorImagePlayerPropertiesForPlayerStmt: %w", cerr)
+		}
+	}
+	if q.clearPrimaryEmailStmt != nil {
+		if cerr := q.clearPrimaryEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearPrimaryEmailStmt: %w", cerr)
+		}
+	}
 	if q.countCurrentActorImagePlayerPropertiesForPlayerStmt != nil {
 		if cerr := q.countCurrentActorImagePlayerPropertiesForPlayerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countCurrentActorImagePlayerPropertiesForPlayerStmt: %w", cerr)
@@ -420,6 +640,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countOpenRequestChangeRequestsForRequestStmt: %w", cerr)
 		}
 	}
+	if q.countUniqueActorImagesWithCharacterNameStmt != nil {
+		if cerr := q.countUniqueActorImagesWithCharacterNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUniqueActorImagesWithCharacterNameStmt: %w", cerr)
+		}
+	}
+	if q.countZoneBuilderStmt != nil {
+		if cerr := q.countZoneBuilderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countZoneBuilderStmt: %w", cerr)
+		}
+	}
 	if q.createActorImageStmt != nil {
 		if cerr := q.createActorImageStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createActorImageStmt: %w", cerr)
@@ -475,6 +705,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createActorImagePrimaryHandStmt: %w", cerr)
 		}
 	}
+	if q.createCharacterNameReservationStmt != nil {
+		if cerr := q.createCharacterNameReservationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCharacterNameReservationStmt: %w", cerr)
+		}
+	}
 	if q.createEmailStmt != nil {
 		if cerr := q.createEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createEmailStmt: %w", cerr)
@@ -510,11 +745,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createPlayerPermissionRevokedChangeHistoryStmt: %w", cerr)
 		}
 	}
+	if q.createPlayerRecoveryCodeStmt != nil {
+		if cerr := q.createPlayerRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlayerRecoveryCodeStmt: %w", cerr)
+		}
+	}
+	if q.createPlayerRoleStmt != nil {
+		if cerr := q.createPlayerRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlayerRoleStmt: %w", cerr)
+		}
+	}
+	if q.createPlayerRoleIssuedChangeHistoryStmt != nil {
+		if cerr := q.createPlayerRoleIssuedChangeHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlayerRoleIssuedChangeHistoryStmt: %w", cerr)
+		}
+	}
+	if q.createPlayerRoleRevokedChangeHistoryStmt != nil {
+		if cerr := q.createPlayerRoleRevokedChangeHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlayerRoleRevokedChangeHistoryStmt: %w", cerr)
+		}
+	}
 	if q.createPlayerSettingsStmt != nil {
 		if cerr := q.createPlayerSettingsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createPlayerSettingsStmt: %w", cerr)
 		}
 	}
+	if q.createPlayerTwoFactorStmt != nil {
+		if cerr := q.createPlayerTwoFactorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlayerTwoFactorStmt: %w", cerr)
+		}
+	}
 	if q.createRequestStmt != nil {
 		if cerr := q.createRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createRequestStmt: %w", cerr)
@@ -525,21 +785,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createRequestChangeRequestStmt: %w", cerr)
 		}
 	}
+	if q.createRequestDeletionStmt != nil {
+		if cerr := q.createRequestDeletionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRequestDeletionStmt: %w", cerr)
+		}
+	}
 	if q.createRequestFieldStmt != nil {
 		if cerr := q.createRequestFieldStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createRequestFieldStmt: %w", cerr)
 		}
 	}
+	if q.createRequestFieldCommentStmt != nil {
+		if cerr := q.createRequestFieldCommentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRequestFieldCommentStmt: %w", cerr)
+		}
+	}
+	if q.createRequestFieldCommentResolutionStmt != nil {
+		if cerr := q.createRequestFieldCommentResolutionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRequestFieldCommentResolutionStmt: %w", cerr)
+		}
+	}
+	if q.createRequestFieldVersionStmt != nil {
+		if cerr := q.createRequestFieldVersionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRequestFieldVersionStmt: %w", cerr)
+		}
+	}
+	if q.createRequestStatusHistoryStmt != nil {
+		if cerr := q.createRequestStatusHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRequestStatusHistoryStmt: %w", cerr)
+		}
+	}
 	if q.createRequestSubfieldStmt != nil {
 		if cerr := q.createRequestSubfieldStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createRequestSubfieldStmt: %w", cerr)
 		}
 	}
+	if q.createRequestSubfieldVersionStmt != nil {
+		if cerr := q.createRequestSubfieldVersionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRequestSubfieldVersionStmt: %w", cerr)
+		}
+	}
 	if q.createRoomStmt != nil {
 		if cerr := q.createRoomStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createRoomStmt: %w", cerr)
 		}
 	}
+	if q.createZoneStmt != nil {
+		if cerr := q.createZoneStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createZoneStmt: %w", cerr)
+		}
+	}
 	if q.deleteActorImageCanStmt != nil {
 		if cerr := q.deleteActorImageCanStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteActorImageCanStmt: %w", cerr)
@@ -575,6 +870,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteActorImagePrimaryHandStmt: %w", cerr)
 		}
 	}
+	if q.deleteCharacterNameReservationForRequestStmt != nil {
+		if cerr := q.deleteCharacterNameReservationForRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCharacterNameReservationForRequestStmt: %w", cerr)
+		}
+	}
 	if q.deleteEmailStmt != nil {
 		if cerr := q.deleteEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteEmailStmt: %w", cerr)
@@ -590,21 +890,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deletePlayerPermissionStmt: %w", cerr)
 		}
 	}
+	if q.deletePlayerRecoveryCodesStmt != nil {
+		if cerr := q.deletePlayerRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePlayerRecoveryCodesStmt: %w", cerr)
+		}
+	}
+	if q.deletePlayerRoleStmt != nil {
+		if cerr := q.deletePlayerRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePlayerRoleStmt: %w", cerr)
+		}
+	}
+	if q.deletePlayerTwoFactorStmt != nil {
+		if cerr := q.deletePlayerTwoFactorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePlayerTwoFactorStmt: %w", cerr)
+		}
+	}
 	if q.deleteRequestChangeRequestStmt != nil {
 		if cerr := q.deleteRequestChangeRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteRequestChangeRequestStmt: %w", cerr)
 		}
 	}
+	if q.deleteRequestDeletionStmt != nil {
+		if cerr := q.deleteRequestDeletionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRequestDeletionStmt: %w", cerr)
+		}
+	}
+	if q.deleteRequestFieldCommentResolutionStmt != nil {
+		if cerr := q.deleteRequestFieldCommentResolutionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRequestFieldCommentResolutionStmt: %w", cerr)
+		}
+	}
 	if q.deleteRequestSubfieldStmt != nil {
 		if cerr := q.deleteRequestSubfieldStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteRequestSubfieldStmt: %w", cerr)
 		}
 	}
+	if q.deleteRoomExitStmt != nil {
+		if cerr := q.deleteRoomExitStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRoomExitStmt: %w", cerr)
+		}
+	}
 	if q.editOpenRequestChangeRequestStmt != nil {
 		if cerr := q.editOpenRequestChangeRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing editOpenRequestChangeRequestStmt: %w", cerr)
 		}
 	}
+	if q.enablePlayerTwoFactorStmt != nil {
+		if cerr := q.enablePlayerTwoFactorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing enablePlayerTwoFactorStmt: %w", cerr)
+		}
+	}
 	if q.getActorImageStmt != nil {
 		if cerr := q.getActorImageStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getActorImageStmt: %w", cerr)
@@ -635,6 +970,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getActorImagePlayerPropertiesForImageStmt: %w", cerr)
 		}
 	}
+	if q.getCharacterNameReservationStmt != nil {
+		if cerr := q.getCharacterNameReservationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterNameReservationStmt: %w", cerr)
+		}
+	}
 	if q.getEmailStmt != nil {
 		if cerr := q.getEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEmailStmt: %w", cerr)
@@ -655,6 +995,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getHelpRelatedStmt: %w", cerr)
 		}
 	}
+	if q.getLatestRequestStatusHistoryToStmt != nil {
+		if cerr := q.getLatestRequestStatusHistoryToStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLatestRequestStatusHistoryToStmt: %w", cerr)
+		}
+	}
 	if q.getOpenRequestChangeRequestStmt != nil {
 		if cerr := q.getOpenRequestChangeRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOpenRequestChangeRequestStmt: %w", cerr)
@@ -680,6 +1025,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPlayerSettingsStmt: %w", cerr)
 		}
 	}
+	if q.getPlayerTwoFactorStmt != nil {
+		if cerr := q.getPlayerTwoFactorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlayerTwoFactorStmt: %w", cerr)
+		}
+	}
 	if q.getPlayerUsernameStmt != nil {
 		if cerr := q.getPlayerUsernameStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPlayerUsernameStmt: %w", cerr)
@@ -690,6 +1040,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPlayerUsernameByIdStmt: %w", cerr)
 		}
 	}
+	if q.getPrimaryEmailStmt != nil {
+		if cerr := q.getPrimaryEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPrimaryEmailStmt: %w", cerr)
+		}
+	}
 	if q.getRequestStmt != nil {
 		if cerr := q.getRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRequestStmt: %w", cerr)
@@ -700,6 +1055,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRequestChangeRequestByFieldIDStmt: %w", cerr)
 		}
 	}
+	if q.getRequestDeletionStmt != nil {
+		if cerr := q.getRequestDeletionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRequestDeletionStmt: %w", cerr)
+		}
+	}
 	if q.getRequestFieldStmt != nil {
 		if cerr := q.getRequestFieldStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRequestFieldStmt: %w", cerr)
@@ -715,6 +1075,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRequestFieldByTypeWithChangeRequestsStmt: %w", cerr)
 		}
 	}
+	if q.getRequestFieldCommentReadStmt != nil {
+		if cerr := q.getRequestFieldCommentReadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRequestFieldCommentReadStmt: %w", cerr)
+		}
+	}
+	if q.getRequestFieldCommentResolutionStmt != nil {
+		if cerr := q.getRequestFieldCommentResolutionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRequestFieldCommentResolutionStmt: %w", cerr)
+		}
+	}
+	if q.getRequestFieldVersionBeforeStmt != nil {
+		if cerr := q.getRequestFieldVersionBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRequestFieldVersionBeforeStmt: %w", cerr)
+		}
+	}
+	if q.getRequestForUpdateStmt != nil {
+		if cerr := q.getRequestForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRequestForUpdateStmt: %w", cerr)
+		}
+	}
 	if q.getRequestSubfieldStmt != nil {
 		if cerr := q.getRequestSubfieldStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRequestSubfieldStmt: %w", cerr)
@@ -725,6 +1105,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRoomStmt: %w", cerr)
 		}
 	}
+	if q.getRoomExitStmt != nil {
+		if cerr := q.getRoomExitStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRoomExitStmt: %w", cerr)
+		}
+	}
+	if q.getSiteSettingStmt != nil {
+		if cerr := q.getSiteSettingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSiteSettingStmt: %w", cerr)
+		}
+	}
 	if q.getTagsForHelpFileStmt != nil {
 		if cerr := q.getTagsForHelpFileStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTagsForHelpFileStmt: %w", cerr)
@@ -735,6 +1125,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getVerifiedEmailByAddressStmt: %w", cerr)
 		}
 	}
+	if q.getZoneStmt != nil {
+		if cerr := q.getZoneStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getZoneStmt: %w", cerr)
+		}
+	}
 	if q.listActorImageCanStmt != nil {
 		if cerr := q.listActorImageCanStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listActorImageCanStmt: %w", cerr)
@@ -765,6 +1160,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listActorImagesPrimaryHandsStmt: %w", cerr)
 		}
 	}
+	if q.listAllRoomExitsStmt != nil {
+		if cerr := q.listAllRoomExitsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAllRoomExitsStmt: %w", cerr)
+		}
+	}
+	if q.listCharactersForPlayerStmt != nil {
+		if cerr := q.listCharactersForPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCharactersForPlayerStmt: %w", cerr)
+		}
+	}
+	if q.listDeletedRequestsForPlayerStmt != nil {
+		if cerr := q.listDeletedRequestsForPlayerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDeletedRequestsForPlayerStmt: %w", cerr)
+		}
+	}
 	if q.listEmailsStmt != nil {
 		if cerr := q.listEmailsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listEmailsStmt: %w", cerr)
@@ -790,16 +1200,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listOpenRequestChangeRequestsForRequestStmt: %w", cerr)
 		}
 	}
+	if q.listPastRequestChangeRequestsForFieldStmt != nil {
+		if cerr := q.listPastRequestChangeRequestsForFieldStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPastRequestChangeRequestsForFieldStmt: %w", cerr)
+		}
+	}
+	if q.listPlayerPasswordHashesStmt != nil {
+		if cerr := q.listPlayerPasswordHashesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPlayerPasswordHashesStmt: %w", cerr)
+		}
+	}
+	if q.listPlayerPermissionChangeHistoryStmt != nil {
+		if cerr := q.listPlayerPermissionChangeHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPlayerPermissionChangeHistoryStmt: %w", cerr)
+		}
+	}
 	if q.listPlayerPermissionsStmt != nil {
 		if cerr := q.listPlayerPermissionsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listPlayerPermissionsStmt: %w", cerr)
 		}
 	}
+	if q.listPlayerRoleChangeHistoryStmt != nil {
+		if cerr := q.listPlayerRoleChangeHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPlayerRoleChangeHistoryStmt: %w", cerr)
+		}
+	}
+	if q.listPlayerRolesStmt != nil {
+		if cerr := q.listPlayerRolesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPlayerRolesStmt: %w", cerr)
+		}
+	}
 	if q.listRequestChangeRequestsByFieldIDStmt != nil {
 		if cerr := q.listRequestChangeRequestsByFieldIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listRequestChangeRequestsByFieldIDStmt: %w", cerr)
 		}
 	}
+	if q.listRequestChangeRequestsForRequestStmt != nil {
+		if cerr := q.listRequestChangeRequestsForRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRequestChangeRequestsForRequestStmt: %w", cerr)
+		}
+	}
+	if q.listRequestFieldCommentsForFieldStmt != nil {
+		if cerr := q.listRequestFieldCommentsForFieldStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRequestFieldCommentsForFieldStmt: %w", cerr)
+		}
+	}
+	if q.listRequestFieldVersionsForFieldStmt != nil {
+		if cerr := q.listRequestFieldVersionsForFieldStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRequestFieldVersionsForFieldStmt: %w", cerr)
+		}
+	}
 	if q.listRequestFieldsForRequestStmt != nil {
 		if cerr := q.listRequestFieldsForRequestStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listRequestFieldsForRequestStmt: %w", cerr)
@@ -810,6 +1260,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listRequestFieldsForRequestWithChangeRequestsStmt: %w", cerr)
 		}
 	}
+	if q.listRequestQueueStmt != nil {
+		if cerr := q.listRequestQueueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRequestQueueStmt: %w", cerr)
+		}
+	}
+	if q.listRequestStatusHistoryForRequestStmt != nil {
+		if cerr := q.listRequestStatusHistoryForRequestStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRequestStatusHistoryForRequestStmt: %w", cerr)
+		}
+	}
+	if q.listRequestSubfieldVersionsBeforeStmt != nil {
+		if cerr := q.listRequestSubfieldVersionsBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRequestSubfieldVersionsBeforeStmt: %w", cerr)
+		}
+	}
 	if q.listRequestSubfieldsForFieldStmt != nil {
 		if cerr := q.listRequestSubfieldsForFieldStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listRequestSubfieldsForFieldStmt: %w", cerr)
@@ -830,6 +1295,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listRequestsForPlayerStmt: %w", cerr)
 		}
 	}
+	if q.listRoomExitsStmt != nil {
+		if cerr := q.listRoomExitsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRoomExitsStmt: %w", cerr)
+		}
+	}
+	if q.listRoomExitsByRoomIDsStmt != nil {
+		if cerr := q.listRoomExitsByRoomIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRoomExitsByRoomIDsStmt: %w", cerr)
+		}
+	}
 	if q.listRoomsStmt != nil {
 		if cerr := q.listRoomsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listRoomsStmt: %w", cerr)
@@ -840,16 +1315,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listRoomsByIDsStmt: %w", cerr)
 		}
 	}
+	if q.listRoomsByZoneStmt != nil {
+		if cerr := q.listRoomsByZoneStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRoomsByZoneStmt: %w", cerr)
+		}
+	}
+	if q.listStaleInReviewRequestsStmt != nil {
+		if cerr := q.listStaleInReviewRequestsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listStaleInReviewRequestsStmt: %w", cerr)
+		}
+	}
+	if q.listUnusedPlayerRecoveryCodesStmt != nil {
+		if cerr := q.listUnusedPlayerRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUnusedPlayerRecoveryCodesStmt: %w", cerr)
+		}
+	}
 	if q.listVerifiedEmailsStmt != nil {
 		if cerr := q.listVerifiedEmailsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listVerifiedEmailsStmt: %w", cerr)
 		}
 	}
+	if q.listZoneBuildersStmt != nil {
+		if cerr := q.listZoneBuildersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listZoneBuildersStmt: %w", cerr)
+		}
+	}
+	if q.listZonesStmt != nil {
+		if cerr := q.listZonesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listZonesStmt: %w", cerr)
+		}
+	}
+	if q.markEmailPrimaryStmt != nil {
+		if cerr := q.markEmailPrimaryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markEmailPrimaryStmt: %w", cerr)
+		}
+	}
 	if q.markEmailVerifiedStmt != nil {
 		if cerr := q.markEmailVerifiedStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing markEmailVerifiedStmt: %w", cerr)
 		}
 	}
+	if q.markRequestFieldCommentsReadStmt != nil {
+		if cerr := q.markRequestFieldCommentsReadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markRequestFieldCommentsReadStmt: %w", cerr)
+		}
+	}
+	if q.removeZoneBuilderStmt != nil {
+		if cerr := q.removeZoneBuilderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeZoneBuilderStmt: %w", cerr)
+		}
+	}
 	if q.searchHelpByCategoryStmt != nil {
 		if cerr := q.searchHelpByCategoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing searchHelpByCategoryStmt: %w", cerr)
@@ -885,6 +1400,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setActorImagePlayerPropertiesCurrentStmt: %w", cerr)
 		}
 	}
+	if q.setActorImagePlayerPropertiesRetiredStmt != nil {
+		if cerr := q.setActorImagePlayerPropertiesRetiredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setActorImagePlayerPropertiesRetiredStmt: %w", cerr)
+		}
+	}
+	if q.setRoomExitStmt != nil {
+		if cerr := q.setRoomExitStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setRoomExitStmt: %w", cerr)
+		}
+	}
+	if q.setSiteSettingStmt != nil {
+		if cerr := q.setSiteSettingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setSiteSettingStmt: %w", cerr)
+		}
+	}
 	if q.updateActorImageDescriptionStmt != nil {
 		if cerr := q.updateActorImageDescriptionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateActorImageDescriptionStmt: %w", cerr)
@@ -910,6 +1440,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updatePlayerSettingsThemeStmt: %w", cerr)
 		}
 	}
+	if q.updatePlayerTwoFactorLastStepStmt != nil {
+		if cerr := q.updatePlayerTwoFactorLastStepStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePlayerTwoFactorLastStepStmt: %w", cerr)
+		}
+	}
 	if q.updateRequestFieldStatusStmt != nil {
 		if cerr := q.updateRequestFieldStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateRequestFieldStatusStmt: %w", cerr)
@@ -950,49 +1485,19 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateRoomStmt: %w", cerr)
 		}
 	}
+	if q.updateRoomCoordinatesStmt != nil {
+		if cerr := q.updateRoomCoordinatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateRoomCoordinatesStmt: %w", cerr)
+		}
+	}
 	if q.updateRoomDescriptionStmt != nil {
 		if cerr := q.updateRoomDescriptionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateRoomDescriptionStmt: %w", cerr)
 		}
 	}
-	if q.updateRoomExitEastStmt != nil {
-		if cerr := q.updateRoomExitEastStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitEastStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitNorthStmt != nil {
-		if cerr := q.updateRoomExitNorthStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitNorthStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitNortheastStmt != nil {
-		if cerr := q.updateRoomExitNortheastStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitNortheastStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitNorthwestStmt != nil {
-		if cerr := q.updateRoomExitNorthwestStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitNorthwestStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitSouthStmt != nil {
-		if cerr := q.updateRoomExitSouthStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitSouthStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitSoutheastStmt != nil {
-		if cerr := q.updateRoomExitSoutheastStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitSoutheastStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitSouthwestStmt != nil {
-		if cerr := q.updateRoomExitSouthwestStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitSouthwestStmt: %w", cerr)
-		}
-	}
-	if q.updateRoomExitWestStmt != nil {
-		if cerr := q.updateRoomExitWestStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateRoomExitWestStmt: %w", cerr)
+	if q.updateRoomExitFlagsStmt != nil {
+		if cerr := q.updateRoomExitFlagsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateRoomExitFlagsStmt: %w", cerr)
 		}
 	}
 	if q.updateRoomSizeStmt != nil {
@@ -1005,6 +1510,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateRoomTitleStmt: %w", cerr)
 		}
 	}
+	if q.updateRoomZoneStmt != nil {
+		if cerr := q.updateRoomZoneStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateRoomZoneStmt: %w", cerr)
+		}
+	}
+	if q.updateZoneOwnerStmt != nil {
+		if cerr := q.updateZoneOwnerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateZoneOwnerStmt: %w", cerr)
+		}
+	}
+	if q.usePlayerRecoveryCodeStmt != nil {
+		if cerr := q.usePlayerRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing usePlayerRecoveryCodeStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -1044,11 +1564,18 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                                  DBTX
 	tx                                                  *sql.Tx
+	addZoneBuilderStmt                                  *sql.Stmt
+	batchCreatePastRequestChangeRequestStmt             *sql.Stmt
 	batchCreateRequestChangeRequestStmt                 *sql.Stmt
 	batchDeleteOpenRequestChangeRequestStmt             *sql.Stmt
+	batchDeleteRequestChangeRequestStmt                 *sql.Stmt
+	clearCurrentActorImagePlayerPropertiesForPlayerStmt *sql.Stmt
+	clearPrimaryEmailStmt                               *sql.Stmt
 	countCurrentActorImagePlayerPropertiesForPlayerStmt *sql.Stmt
 	countEmailsStmt                                     *sql.Stmt
 	countOpenRequestChangeRequestsForRequestStmt        *sql.Stmt
+	countUniqueActorImagesWithCharacterNameStmt         *sql.Stmt
+	countZoneBuilderStmt                                *sql.Stmt
 	createActorImageStmt                                *sql.Stmt
 	createActorImageCanStmt                             *sql.Stmt
 	createActorImageCanBeStmt                           *sql.Stmt
@@ -1060,6 +1587,7 @@ type Queries struct {
 	createActorImageKeywordStmt                         *sql.Stmt
 	createActorImagePlayerPropertiesStmt                *sql.Stmt
 	createActorImagePrimaryHandStmt                     *sql.Stmt
+	createCharacterNameReservationStmt                  *sql.Stmt
 	createEmailStmt                                     *sql.Stmt
 	createOpenRequestChangeRequestStmt                  *sql.Stmt
 	createPastRequestChangeRequestStmt                  *sql.Stmt
@@ -1067,12 +1595,24 @@ type Queries struct {
 	createPlayerPermissionStmt                          *sql.Stmt
 	createPlayerPermissionIssuedChangeHistoryStmt       *sql.Stmt
 	createPlayerPermissionRevokedChangeHistoryStmt      *sql.Stmt
+	createPlayerRecoveryCodeStmt                        *sql.Stmt
+	createPlayerRoleStmt                                *sql.Stmt
+	createPlayerRoleIssuedChangeHistoryStmt             *sql.Stmt
+	createPlayerRoleRevokedChangeHistoryStmt            *sql.Stmt
 	createPlayerSettingsStmt                            *sql.Stmt
+	createPlayerTwoFactorStmt                           *sql.Stmt
 	createRequestStmt                                   *sql.Stmt
 	createRequestChangeRequestStmt                      *sql.Stmt
+	createRequestDeletionStmt                           *sql.Stmt
 	createRequestFieldStmt                              *sql.Stmt
+	createRequestFieldCommentStmt                       *sql.Stmt
+	createRequestFieldCommentResolutionStmt             *sql.Stmt
+	createRequestFieldVersionStmt                       *sql.Stmt
+	createRequestStatusHistoryStmt                      *sql.Stmt
 	createRequestSubfieldStmt                           *sql.Stmt
+	createRequestSubfieldVersionStmt                    *sql.Stmt
 	createRoomStmt                                      *sql.Stmt
+	createZoneStmt                                      *sql.Stmt
 	deleteActorImageCanStmt                             *sql.Stmt
 	deleteActorImageCanBeStmt                           *sql.Stmt
 	deleteActorImageContainerPropertiesStmt             *sql.Stmt
@@ -1080,61 +1620,105 @@ type Queries struct {
 	deleteActorImageFurniturePropertiesStmt             *sql.Stmt
 	deleteActorImageHandStmt                            *sql.Stmt
 	deleteActorImagePrimaryHandStmt                     *sql.Stmt
+	deleteCharacterNameReservationForRequestStmt        *sql.Stmt
 	deleteEmailStmt                                     *sql.Stmt
 	deleteOpenRequestChangeRequestStmt                  *sql.Stmt
 	deletePlayerPermissionStmt                          *sql.Stmt
+	deletePlayerRecoveryCodesStmt                       *sql.Stmt
+	deletePlayerRoleStmt                                *sql.Stmt
+	deletePlayerTwoFactorStmt                           *sql.Stmt
 	deleteRequestChangeRequestStmt                      *sql.Stmt
+	deleteRequestDeletionStmt                           *sql.Stmt
+	deleteRequestFieldCommentResolutionStmt             *sql.Stmt
 	deleteRequestSubfieldStmt                           *sql.Stmt
+	deleteRoomExitStmt                                  *sql.Stmt
 	editOpenRequestChangeRequestStmt                    *sql.Stmt
+	enablePlayerTwoFactorStmt                           *sql.Stmt
 	getActorImageStmt                                   *sql.Stmt
 	getActorImageByNameStmt                             *sql.Stmt
 	getActorImageContainerPropertiesStmt                *sql.Stmt
 	getActorImageFoodPropertiesStmt                     *sql.Stmt
 	getActorImageFurniturePropertiesStmt                *sql.Stmt
 	getActorImagePlayerPropertiesForImageStmt           *sql.Stmt
+	getCharacterNameReservationStmt                     *sql.Stmt
 	getEmailStmt                                        *sql.Stmt
 	getEmailByAddressForPlayerStmt                      *sql.Stmt
 	getHelpStmt                                         *sql.Stmt
 	getHelpRelatedStmt                                  *sql.Stmt
+	getLatestRequestStatusHistoryToStmt                 *sql.Stmt
 	getOpenRequestChangeRequestStmt                     *sql.Stmt
 	getOpenRequestChangeRequestForRequestFieldStmt      *sql.Stmt
 	getPlayerStmt                                       *sql.Stmt
 	getPlayerByUsernameStmt                             *sql.Stmt
 	getPlayerSettingsStmt                               *sql.Stmt
+	getPlayerTwoFactorStmt                              *sql.Stmt
 	getPlayerUsernameStmt                               *sql.Stmt
 	getPlayerUsernameByIdStmt                           *sql.Stmt
+	getPrimaryEmailStmt                                 *sql.Stmt
 	getRequestStmt                                      *sql.Stmt
 	getRequestChangeRequestByFieldIDStmt                *sql.Stmt
+	getRequestDeletionStmt                              *sql.Stmt
 	getRequestFieldStmt                                 *sql.Stmt
 	getRequestFieldByTypeStmt                           *sql.Stmt
 	getRequestFieldByTypeWithChangeRequestsStmt         *sql.Stmt
+	getRequestFieldCommentReadStmt                      *sql.Stmt
+	getRequestFieldCommentResolutionStmt                *sql.Stmt
+	getRequestFieldVersionBeforeStmt                    *sql.Stmt
+	getRequestForUpdateStmt                             *sql.Stmt
 	getRequestSubfieldStmt                              *sql.Stmt
 	getRoomStmt                                         *sql.Stmt
+	getRoomExitStmt                                     *sql.Stmt
+	getSiteSettingStmt                                  *sql.Stmt
 	getTagsForHelpFileStmt                              *sql.Stmt
 	getVerifiedEmailByAddressStmt                       *sql.Stmt
+	getZoneStmt                                         *sql.Stmt
 	listActorImageCanStmt                               *sql.Stmt
 	listActorImageCanBeStmt                             *sql.Stmt
 	listActorImageKeywordsStmt                          *sql.Stmt
 	listActorImagesStmt                                 *sql.Stmt
 	listActorImagesHandsStmt                            *sql.Stmt
 	listActorImagesPrimaryHandsStmt                     *sql.Stmt
+	listAllRoomExitsStmt                                *sql.Stmt
+	listCharactersForPlayerStmt                         *sql.Stmt
+	listDeletedRequestsForPlayerStmt                    *sql.Stmt
 	listEmailsStmt                                      *sql.Stmt
 	listHelpHeadersStmt                                 *sql.Stmt
 	listHelpSlugsStmt                                   *sql.Stmt
 	listOpenRequestChangeRequestsByFieldIDStmt          *sql.Stmt
 	listOpenRequestChangeRequestsForRequestStmt         *sql.Stmt
+	listPastRequestChangeRequestsForFieldStmt           *sql.Stmt
+	listPlayerPasswordHashesStmt                        *sql.Stmt
+	listPlayerPermissionChangeHistoryStmt               *sql.Stmt
 	listPlayerPermissionsStmt                           *sql.Stmt
+	listPlayerRoleChangeHistoryStmt                     *sql.Stmt
+	listPlayerRolesStmt                                 *sql.Stmt
 	listRequestChangeRequestsByFieldIDStmt              *sql.Stmt
+	listRequestChangeRequestsForRequestStmt             *sql.Stmt
+	listRequestFieldCommentsForFieldStmt                *sql.Stmt
+	listRequestFieldVersionsForFieldStmt                *sql.Stmt
 	listRequestFieldsForRequestStmt                     *sql.Stmt
 	listRequestFieldsForRequestWithChangeRequestsStmt   *sql.Stmt
+	listRequestQueueStmt                                *sql.Stmt
+	listRequestStatusHistoryForRequestStmt              *sql.Stmt
+	listRequestSubfieldVersionsBeforeStmt               *sql.Stmt
 	listRequestSubfieldsForFieldStmt                    *sql.Stmt
 	listRequestSubfieldsForFieldsStmt                   *sql.Stmt
 	listRequestsByTypeAndStatusStmt                     *sql.Stmt
 	listRequestsForPlayerStmt                           *sql.Stmt
+	listRoomExitsStmt                                   *sql.Stmt
+	listRoomExitsByRoomIDsStmt                          *sql.Stmt
 	listRoomsStmt                                       *sql.Stmt
 	listRoomsByIDsStmt                                  *sql.Stmt
+	listRoomsByZoneStmt                                 *sql.Stmt
+	listStaleInReviewRequestsStmt                       *sql.Stmt
+	listUnusedPlayerRecoveryCodesStmt                   *sql.Stmt
 	listVerifiedEmailsStmt                              *sql.Stmt
+	listZoneBuildersStmt                                *sql.Stmt
+	listZonesStmt                                       *sql.Stmt
+	markEmailPrimaryStmt                                *sql.Stmt
 	markEmailVerifiedStmt                               *sql.Stmt
+	markRequestFieldCommentsReadStmt                    *sql.Stmt
+	removeZoneBuilderStmt                               *sql.Stmt
 	searchHelpByCategoryStmt                            *sql.Stmt
 	searchHelpByContentStmt                             *sql.Stmt
 	searchHelpByTagsStmt                                *sql.Stmt
@@ -1142,11 +1726,15 @@ type Queries struct {
 	searchPlayersByUsernameStmt                         *sql.Stmt
 	searchTagsStmt                                      *sql.Stmt
 	setActorImagePlayerPropertiesCurrentStmt            *sql.Stmt
+	setActorImagePlayerPropertiesRetiredStmt            *sql.Stmt
+	setRoomExitStmt                                     *sql.Stmt
+	setSiteSettingStmt                                  *sql.Stmt
 	updateActorImageDescriptionStmt                     *sql.Stmt
 	updateActorImageShortDescriptionStmt                *sql.Stmt
 	updateActorImageUniqueStmt                          *sql.Stmt
 	updatePlayerPasswordStmt                            *sql.Stmt
 	updatePlayerSettingsThemeStmt                       *sql.Stmt
+	updatePlayerTwoFactorLastStepStmt                   *sql.Stmt
 	updateRequestFieldStatusStmt                        *sql.Stmt
 	updateRequestFieldStatusByRequestAndTypeStmt        *sql.Stmt
 	updateRequestFieldValueStmt                         *sql.Stmt
@@ -1155,144 +1743,206 @@ type Queries struct {
 	updateRequestStatusStmt                             *sql.Stmt
 	updateRequestSubfieldStmt                           *sql.Stmt
 	updateRoomStmt                                      *sql.Stmt
+	updateRoomCoordinatesStmt                           *sql.Stmt
 	updateRoomDescriptionStmt                           *sql.Stmt
-	updateRoomExitEastStmt                              *sql.Stmt
-	updateRoomExitNorthStmt                             *sql.Stmt
-	updateRoomExitNortheastStmt                         *sql.Stmt
-	updateRoomExitNorthwestStmt                         *sql.Stmt
-	updateRoomExitSouthStmt                             *sql.Stmt
-	updateRoomExitSoutheastStmt                         *sql.Stmt
-	updateRoomExitSouthwestStmt                         *sql.Stmt
-	updateRoomExitWestStmt                              *sql.Stmt
+	updateRoomExitFlagsStmt                             *sql.Stmt
 	updateRoomSizeStmt                                  *sql.Stmt
 	updateRoomTitleStmt                                 *sql.Stmt
+	updateRoomZoneStmt                                  *sql.Stmt
+	updateZoneOwnerStmt                                 *sql.Stmt
+	usePlayerRecoveryCodeStmt                           *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                                      tx,
 		tx:                                      tx,
+		addZoneBuilderStmt:                      q.addZoneBuilderStmt,
+		batchCreatePastRequestChangeRequestStmt: q.batchCreatePastRequestChangeRequestStmt,
 		batchCreateRequestChangeRequestStmt:     q.batchCreateRequestChangeRequestStmt,
 		batchDeleteOpenRequestChangeRequestStmt: q.batchDeleteOpenRequestChangeRequestStmt,
+		batchDeleteRequestChangeRequestStmt:     q.batchDeleteRequestChangeRequestStmt,
+		clearCurrentActorImagePlayerPropertiesForPlayerStmt: q.clearCurrentActorImagePlayerPropertiesForPlayerStmt,
+		clearPrimaryEmailStmt:                               q.clearPrimaryEmailStmt,
 		countCurrentActorImagePlayerPropertiesForPlayerStmt: q.countCurrentActorImagePlayerPropertiesForPlayerStmt,
-		countEmailsStmt: q.countEmailsStmt,
-		countOpenRequestChangeRequestsForRequestStmt:      q.countOpenRequestChangeRequestsForRequestStmt,
-		createActorImageStmt:                              q.createActorImageStmt,
-		createActorImageCanStmt:                           q.createActorImageCanStmt,
-		createActorImageCanBeStmt:                         q.createActorImageCanBeStmt,
-		createActorImageCharacterMetadataStmt:             q.createActorImageCharacterMetadataStmt,
-		createActorImageContainerPropertiesStmt:           q.createActorImageContainerPropertiesStmt,
-		createActorImageFoodPropertiesStmt:                q.createActorImageFoodPropertiesStmt,
-		createActorImageFurniturePropertiesStmt:           q.createActorImageFurniturePropertiesStmt,
-		createActorImageHandStmt:                          q.createActorImageHandStmt,
-		createActorImageKeywordStmt:                       q.createActorImageKeywordStmt,
-		createActorImagePlayerPropertiesStmt:              q.createActorImagePlayerPropertiesStmt,
-		createActorImagePrimaryHandStmt:                   q.createActorImagePrimaryHandStmt,
-		createEmailStmt:                                   q.createEmailStmt,
-		createOpenRequestChangeRequestStmt:                q.createOpenRequestChangeRequestStmt,
-		createPastRequestChangeRequestStmt:                q.createPastRequestChangeRequestStmt,
-		createPlayerStmt:                                  q.createPlayerStmt,
-		createPlayerPermissionStmt:                        q.createPlayerPermissionStmt,
-		createPlayerPermissionIssuedChangeHistoryStmt:     q.createPlayerPermissionIssuedChangeHistoryStmt,
-		createPlayerPermissionRevokedChangeHistoryStmt:    q.createPlayerPermissionRevokedChangeHistoryStmt,
-		createPlayerSettingsStmt:                          q.createPlayerSettingsStmt,
-		createRequestStmt:                                 q.createRequestStmt,
-		createRequestChangeRequestStmt:                    q.createRequestChangeRequestStmt,
-		createRequestFieldStmt:                            q.createRequestFieldStmt,
-		createRequestSubfieldStmt:                         q.createRequestSubfieldStmt,
-		createRoomStmt:                                    q.createRoomStmt,
-		deleteActorImageCanStmt:                           q.deleteActorImageCanStmt,
-		deleteActorImageCanBeStmt:                         q.deleteActorImageCanBeStmt,
-		deleteActorImageContainerPropertiesStmt:           q.deleteActorImageContainerPropertiesStmt,
-		deleteActorImageFoodPropertiesStmt:                q.deleteActorImageFoodPropertiesStmt,
-		deleteActorImageFurniturePropertiesStmt:           q.deleteActorImageFurniturePropertiesStmt,
-		deleteActorImageHandStmt:                          q.deleteActorImageHandStmt,
-		deleteActorImagePrimaryHandStmt:                   q.deleteActorImagePrimaryHandStmt,
-		deleteEmailStmt:                                   q.deleteEmailStmt,
-		deleteOpenRequestChangeRequestStmt:                q.deleteOpenRequestChangeRequestStmt,
-		deletePlayerPermissionStmt:                        q.deletePlayerPermissionStmt,
-		deleteRequestChangeRequestStmt:                    q.deleteRequestChangeRequestStmt,
-		deleteRequestSubfieldStmt:                         q.deleteRequestSubfieldStmt,
-		editOpenRequestChangeRequestStmt:                  q.editOpenRequestChangeRequestStmt,
-		getActorImageStmt:                                 q.getActorImageStmt,
-		getActorImageByNameStmt:                           q.getActorImageByNameStmt,
-		getActorImageContainerPropertiesStmt:              q.getActorImageContainerPropertiesStmt,
-		getActorImageFoodPropertiesStmt:                   q.getActorImageFoodPropertiesStmt,
-		getActorImageFurniturePropertiesStmt:              q.getActorImageFurniturePropertiesStmt,
-		getActorImagePlayerPropertiesForImageStmt:         q.getActorImagePlayerPropertiesForImageStmt,
-		getEmailStmt:                                      q.getEmailStmt,
-		getEmailByAddressForPlayerStmt:                    q.getEmailByAddressForPlayerStmt,
-		getHelpStmt:                                       q.getHelpStmt,
-		getHelpRelatedStmt:                                q.getHelpRelatedStmt,
-		getOpenRequestChangeRequestStmt:                   q.getOpenRequestChangeRequestStmt,
-		getOpenRequestChangeRequestForRequestFieldStmt:    q.getOpenRequestChangeRequestForRequestFieldStmt,
-		getPlayerStmt:                                     q.getPlayerStmt,
-		getPlayerByUsernameStmt:                           q.getPlayerByUsernameStmt,
-		getPlayerSettingsStmt:                             q.getPlayerSettingsStmt,
-		getPlayerUsernameStmt:                             q.getPlayerUsernameStmt,
-		getPlayerUsernameByIdStmt:                         q.getPlayerUsernameByIdStmt,
-		getRequestStmt:                                    q.getRequestStmt,
-		getRequestChangeRequestByFieldIDStmt:              q.getRequestChangeRequestByFieldIDStmt,
-		getRequestFieldStmt:                               q.getRequestFieldStmt,
-		getRequestFieldByTypeStmt:                         q.getRequestFieldByTypeStmt,
-		getRequestFieldByTypeWithChangeRequestsStmt:       q.getRequestFieldByTypeWithChangeRequestsStmt,
-		getRequestSubfieldStmt:                            q.getRequestSubfieldStmt,
-		getRoomStmt:                                       q.getRoomStmt,
-		getTagsForHelpFileStmt:                            q.getTagsForHelpFileStmt,
-		getVerifiedEmailByAddressStmt:                     q.getVerifiedEmailByAddressStmt,
-		listActorImageCanStmt:                             q.listActorImageCanStmt,
-		listActorImageCanBeStmt:                           q.listActorImageCanBeStmt,
-		listActorImageKeywordsStmt:                        q.listActorImageKeywordsStmt,
-		listActorImagesStmt:                               q.listActorImagesStmt,
-		listActorImagesHandsStmt:                          q.listActorImagesHandsStmt,
-		listActorImagesPrimaryHandsStmt:                   q.listActorImagesPrimaryHandsStmt,
-		listEmailsStmt:                                    q.listEmailsStmt,
-		listHelpHeadersStmt:                               q.listHelpHeadersStmt,
-		listHelpSlugsStmt:                                 q.listHelpSlugsStmt,
-		listOpenRequestChangeRequestsByFieldIDStmt:        q.listOpenRequestChangeRequestsByFieldIDStmt,
-		listOpenRequestChangeRequestsForRequestStmt:       q.listOpenRequestChangeRequestsForRequestStmt,
-		listPlayerPermissionsStmt:                         q.listPlayerPermissionsStmt,
-		listRequestChangeRequestsByFieldIDStmt:            q.listRequestChangeRequestsByFieldIDStmt,
-		listRequestFieldsForRequestStmt:                   q.listRequestFieldsForRequestStmt,
-		listRequestFieldsForRequestWithChangeRequestsStmt: q.listRequestFieldsForRequestWithChangeRequestsStmt,
-		listRequestSubfieldsForFieldStmt:                  q.listRequestSubfieldsForFieldStmt,
-		listRequestSubfieldsForFieldsStmt:                 q.listRequestSubfieldsForFieldsStmt,
-		listRequestsByTypeAndStatusStmt:                   q.listRequestsByTypeAndStatusStmt,
-		listRequestsForPlayerStmt:                         q.listRequestsForPlayerStmt,
-		listRoomsStmt:                                     q.listRoomsStmt,
-		listRoomsByIDsStmt:                                q.listRoomsByIDsStmt,
-		listVerifiedEmailsStmt:                            q.listVerifiedEmailsStmt,
-		markEmailVerifiedStmt:                             q.markEmailVerifiedStmt,
-		searchHelpByCategoryStmt:                          q.searchHelpByCategoryStmt,
-		searchHelpByContentStmt:                           q.searchHelpByContentStmt,
-		searchHelpByTagsStmt:                              q.searchHelpByTagsStmt,
-		searchHelpByTitleStmt:                             q.searchHelpByTitleStmt,
-		searchPlayersByUsernameStmt:                       q.searchPlayersByUsernameStmt,
-		searchTagsStmt:                                    q.searchTagsStmt,
-		setActorImagePlayerPropertiesCurrentStmt:          q.setActorImagePlayerPropertiesCurrentStmt,
-		updateActorImageDescriptionStmt:                   q.updateActorImageDescriptionStmt,
-		updateActorImageShortDescriptionStmt:              q.updateActorImageShortDescriptionStmt,
-		updateActorImageUniqueStmt:                        q.updateActorImageUniqueStmt,
-		updatePlayerPasswordStmt:                          q.updatePlayerPasswordStmt,
-		updatePlayerSettingsThemeStmt:                     q.updatePlayerSettingsThemeStmt,
-		updateRequestFieldStatusStmt:                      q.updateRequestFieldStatusStmt,
-		updateRequestFieldStatusByRequestAndTypeStmt:      q.updateRequestFieldStatusByRequestAndTypeStmt,
-		updateRequestFieldValueStmt:                       q.updateRequestFieldValueStmt,
-		updateRequestFieldValueByRequestAndTypeStmt:       q.updateRequestFieldValueByRequestAndTypeStmt,
-		updateRequestReviewerStmt:                         q.updateRequestReviewerStmt,
-		updateRequestStatusStmt:                           q.updateRequestStatusStmt,
-		updateRequestSubfieldStmt:                         q.updateRequestSubfieldStmt,
-		updateRoomStmt:                                    q.updateRoomStmt,
-		updateRoomDescriptionStmt:                         q.updateRoomDescriptionStmt,
-		updateRoomExitEastStmt:                            q.updateRoomExitEastStmt,
-		updateRoomExitNorthStmt:                           q.updateRoomExitNorthStmt,
-		updateRoomExitNortheastStmt:                       q.updateRoomExitNortheastStmt,
-		updateRoomExitNorthwestStmt:                       q.updateRoomExitNorthwestStmt,
-		updateRoomExitSouthStmt:                           q.updateRoomExitSouthStmt,
-		updateRoomExitSoutheastStmt:                       q.updateRoomExitSoutheastStmt,
-		updateRoomExitSouthwestStmt:                       q.updateRoomExitSouthwestStmt,
-		updateRoomExitWestStmt:                            q.updateRoomExitWestStmt,
-		updateRoomSizeStmt:                                q.updateRoomSizeStmt,
-		updateRoomTitleStmt:                               q.updateRoomTitleStmt,
+		countEmailsStmt:                                     q.countEmailsStmt,
+		countOpenRequestChangeRequestsForRequestStmt:        q.countOpenRequestChangeRequestsForRequestStmt,
+		countUniqueActorImagesWithCharacterNameStmt:         q.countUniqueActorImagesWithCharacterNameStmt,
+		countZoneBuilderStmt:                                q.countZoneBuilderStmt,
+		createActorImageStmt:                                q.createActorImageStmt,
+		createActorImageCanStmt:                             q.createActorImageCanStmt,
+		createActorImageCanBeStmt:                           q.createActorImageCanBeStmt,
+		createActorImageCharacterMetadataStmt:               q.createActorImageCharacterMetadataStmt,
+		createActorImageContainerPropertiesStmt:             q.createActorImageContainerPropertiesStmt,
+		createActorImageFoodPropertiesStmt:                  q.createActorImageFoodPropertiesStmt,
+		createActorImageFurniturePropertiesStmt:             q.createActorImageFurniturePropertiesStmt,
+		createActorImageHandStmt:                            q.createActorImageHandStmt,
+		createActorImageKeywordStmt:                         q.createActorImageKeywordStmt,
+		createActorImagePlayerPropertiesStmt:                q.createActorImagePlayerPropertiesStmt,
+		createActorImagePrimaryHandStmt:                     q.createActorImagePrimaryHandStmt,
+		createCharacterNameReservationStmt:                  q.createCharacterNameReservationStmt,
+		createEmailStmt:                                     q.createEmailStmt,
+		createOpenRequestChangeRequestStmt:                  q.createOpenRequestChangeRequestStmt,
+		createPastRequestChangeRequestStmt:                  q.createPastRequestChangeRequestStmt,
+		createPlayerStmt:                                    q.createPlayerStmt,
+		createPlayerPermissionStmt:                          q.createPlayerPermissionStmt,
+		createPlayerPermissionIssuedChangeHistoryStmt:       q.createPlayerPermissionIssuedChangeHistoryStmt,
+		createPlayerPermissionRevokedChangeHistoryStmt:      q.createPlayerPermissionRevokedChangeHistoryStmt,
+		createPlayerRecoveryCodeStmt:                        q.createPlayerRecoveryCodeStmt,
+		createPlayerRoleStmt:                                q.createPlayerRoleStmt,
+		createPlayerRoleIssuedChangeHistoryStmt:             q.createPlayerRoleIssuedChangeHistoryStmt,
+		createPlayerRoleRevokedChangeHistoryStmt:            q.createPlayerRoleRevokedChangeHistoryStmt,
+		createPlayerSettingsStmt:                            q.createPlayerSettingsStmt,
+		createPlayerTwoFactorStmt:                           q.createPlayerTwoFactorStmt,
+		createRequestStmt:                                   q.createRequestStmt,
+		createRequestChangeRequestStmt:                      q.createRequestChangeRequestStmt,
+		createRequestDeletionStmt:                           q.createRequestDeletionStmt,
+		createRequestFieldStmt:                              q.createRequestFieldStmt,
+		createRequestFieldCommentStmt:                       q.createRequestFieldCommentStmt,
+		createRequestFieldCommentResolutionStmt:             q.createRequestFieldCommentResolutionStmt,
+		createRequestFieldVersionStmt:                       q.createRequestFieldVersionStmt,
+		createRequestStatusHistoryStmt:                      q.createRequestStatusHistoryStmt,
+		createRequestSubfieldStmt:                           q.createRequestSubfieldStmt,
+		createRequestSubfieldVersionStmt:                    q.createRequestSubfieldVersionStmt,
+		createRoomStmt:                                      q.createRoomStmt,
+		createZoneStmt:                                      q.createZoneStmt,
+		deleteActorImageCanStmt:                             q.deleteActorImageCanStmt,
+		deleteActorImageCanBeStmt:                           q.deleteActorImageCanBeStmt,
+		deleteActorImageContainerPropertiesStmt:             q.deleteActorImageContainerPropertiesStmt,
+		deleteActorImageFoodPropertiesStmt:                  q.deleteActorImageFoodPropertiesStmt,
+		deleteActorImageFurniturePropertiesStmt:             q.deleteActorImageFurniturePropertiesStmt,
+		deleteActorImageHandStmt:                            q.deleteActorImageHandStmt,
+		deleteActorImagePrimaryHandStmt:                     q.deleteActorImagePrimaryHandStmt,
+		deleteCharacterNameReservationForRequestStmt:        q.deleteCharacterNameReservationForRequestStmt,
+		deleteEmailStmt:                                     q.deleteEmailStmt,
+		deleteOpenRequestChangeRequestStmt:                  q.deleteOpenRequestChangeRequestStmt,
+		deletePlayerPermissionStmt:                          q.deletePlayerPermissionStmt,
+		deletePlayerRecoveryCodesStmt:                       q.deletePlayerRecoveryCodesStmt,
+		deletePlayerRoleStmt:                                q.deletePlayerRoleStmt,
+		deletePlayerTwoFactorStmt:                           q.deletePlayerTwoFactorStmt,
+		deleteRequestChangeRequestStmt:                      q.deleteRequestChangeRequestStmt,
+		deleteRequestDeletionStmt:                           q.deleteRequestDeletionStmt,
+		deleteRequestFieldCommentResolutionStmt:             q.deleteRequestFieldCommentResolutionStmt,
+		deleteRequestSubfieldStmt:                           q.deleteRequestSubfieldStmt,
+		deleteRoomExitStmt:                                  q.deleteRoomExitStmt,
+		editOpenRequestChangeRequestStmt:                    q.editOpenRequestChangeRequestStmt,
+		enablePlayerTwoFactorStmt:                           q.enablePlayerTwoFactorStmt,
+		getActorImageStmt:                                   q.getActorImageStmt,
+		getActorImageByNameStmt:                             q.getActorImageByNameStmt,
+		getActorImageContainerPropertiesStmt:                q.getActorImageContainerPropertiesStmt,
+		getActorImageFoodPropertiesStmt:                     q.getActorImageFoodPropertiesStmt,
+		getActorImageFurniturePropertiesStmt:                q.getActorImageFurniturePropertiesStmt,
+		getActorImagePlayerPropertiesForImageStmt:           q.getActorImagePlayerPropertiesForImageStmt,
+		getCharacterNameReservationStmt:                     q.getCharacterNameReservationStmt,
+		getEmailStmt:                                        q.getEmailStmt,
+		getEmailByAddressForPlayerStmt:                      q.getEmailByAddressForPlayerStmt,
+		getHelpStmt:                                         q.getHelpStmt,
+		getHelpRelatedStmt:                                  q.getHelpRelatedStmt,
+		getLatestRequestStatusHistoryToStmt:                 q.getLatestRequestStatusHistoryToStmt,
+		getOpenRequestChangeRequestStmt:                     q.getOpenRequestChangeRequestStmt,
+		getOpenRequestChangeRequestForRequestFieldStmt:      q.getOpenRequestChangeRequestForRequestFieldStmt,
+		getPlayerStmt:                                       q.getPlayerStmt,
+		getPlayerByUsernameStmt:                             q.getPlayerByUsernameStmt,
+		getPlayerSettingsStmt:                               q.getPlayerSettingsStmt,
+		getPlayerTwoFactorStmt:                              q.getPlayerTwoFactorStmt,
+		getPlayerUsernameStmt:                               q.getPlayerUsernameStmt,
+		getPlayerUsernameByIdStmt:                           q.getPlayerUsernameByIdStmt,
+		getPrimaryEmailStmt:                                 q.getPrimaryEmailStmt,
+		getRequestStmt:                                      q.getRequestStmt,
+		getRequestChangeRequestByFieldIDStmt:                q.getRequestChangeRequestByFieldIDStmt,
+		getRequestDeletionStmt:                              q.getRequestDeletionStmt,
+		getRequestFieldStmt:                                 q.getRequestFieldStmt,
+		getRequestFieldByTypeStmt:                           q.getRequestFieldByTypeStmt,
+		getRequestFieldByTypeWithChangeRequestsStmt:         q.getRequestFieldByTypeWithChangeRequestsStmt,
+		getRequestFieldCommentReadStmt:                      q.getRequestFieldCommentReadStmt,
+		getRequestFieldCommentResolutionStmt:                q.getRequestFieldCommentResolutionStmt,
+		getRequestFieldVersionBeforeStmt:                    q.getRequestFieldVersionBeforeStmt,
+		getRequestForUpdateStmt:                             q.getRequestForUpdateStmt,
+		getRequestSubfieldStmt:                              q.getRequestSubfieldStmt,
+		getRoomStmt:                                         q.getRoomStmt,
+		getRoomExitStmt:                                     q.getRoomExitStmt,
+		getSiteSettingStmt:                                  q.getSiteSettingStmt,
+		getTagsForHelpFileStmt:                              q.getTagsForHelpFileStmt,
+		getVerifiedEmailByAddressStmt:                       q.getVerifiedEmailByAddressStmt,
+		getZoneStmt:                                         q.getZoneStmt,
+		listActorImageCanStmt:                               q.listActorImageCanStmt,
+		listActorImageCanBeStmt:                             q.listActorImageCanBeStmt,
+		listActorImageKeywordsStmt:                          q.listActorImageKeywordsStmt,
+		listActorImagesStmt:                                 q.listActorImagesStmt,
+		listActorImagesHandsStmt:                            q.listActorImagesHandsStmt,
+		listActorImagesPrimaryHandsStmt:                     q.listActorImagesPrimaryHandsStmt,
+		listAllRoomExitsStmt:                                q.listAllRoomExitsStmt,
+		listCharactersForPlayerStmt:                         q.listCharactersForPlayerStmt,
+		listDeletedRequestsForPlayerStmt:                    q.listDeletedRequestsForPlayerStmt,
+		listEmailsStmt:                                      q.listEmailsStmt,
+		listHelpHeadersStmt:                                 q.listHelpHeadersStmt,
+		listHelpSlugsStmt:                                   q.listHelpSlugsStmt,
+		listOpenRequestChangeRequestsByFieldIDStmt:          q.listOpenRequestChangeRequestsByFieldIDStmt,
+		listOpenRequestChangeRequestsForRequestStmt:         q.listOpenRequestChangeRequestsForRequestStmt,
+		listPastRequestChangeRequestsForFieldStmt:           q.listPastRequestChangeRequestsForFieldStmt,
+		listPlayerPasswordHashesStmt:                        q.listPlayerPasswordHashesStmt,
+		listPlayerPermissionChangeHistoryStmt:               q.listPlayerPermissionChangeHistoryStmt,
+		listPlayerPermissionsStmt:                           q.listPlayerPermissionsStmt,
+		listPlayerRoleChangeHistoryStmt:                     q.listPlayerRoleChangeHistoryStmt,
+		listPlayerRolesStmt:                                 q.listPlayerRolesStmt,
+		listRequestChangeRequestsByFieldIDStmt:              q.listRequestChangeRequestsByFieldIDStmt,
+		listRequestChangeRequestsForRequestStmt:             q.listRequestChangeRequestsForRequestStmt,
+		listRequestFieldCommentsForFieldStmt:                q.listRequestFieldCommentsForFieldStmt,
+		listRequestFieldVersionsForFieldStmt:                q.listRequestFieldVersionsForFieldStmt,
+		listRequestFieldsForRequestStmt:                     q.listRequestFieldsForRequestStmt,
+		listRequestFieldsForRequestWithChangeRequestsStmt:   q.listRequestFieldsForRequestWithChangeRequestsStmt,
+		listRequestQueueStmt:                                q.listRequestQueueStmt,
+		listRequestStatusHistoryForRequestStmt:              q.listRequestStatusHistoryForRequestStmt,
+		listRequestSubfieldVersionsBeforeStmt:               q.listRequestSubfieldVersionsBeforeStmt,
+		listRequestSubfieldsForFieldStmt:                    q.listRequestSubfieldsForFieldStmt,
+		listRequestSubfieldsForFieldsStmt:                   q.listRequestSubfieldsForFieldsStmt,
+		listRequestsByTypeAndStatusStmt:                     q.listRequestsByTypeAndStatusStmt,
+		listRequestsForPlayerStmt:                           q.listRequestsForPlayerStmt,
+		listRoomExitsStmt:                                   q.listRoomExitsStmt,
+		listRoomExitsByRoomIDsStmt:                          q.listRoomExitsByRoomIDsStmt,
+		listRoomsStmt:                                       q.listRoomsStmt,
+		listRoomsByIDsStmt:                                  q.listRoomsByIDsStmt,
+		listRoomsByZoneStmt:                                 q.listRoomsByZoneStmt,
+		listStaleInReviewRequestsStmt:                       q.listStaleInReviewRequestsStmt,
+		listUnusedPlayerRecoveryCodesStmt:                   q.listUnusedPlayerRecoveryCodesStmt,
+		listVerifiedEmailsStmt:                              q.listVerifiedEmailsStmt,
+		listZoneBuildersStmt:                                q.listZoneBuildersStmt,
+		listZonesStmt:                                       q.listZonesStmt,
+		markEmailPrimaryStmt:                                q.markEmailPrimaryStmt,
+		markEmailVerifiedStmt:                               q.markEmailVerifiedStmt,
+		markRequestFieldCommentsReadStmt:                    q.markRequestFieldCommentsReadStmt,
+		removeZoneBuilderStmt:                               q.removeZoneBuilderStmt,
+		searchHelpByCategoryStmt:                            q.searchHelpByCategoryStmt,
+		searchHelpByContentStmt:                             q.searchHelpByContentStmt,
+		searchHelpByTagsStmt:                                q.searchHelpByTagsStmt,
+		searchHelpByTitleStmt:                               q.searchHelpByTitleStmt,
+		searchPlayersByUsernameStmt:                         q.searchPlayersByUsernameStmt,
+		searchTagsStmt:                                      q.searchTagsStmt,
+		setActorImagePlayerPropertiesCurrentStmt:            q.setActorImagePlayerPropertiesCurrentStmt,
+		setActorImagePlayerPropertiesRetiredStmt:            q.setActorImagePlayerPropertiesRetiredStmt,
+		setRoomExitStmt:                                     q.setRoomExitStmt,
+		setSiteSettingStmt:                                  q.setSiteSettingStmt,
+		updateActorImageDescriptionStmt:                     q.updateActorImageDescriptionStmt,
+		updateActorImageShortDescriptionStmt:                q.updateActorImageShortDescriptionStmt,
+		updateActorImageUniqueStmt:                          q.updateActorImageUniqueStmt,
+		updatePlayerPasswordStmt:                            q.updatePlayerPasswordStmt,
+		updatePlayerSettingsThemeStmt:                       q.updatePlayerSettingsThemeStmt,
+		updatePlayerTwoFactorLastStepStmt:                   q.updatePlayerTwoFactorLastStepStmt,
+		updateRequestFieldStatusStmt:                        q.updateRequestFieldStatusStmt,
+		updateRequestFieldStatusByRequestAndTypeStmt:        q.updateRequestFieldStatusByRequestAndTypeStmt,
+		updateRequestFieldValueStmt:                         q.updateRequestFieldValueStmt,
+		updateRequestFieldValueByRequestAndTypeStmt:         q.updateRequestFieldValueByRequestAndTypeStmt,
+		updateRequestReviewerStmt:                           q.updateRequestReviewerStmt,
+		updateRequestStatusStmt:                             q.updateRequestStatusStmt,
+		updateRequestSubfieldStmt:                           q.updateRequestSubfieldStmt,
+		updateRoomStmt:                                      q.updateRoomStmt,
+		updateRoomCoordinatesStmt:                           q.updateRoomCoordinatesStmt,
+		updateRoomDescriptionStmt:                           q.updateRoomDescriptionStmt,
+		updateRoomExitFlagsStmt:                             q.updateRoomExitFlagsStmt,
+		updateRoomSizeStmt:                                  q.updateRoomSizeStmt,
+		updateRoomTitleStmt:                                 q.updateRoomTitleStmt,
+		updateRoomZoneStmt:                                  q.updateRoomZoneStmt,
+		updateZoneOwnerStmt:                                 q.updateZoneOwnerStmt,
+		usePlayerRecoveryCodeStmt:                           q.usePlayerRecoveryCodeStmt,
 	}
 }
@@ -10,6 +10,15 @@ import (
 	"database/sql"
 )
 
+const clearPrimaryEmail = `-- name: ClearPrimaryEmail :exec
+UPDATE emails SET is_primary = false WHERE pid = ?
+`
+
+func (q *Queries) ClearPrimaryEmail(ctx context.Context, pid int64) error {
+	_, err := q.exec(ctx, q.clearPrimaryEmailStmt, clearPrimaryEmail, pid)
+	return err
+}
+
 const countEmails = `-- name: CountEmails :one
 SELECT COUNT(*) FROM emails WHERE pid = ?
 `
@@ -44,7 +53,7 @@ func (q *Queries) DeleteEmail(ctx context.Context, id int64) error {
 }
 
 const getEmail = `-- name: GetEmail :one
-SELECT created_at, updated_at, address, verified, pid, id FROM emails WHERE id = ?
+SELECT created_at, updated_at, address, verified, is_primary, pid, id FROM emails WHERE id = ?
 `
 
 func (q *Queries) GetEmail(ctx context.Context, id int64) (Email, error) {
@@ -55,6 +64,7 @@ func (q *Queries) GetEmail(ctx context.Context, id int64) (Email, error) {
 		&i.UpdatedAt,
 		&i.Address,
 		&i.Verified,
+		&i.IsPrimary,
 		&i.PID,
 		&i.ID,
 	)
@@ -62,7 +72,7 @@ func (q *Queries) GetEmail(ctx context.Context, id int64) (Email, error) {
 }
 
 const getEmailByAddressForPlayer = `-- name: GetEmailByAddressForPlayer :one
-SELECT created_at, updated_at, address, verified, pid, id FROM emails WHERE address = ? AND pid = ?
+SELECT created_at, updated_at, address, verified, is_primary, pid, id FROM emails WHERE address = ? AND pid = ?
 `
 
 type GetEmailByAddressForPlayerParams struct {
@@ -78,6 +88,26 @@ func (q *Queries) GetEmailByAddressForPlayer(ctx context.Context, arg GetEmailBy
 		&i.UpdatedAt,
 		&i.Address,
 		&i.Verified,
+		&i.IsPrimary,
+		&i.PID,
+		&i.ID,
+	)
+	return i, err
+}
+
+const getPrimaryEmail = `-- name: GetPrimaryEmail :one
+SELECT created_at, updated_at, address, verified, is_primary, pid, id FROM emails WHERE pid = ? AND is_primary = true
+`
+
+func (q *Queries) GetPrimaryEmail(ctx context.Context, pid int64) (Email, error) {
+	row := q.queryRow(ctx, q.getPrimaryEmailStmt, getPrimaryEmail, pid)
+	var i Email
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Address,
+		&i.Verified,
+		&i.IsPrimary,
 		&i.PID,
 		&i.ID,
 	)
@@ -85,7 +115,7 @@ func (q *Queries) GetEmailByAddressForPlayer(ctx context.Context, arg GetEmailBy
 }
 
 const getVerifiedEmailByAddress = `-- name: GetVerifiedEmailByAddress :one
-SELECT created_at, updated_at, address, verified, pid, id FROM emails WHERE address = ? AND verified = true
+SELECT created_at, updated_at, address, verified, is_primary, pid, id FROM emails WHERE address = ? AND verified = true
 `
 
 func (q *Queries) GetVerifiedEmailByAddress(ctx context.Context, address string) (Email, error) {
@@ -96,6 +126,7 @@ func (q *Queries) GetVerifiedEmailByAddress(ctx context.Context, address string)
 		&i.UpdatedAt,
 		&i.Address,
 		&i.Verified,
+		&i.IsPrimary,
 		&i.PID,
 		&i.ID,
 	)
@@ -103,7 +134,7 @@ func (q *Queries) GetVerifiedEmailByAddress(ctx context.Context, address string)
 }
 
 const listEmails = `-- name: ListEmails :many
-SELECT created_at, updated_at, address, verified, pid, id FROM emails WHERE pid = ?
+SELECT created_at, updated_at, address, verified, is_primary, pid, id FROM emails WHERE pid = ?
 `
 
 func (q *Queries) ListEmails(ctx context.Context, pid int64) ([]Email, error) {
@@ -120,6 +151,7 @@ func (q *Queries) ListEmails(ctx context.Context, pid int64) ([]Email, error) {
 			&i.UpdatedAt,
 			&i.Address,
 			&i.Verified,
+			&i.IsPrimary,
 			&i.PID,
 			&i.ID,
 		); err != nil {
@@ -137,7 +169,7 @@ func (q *Queries) ListEmails(ctx context.Context, pid int64) ([]Email, error) {
 }
 
 const listVerifiedEmails = `-- name: ListVerifiedEmails :many
-SELECT created_at, updated_at, address, verified, pid, id FROM emails WHERE pid = ? AND verified = true
+SELECT created_at, updated_at, address, verified, is_primary, pid, id FROM emails WHERE pid = ? AND verified = true
 `
 
 func (q *Queries) ListVerifiedEmails(ctx context.Context, pid int64) ([]Email, error) {
@@ -154,6 +186,7 @@ func (q *Queries) ListVerifiedEmails(ctx context.Context, pid int64) ([]Email, e
 			&i.UpdatedAt,
 			&i.Address,
 			&i.Verified,
+			&i.IsPrimary,
 			&i.PID,
 			&i.ID,
 		); err != nil {
@@ -170,6 +203,15 @@ func (q *Queries) ListVerifiedEmails(ctx context.Context, pid int64) ([]Email, e
 	return items, nil
 }
 
+const markEmailPrimary = `-- name: MarkEmailPrimary :exec
+UPDATE emails SET is_primary = true WHERE id = ? AND verified = true
+`
+
+func (q *Queries) MarkEmailPrimary(ctx context.Context, id int64) error {
+	_, err := q.exec(ctx, q.markEmailPrimaryStmt, markEmailPrimary, id)
+	return err
+}
+
 const markEmailVerified = `-- name: MarkEmailVerified :exec
 UPDATE emails SET verified = true WHERE id = ?
 `
@@ -94,6 +94,7 @@ type ActorImagesPlayerProperty struct {
 	PID       int64
 	ID        int64
 	Current   bool
+	Retired   bool
 }
 
 type ActorImagesPrimaryHand struct {
@@ -104,11 +105,20 @@ type ActorImagesPrimaryHand struct {
 	Hand      int32
 }
 
+type CharacterNameReservation struct {
+	CreatedAt time.Time
+	Name      string
+	RID       int64
+	PID       int64
+	ID        int64
+}
+
 type Email struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Address   string
 	Verified  bool
+	IsPrimary bool
 	PID       int64
 	ID        int64
 }
@@ -183,6 +193,31 @@ type PlayerPermissionChangeHistory struct {
 	Revoked   bool
 }
 
+type PlayerRecoveryCode struct {
+	CreatedAt time.Time
+	Hash      string
+	Used      bool
+	PID       int64
+	ID        int64
+}
+
+type PlayerRole struct {
+	CreatedAt time.Time
+	Name      string
+	IPID      int64
+	PID       int64
+	ID        int64
+}
+
+type PlayerRoleChangeHistory struct {
+	CreatedAt time.Time
+	Name      string
+	IPID      int64
+	PID       int64
+	ID        int64
+	Revoked   bool
+}
+
 type PlayerSetting struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -191,6 +226,15 @@ type PlayerSetting struct {
 	ID        int64
 }
 
+type PlayerTwoFactor struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Secret    string
+	Enabled   bool
+	LastStep  int64
+	PID       int64
+}
+
 type Request struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -211,6 +255,13 @@ type RequestChangeRequest struct {
 	ID        int64
 }
 
+type RequestDeletion struct {
+	CreatedAt time.Time
+	RID       int64
+	PID       int64
+	ID        int64
+}
+
 type RequestField struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -221,6 +272,46 @@ type RequestField struct {
 	ID        int64
 }
 
+type RequestFieldComment struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Text      string
+	RFID      int64
+	PID       int64
+	ID        int64
+}
+
+type RequestFieldCommentRead struct {
+	UpdatedAt time.Time
+	RFID      int64
+	PID       int64
+	CID       int64
+}
+
+type RequestFieldCommentResolution struct {
+	CreatedAt time.Time
+	RFID      int64
+	PID       int64
+}
+
+type RequestFieldVersion struct {
+	CreatedAt time.Time
+	Value     string
+	RFID      int64
+	PID       int64
+	ID        int64
+}
+
+type RequestStatusHistory struct {
+	CreatedAt  time.Time
+	Note       string
+	FromStatus string
+	ToStatus   string
+	RID        int64
+	PID        int64
+	ID         int64
+}
+
 type RequestSubfield struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -229,20 +320,60 @@ type RequestSubfield struct {
 	ID        int64
 }
 
+type RequestSubfieldVersion struct {
+	CreatedAt time.Time
+	Value     string
+	Deleted   bool
+	RSID      int64
+	RFID      int64
+	PID       int64
+	ID        int64
+}
+
 type Room struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	Description string
 	Title       string
-	North       int64
-	Northeast   int64
-	East        int64
-	Southeast   int64
-	South       int64
-	Southwest   int64
-	West        int64
-	Northwest   int64
 	ID          int64
+	ZID         int64
 	Size        int32
+	X           int32
+	Y           int32
+	Z           int32
+	Placed      bool
 	Unmodified  bool
 }
+
+type RoomExit struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Direction string
+	Door      bool
+	Hidden    bool
+	Locked    bool
+	RID       int64
+	ToRID     int64
+	ID        int64
+}
+
+type SiteSetting struct {
+	UpdatedAt time.Time
+	Name      string
+	Value     string
+}
+
+type Zone struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	ID        int64
+	OwnerPID  int64
+}
+
+type ZoneBuilder struct {
+	CreatedAt time.Time
+	ID        int64
+	PID       int64
+	ZID       int64
+}
@@ -67,6 +67,64 @@ func (q *Queries) CreatePlayerPermissionRevokedChangeHistory(ctx context.Context
 	return err
 }
 
+const createPlayerRecoveryCode = `-- name: CreatePlayerRecoveryCode :exec
+INSERT INTO player_recovery_codes (hash, pid) VALUES (?, ?)
+`
+
+type CreatePlayerRecoveryCodeParams struct {
+	Hash string
+	PID  int64
+}
+
+func (q *Queries) CreatePlayerRecoveryCode(ctx context.Context, arg CreatePlayerRecoveryCodeParams) error {
+	_, err := q.exec(ctx, q.createPlayerRecoveryCodeStmt, createPlayerRecoveryCode, arg.Hash, arg.PID)
+	return err
+}
+
+const createPlayerRole = `-- name: CreatePlayerRole :execresult
+INSERT INTO player_roles (name, pid, ipid) VALUES (?, ?, ?)
+`
+
+type CreatePlayerRoleParams struct {
+	Name string
+	PID  int64
+	IPID int64
+}
+
+func (q *Queries) CreatePlayerRole(ctx context.Context, arg CreatePlayerRoleParams) (sql.Result, error) {
+	return q.exec(ctx, q.createPlayerRoleStmt, createPlayerRole, arg.Name, arg.PID, arg.IPID)
+}
+
+const createPlayerRoleIssuedChangeHistory = `-- name: CreatePlayerRoleIssuedChangeHistory :exec
+INSERT INTO player_role_change_history (name, pid, ipid) VALUES (?, ?, ?)
+`
+
+type CreatePlayerRoleIssuedChangeHistoryParams struct {
+	Name string
+	PID  int64
+	IPID int64
+}
+
+func (q *Queries) CreatePlayerRoleIssuedChangeHistory(ctx context.Context, arg CreatePlayerRoleIssuedChangeHistoryParams) error {
+	_, err := q.exec(ctx, q.createPlayerRoleIssuedChangeHistoryStmt, createPlayerRoleIssuedChangeHistory, arg.Name, arg.PID, arg.IPID)
+	return err
+}
+
+const createPlayerRoleRevokedChangeHistory = `-- name: CreatePlayerRoleRevokedChangeHistory :exec
+INSERT INTO player_role_change_history (name, pid, ipid, revoked) VALUES (?, ?, ?, true)
+`
+
+type CreatePlayerRoleRevokedChangeHistoryParams struct {
+	Name string
+	PID  int64
+	IPID int64
+}
+
+func (q *Queries) CreatePlayerRoleRevokedChangeHistory(ctx context.Context, arg CreatePlayerRoleRevokedChangeHistoryParams) error {
+	_, err := q.exec(ctx, q.createPlayerRoleRevokedChangeHistoryStmt, createPlayerRoleRevokedChangeHistory, arg.Name, arg.PID, arg.IPID)
+	return err
+}
+
 const createPlayerSettings = `-- name: CreatePlayerSettings :exec
 INSERT INTO player_settings (theme, pid) VALUES (?, ?)
 `
@@ -81,6 +139,20 @@ func (q *Queries) CreatePlayerSettings(ctx context.Context, arg CreatePlayerSett
 	return err
 }
 
+const createPlayerTwoFactor = `-- name: CreatePlayerTwoFactor :exec
+INSERT INTO player_two_factor (secret, pid) VALUES (?, ?) ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled = false, last_step = 0
+`
+
+type CreatePlayerTwoFactorParams struct {
+	Secret string
+	PID    int64
+}
+
+func (q *Queries) CreatePlayerTwoFactor(ctx context.Context, arg CreatePlayerTwoFactorParams) error {
+	_, err := q.exec(ctx, q.createPlayerTwoFactorStmt, createPlayerTwoFactor, arg.Secret, arg.PID)
+	return err
+}
+
 const deletePlayerPermission = `-- name: DeletePlayerPermission :exec
 DELETE FROM player_permissions WHERE name = ? AND pid = ?
 `
@@ -95,6 +167,52 @@ func (q *Queries) DeletePlayerPermission(ctx context.Context, arg DeletePlayerPe
 	return err
 }
 
+const deletePlayerRecoveryCodes = `-- name: DeletePlayerRecoveryCodes :exec
+DELETE FROM player_recovery_codes WHERE pid = ?
+`
+
+func (q *Queries) DeletePlayerRecoveryCodes(ctx context.Context, pid int64) error {
+	_, err := q.exec(ctx, q.deletePlayerRecoveryCodesStmt, deletePlayerRecoveryCodes, pid)
+	return err
+}
+
+const deletePlayerRole = `-- name: DeletePlayerRole :exec
+DELETE FROM player_roles WHERE name = ? AND pid = ?
+`
+
+type DeletePlayerRoleParams struct {
+	Name string
+	PID  int64
+}
+
+func (q *Queries) DeletePlayerRole(ctx context.Context, arg DeletePlayerRoleParams) error {
+	_, err := q.exec(ctx, q.deletePlayerRoleStmt, deletePlayerRole, arg.Name, arg.PID)
+	return err
+}
+
+const deletePlayerTwoFactor = `-- name: DeletePlayerTwoFactor :exec
+DELETE FROM player_two_factor WHERE pid = ?
+`
+
+func (q *Queries) DeletePlayerTwoFactor(ctx context.Context, pid int64) error {
+	_, err := q.exec(ctx, q.deletePlayerTwoFactorStmt, deletePlayerTwoFactor, pid)
+	return err
+}
+
+const enablePlayerTwoFactor = `-- name: EnablePlayerTwoFactor :exec
+UPDATE player_two_factor SET enabled = true, last_step = ? WHERE pid = ?
+`
+
+type EnablePlayerTwoFactorParams struct {
+	LastStep int64
+	PID      int64
+}
+
+func (q *Queries) EnablePlayerTwoFactor(ctx context.Context, arg EnablePlayerTwoFactorParams) error {
+	_, err := q.exec(ctx, q.enablePlayerTwoFactorStmt, enablePlayerTwoFactor, arg.LastStep, arg.PID)
+	return err
+}
+
 const getPlayer = `-- name: GetPlayer :one
 SELECT created_at, updated_at, pw_hash, username, id FROM players WHERE id = ?
 `
@@ -146,6 +264,24 @@ func (q *Queries) GetPlayerSettings(ctx context.Context, pid int64) (PlayerSetti
 	return i, err
 }
 
+const getPlayerTwoFactor = `-- name: GetPlayerTwoFactor :one
+SELECT created_at, updated_at, secret, enabled, last_step, pid FROM player_two_factor WHERE pid = ?
+`
+
+func (q *Queries) GetPlayerTwoFactor(ctx context.Context, pid int64) (PlayerTwoFactor, error) {
+	row := q.queryRow(ctx, q.getPlayerTwoFactorStmt, getPlayerTwoFactor, pid)
+	var i PlayerTwoFactor
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Secret,
+		&i.Enabled,
+		&i.LastStep,
+		&i.PID,
+	)
+	return i, err
+}
+
 const getPlayerUsername = `-- name: GetPlayerUsername :one
 SELECT (username) FROM players WHERE id = ?
 `
@@ -168,6 +304,103 @@ func (q *Queries) GetPlayerUsernameById(ctx context.Context, id int64) (string,
 	return username, err
 }
 
+const listPlayerPasswordHashes = `-- name: ListPlayerPasswordHashes :many
+SELECT pw_hash FROM players
+`
+
+func (q *Queries) ListPlayerPasswordHashes(ctx context.Context) ([]string, error) {
+	rows, err := q.query(ctx, q.listPlayerPasswordHashesStmt, listPlayerPasswordHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var pw_hash string
+		if err := rows.Scan(&pw_hash); err != nil {
+			return nil, err
+		}
+		items = append(items, pw_hash)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerPermissionChangeHistory = `-- name: ListPlayerPermissionChangeHistory :many
+SELECT
+  player_permission_change_history.created_at, player_permission_change_history.name, player_permission_change_history.ipid, player_permission_change_history.pid, player_permission_change_history.id, player_permission_change_history.revoked, issuers.username AS issuer, targets.username AS target
+FROM
+  player_permission_change_history
+LEFT JOIN
+  players AS issuers ON issuers.id = player_permission_change_history.ipid
+LEFT JOIN
+  players AS targets ON targets.id = player_permission_change_history.pid
+WHERE
+  player_permission_change_history.ipid = COALESCE(?, player_permission_change_history.ipid)
+  AND player_permission_change_history.pid = COALESCE(?, player_permission_change_history.pid)
+  AND player_permission_change_history.name = COALESCE(?, player_permission_change_history.name)
+ORDER BY
+  player_permission_change_history.created_at DESC, player_permission_change_history.id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListPlayerPermissionChangeHistoryParams struct {
+	IPID   sql.NullInt64
+	PID    sql.NullInt64
+	Name   sql.NullString
+	Limit  int32
+	Offset int32
+}
+
+type ListPlayerPermissionChangeHistoryRow struct {
+	PlayerPermissionChangeHistory PlayerPermissionChangeHistory
+	Issuer                        sql.NullString
+	Target                        sql.NullString
+}
+
+func (q *Queries) ListPlayerPermissionChangeHistory(ctx context.Context, arg ListPlayerPermissionChangeHistoryParams) ([]ListPlayerPermissionChangeHistoryRow, error) {
+	rows, err := q.query(ctx, q.listPlayerPermissionChangeHistoryStmt, listPlayerPermissionChangeHistory,
+		arg.IPID,
+		arg.PID,
+		arg.Name,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPlayerPermissionChangeHistoryRow
+	for rows.Next() {
+		var i ListPlayerPermissionChangeHistoryRow
+		if err := rows.Scan(
+			&i.PlayerPermissionChangeHistory.CreatedAt,
+			&i.PlayerPermissionChangeHistory.Name,
+			&i.PlayerPermissionChangeHistory.IPID,
+			&i.PlayerPermissionChangeHistory.PID,
+			&i.PlayerPermissionChangeHistory.ID,
+			&i.PlayerPermissionChangeHistory.Revoked,
+			&i.Issuer,
+			&i.Target,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPlayerPermissions = `-- name: ListPlayerPermissions :many
 SELECT created_at, name, ipid, pid, id FROM player_permissions WHERE pid = ?
 `
@@ -201,6 +434,142 @@ func (q *Queries) ListPlayerPermissions(ctx context.Context, pid int64) ([]Playe
 	return items, nil
 }
 
+const listPlayerRoleChangeHistory = `-- name: ListPlayerRoleChangeHistory :many
+SELECT
+  player_role_change_history.created_at, player_role_change_history.name, player_role_change_history.ipid, player_role_change_history.pid, player_role_change_history.id, player_role_change_history.revoked, issuers.username AS issuer, targets.username AS target
+FROM
+  player_role_change_history
+LEFT JOIN
+  players AS issuers ON issuers.id = player_role_change_history.ipid
+LEFT JOIN
+  players AS targets ON targets.id = player_role_change_history.pid
+WHERE
+  player_role_change_history.ipid = COALESCE(?, player_role_change_history.ipid)
+  AND player_role_change_history.pid = COALESCE(?, player_role_change_history.pid)
+  AND player_role_change_history.name = COALESCE(?, player_role_change_history.name)
+ORDER BY
+  player_role_change_history.created_at DESC, player_role_change_history.id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListPlayerRoleChangeHistoryParams struct {
+	IPID   sql.NullInt64
+	PID    sql.NullInt64
+	Name   sql.NullString
+	Limit  int32
+	Offset int32
+}
+
+type ListPlayerRoleChangeHistoryRow struct {
+	PlayerRoleChangeHistory PlayerRoleChangeHistory
+	Issuer                  sql.NullString
+	Target                  sql.NullString
+}
+
+func (q *Queries) ListPlayerRoleChangeHistory(ctx context.Context, arg ListPlayerRoleChangeHistoryParams) ([]ListPlayerRoleChangeHistoryRow, error) {
+	rows, err := q.query(ctx, q.listPlayerRoleChangeHistoryStmt, listPlayerRoleChangeHistory,
+		arg.IPID,
+		arg.PID,
+		arg.Name,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPlayerRoleChangeHistoryRow
+	for rows.Next() {
+		var i ListPlayerRoleChangeHistoryRow
+		if err := rows.Scan(
+			&i.PlayerRoleChangeHistory.CreatedAt,
+			&i.PlayerRoleChangeHistory.Name,
+			&i.PlayerRoleChangeHistory.IPID,
+			&i.PlayerRoleChangeHistory.PID,
+			&i.PlayerRoleChangeHistory.ID,
+			&i.PlayerRoleChangeHistory.Revoked,
+			&i.Issuer,
+			&i.Target,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerRoles = `-- name: ListPlayerRoles :many
+SELECT created_at, name, ipid, pid, id FROM player_roles WHERE pid = ?
+`
+
+func (q *Queries) ListPlayerRoles(ctx context.Context, pid int64) ([]PlayerRole, error) {
+	rows, err := q.query(ctx, q.listPlayerRolesStmt, listPlayerRoles, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerRole
+	for rows.Next() {
+		var i PlayerRole
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.Name,
+			&i.IPID,
+			&i.PID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnusedPlayerRecoveryCodes = `-- name: ListUnusedPlayerRecoveryCodes :many
+SELECT created_at, hash, used, pid, id FROM player_recovery_codes WHERE pid = ? AND used = false
+`
+
+func (q *Queries) ListUnusedPlayerRecoveryCodes(ctx context.Context, pid int64) ([]PlayerRecoveryCode, error) {
+	rows, err := q.query(ctx, q.listUnusedPlayerRecoveryCodesStmt, listUnusedPlayerRecoveryCodes, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerRecoveryCode
+	for rows.Next() {
+		var i PlayerRecoveryCode
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.Hash,
+			&i.Used,
+			&i.PID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchPlayersByUsername = `-- name: SearchPlayersByUsername :many
 SELECT created_at, updated_at, pw_hash, username, id FROM players WHERE username LIKE ?
 `
@@ -260,3 +629,26 @@ func (q *Queries) UpdatePlayerSettingsTheme(ctx context.Context, arg UpdatePlaye
 	_, err := q.exec(ctx, q.updatePlayerSettingsThemeStmt, updatePlayerSettingsTheme, arg.Theme, arg.PID)
 	return err
 }
+
+const updatePlayerTwoFactorLastStep = `-- name: UpdatePlayerTwoFactorLastStep :exec
+UPDATE player_two_factor SET last_step = ? WHERE pid = ?
+`
+
+type UpdatePlayerTwoFactorLastStepParams struct {
+	LastStep int64
+	PID      int64
+}
+
+func (q *Queries) UpdatePlayerTwoFactorLastStep(ctx context.Context, arg UpdatePlayerTwoFactorLastStepParams) error {
+	_, err := q.exec(ctx, q.updatePlayerTwoFactorLastStepStmt, updatePlayerTwoFactorLastStep, arg.LastStep, arg.PID)
+	return err
+}
+
+const usePlayerRecoveryCode = `-- name: UsePlayerRecoveryCode :exec
+UPDATE player_recovery_codes SET used = true WHERE id = ?
+`
+
+func (q *Queries) UsePlayerRecoveryCode(ctx context.Context, id int64) error {
+	_, err := q.exec(ctx, q.usePlayerRecoveryCodeStmt, usePlayerRecoveryCode, id)
+	return err
+}
@@ -9,8 +9,33 @@ import (
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 )
 
+const batchCreatePastRequestChangeRequest = `-- name: BatchCreatePastRequestChangeRequest :exec
+INSERT INTO
+  past_request_change_requests
+SELECT created_at, updated_at, value, text, rfid, pid, id FROM
+  request_change_requests
+WHERE
+  request_change_requests.id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) BatchCreatePastRequestChangeRequest(ctx context.Context, ids []int64) error {
+	query := batchCreatePastRequestChangeRequest
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
+
 const batchCreateRequestChangeRequest = `-- name: BatchCreateRequestChangeRequest :exec
 INSERT INTO
   request_change_requests
@@ -54,6 +79,25 @@ func (q *Queries) BatchDeleteOpenRequestChangeRequest(ctx context.Context, ids [
 	return err
 }
 
+const batchDeleteRequestChangeRequest = `-- name: BatchDeleteRequestChangeRequest :exec
+DELETE FROM request_change_requests WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) BatchDeleteRequestChangeRequest(ctx context.Context, ids []int64) error {
+	query := batchDeleteRequestChangeRequest
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
+
 const countOpenRequestChangeRequestsForRequest = `-- name: CountOpenRequestChangeRequestsForRequest :one
 SELECT
   COUNT(*)
@@ -136,6 +180,20 @@ func (q *Queries) CreateRequestChangeRequest(ctx context.Context, id int64) erro
 	return err
 }
 
+const createRequestDeletion = `-- name: CreateRequestDeletion :exec
+INSERT INTO request_deletions (rid, pid) VALUES (?, ?)
+`
+
+type CreateRequestDeletionParams struct {
+	RID int64
+	PID int64
+}
+
+func (q *Queries) CreateRequestDeletion(ctx context.Context, arg CreateRequestDeletionParams) error {
+	_, err := q.exec(ctx, q.createRequestDeletionStmt, createRequestDeletion, arg.RID, arg.PID)
+	return err
+}
+
 const createRequestField = `-- name: CreateRequestField :exec
 INSERT INTO request_fields (value, type, status, rid) VALUES (?, ?, ?, ?)
 `
@@ -157,7 +215,74 @@ func (q *Queries) CreateRequestField(ctx context.Context, arg CreateRequestField
 	return err
 }
 
-const createRequestSubfield = `-- name: CreateRequestSubfield :exec
+const createRequestFieldComment = `-- name: CreateRequestFieldComment :exec
+INSERT INTO request_field_comments (text, rfid, pid) VALUES (?, ?, ?)
+`
+
+type CreateRequestFieldCommentParams struct {
+	Text string
+	RFID int64
+	PID  int64
+}
+
+func (q *Queries) CreateRequestFieldComment(ctx context.Context, arg CreateRequestFieldCommentParams) error {
+	_, err := q.exec(ctx, q.createRequestFieldCommentStmt, createRequestFieldComment, arg.Text, arg.RFID, arg.PID)
+	return err
+}
+
+const createRequestFieldCommentResolution = `-- name: CreateRequestFieldCommentResolution :exec
+INSERT INTO request_field_comment_resolutions (rfid, pid) VALUES (?, ?)
+`
+
+type CreateRequestFieldCommentResolutionParams struct {
+	RFID int64
+	PID  int64
+}
+
+func (q *Queries) CreateRequestFieldCommentResolution(ctx context.Context, arg CreateRequestFieldCommentResolutionParams) error {
+	_, err := q.exec(ctx, q.createRequestFieldCommentResolutionStmt, createRequestFieldCommentResolution, arg.RFID, arg.PID)
+	return err
+}
+
+const createRequestFieldVersion = `-- name: CreateRequestFieldVersion :exec
+INSERT INTO request_field_versions (value, rfid, pid) VALUES (?, ?, ?)
+`
+
+type CreateRequestFieldVersionParams struct {
+	Value string
+	RFID  int64
+	PID   int64
+}
+
+func (q *Queries) CreateRequestFieldVersion(ctx context.Context, arg CreateRequestFieldVersionParams) error {
+	_, err := q.exec(ctx, q.createRequestFieldVersionStmt, createRequestFieldVersion, arg.Value, arg.RFID, arg.PID)
+	return err
+}
+
+const createRequestStatusHistory = `-- name: CreateRequestStatusHistory :exec
+INSERT INTO request_status_history (note, from_status, to_status, rid, pid) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateRequestStatusHistoryParams struct {
+	Note       string
+	FromStatus string
+	ToStatus   string
+	RID        int64
+	PID        int64
+}
+
+func (q *Queries) CreateRequestStatusHistory(ctx context.Context, arg CreateRequestStatusHistoryParams) error {
+	_, err := q.exec(ctx, q.createRequestStatusHistoryStmt, createRequestStatusHistory,
+		arg.Note,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.RID,
+		arg.PID,
+	)
+	return err
+}
+
+const createRequestSubfield = `-- name: CreateRequestSubfield :execresult
 INSERT INTO request_subfields (value, rfid) VALUES (?, ?)
 `
 
@@ -166,8 +291,30 @@ type CreateRequestSubfieldParams struct {
 	RFID  int64
 }
 
-func (q *Queries) CreateRequestSubfield(ctx context.Context, arg CreateRequestSubfieldParams) error {
-	_, err := q.exec(ctx, q.createRequestSubfieldStmt, createRequestSubfield, arg.Value, arg.RFID)
+func (q *Queries) CreateRequestSubfield(ctx context.Context, arg CreateRequestSubfieldParams) (sql.Result, error) {
+	return q.exec(ctx, q.createRequestSubfieldStmt, createRequestSubfield, arg.Value, arg.RFID)
+}
+
+const createRequestSubfieldVersion = `-- name: CreateRequestSubfieldVersion :exec
+INSERT INTO request_subfield_versions (value, deleted, rsid, rfid, pid) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateRequestSubfieldVersionParams struct {
+	Value   string
+	Deleted bool
+	RSID    int64
+	RFID    int64
+	PID     int64
+}
+
+func (q *Queries) CreateRequestSubfieldVersion(ctx context.Context, arg CreateRequestSubfieldVersionParams) error {
+	_, err := q.exec(ctx, q.createRequestSubfieldVersionStmt, createRequestSubfieldVersion,
+		arg.Value,
+		arg.Deleted,
+		arg.RSID,
+		arg.RFID,
+		arg.PID,
+	)
 	return err
 }
 
@@ -189,6 +336,24 @@ func (q *Queries) DeleteRequestChangeRequest(ctx context.Context, id int64) erro
 	return err
 }
 
+const deleteRequestDeletion = `-- name: DeleteRequestDeletion :exec
+DELETE FROM request_deletions WHERE rid = ?
+`
+
+func (q *Queries) DeleteRequestDeletion(ctx context.Context, rid int64) error {
+	_, err := q.exec(ctx, q.deleteRequestDeletionStmt, deleteRequestDeletion, rid)
+	return err
+}
+
+const deleteRequestFieldCommentResolution = `-- name: DeleteRequestFieldCommentResolution :exec
+DELETE FROM request_field_comment_resolutions WHERE rfid = ?
+`
+
+func (q *Queries) DeleteRequestFieldCommentResolution(ctx context.Context, rfid int64) error {
+	_, err := q.exec(ctx, q.deleteRequestFieldCommentResolutionStmt, deleteRequestFieldCommentResolution, rfid)
+	return err
+}
+
 const deleteRequestSubfield = `-- name: DeleteRequestSubfield :exec
 DELETE FROM request_subfields WHERE id = ?
 `
@@ -212,6 +377,38 @@ func (q *Queries) EditOpenRequestChangeRequest(ctx context.Context, arg EditOpen
 	return err
 }
 
+const getLatestRequestStatusHistoryTo = `-- name: GetLatestRequestStatusHistoryTo :one
+SELECT
+  created_at, note, from_status, to_status, rid, pid, id
+FROM
+  request_status_history
+WHERE
+  rid = ? AND to_status = ?
+ORDER BY
+  created_at DESC, id DESC
+LIMIT 1
+`
+
+type GetLatestRequestStatusHistoryToParams struct {
+	RID      int64
+	ToStatus string
+}
+
+func (q *Queries) GetLatestRequestStatusHistoryTo(ctx context.Context, arg GetLatestRequestStatusHistoryToParams) (RequestStatusHistory, error) {
+	row := q.queryRow(ctx, q.getLatestRequestStatusHistoryToStmt, getLatestRequestStatusHistoryTo, arg.RID, arg.ToStatus)
+	var i RequestStatusHistory
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.Note,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.RID,
+		&i.PID,
+		&i.ID,
+	)
+	return i, err
+}
+
 const getOpenRequestChangeRequest = `-- name: GetOpenRequestChangeRequest :one
 SELECT created_at, updated_at, value, text, rfid, pid, id FROM open_request_change_requests WHERE id = ?
 `
@@ -288,6 +485,22 @@ func (q *Queries) GetRequestChangeRequestByFieldID(ctx context.Context, rfid int
 	return i, err
 }
 
+const getRequestDeletion = `-- name: GetRequestDeletion :one
+SELECT created_at, rid, pid, id FROM request_deletions WHERE rid = ?
+`
+
+func (q *Queries) GetRequestDeletion(ctx context.Context, rid int64) (RequestDeletion, error) {
+	row := q.queryRow(ctx, q.getRequestDeletionStmt, getRequestDeletion, rid)
+	var i RequestDeletion
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.RID,
+		&i.PID,
+		&i.ID,
+	)
+	return i, err
+}
+
 const getRequestField = `-- name: GetRequestField :one
 SELECT created_at, updated_at, value, type, status, rid, id FROM request_fields WHERE id = ?
 `
@@ -384,6 +597,91 @@ func (q *Queries) GetRequestFieldByTypeWithChangeRequests(ctx context.Context, a
 	return i, err
 }
 
+const getRequestFieldCommentRead = `-- name: GetRequestFieldCommentRead :one
+SELECT updated_at, rfid, pid, cid FROM request_field_comment_reads WHERE rfid = ? AND pid = ?
+`
+
+type GetRequestFieldCommentReadParams struct {
+	RFID int64
+	PID  int64
+}
+
+func (q *Queries) GetRequestFieldCommentRead(ctx context.Context, arg GetRequestFieldCommentReadParams) (RequestFieldCommentRead, error) {
+	row := q.queryRow(ctx, q.getRequestFieldCommentReadStmt, getRequestFieldCommentRead, arg.RFID, arg.PID)
+	var i RequestFieldCommentRead
+	err := row.Scan(
+		&i.UpdatedAt,
+		&i.RFID,
+		&i.PID,
+		&i.CID,
+	)
+	return i, err
+}
+
+const getRequestFieldCommentResolution = `-- name: GetRequestFieldCommentResolution :one
+SELECT created_at, rfid, pid FROM request_field_comment_resolutions WHERE rfid = ?
+`
+
+func (q *Queries) GetRequestFieldCommentResolution(ctx context.Context, rfid int64) (RequestFieldCommentResolution, error) {
+	row := q.queryRow(ctx, q.getRequestFieldCommentResolutionStmt, getRequestFieldCommentResolution, rfid)
+	var i RequestFieldCommentResolution
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.RFID,
+		&i.PID,
+	)
+	return i, err
+}
+
+const getRequestFieldVersionBefore = `-- name: GetRequestFieldVersionBefore :one
+SELECT
+  created_at, value, rfid, pid, id
+FROM
+  request_field_versions
+WHERE
+  rfid = ? AND created_at <= ?
+ORDER BY
+  created_at DESC, id DESC
+LIMIT 1
+`
+
+type GetRequestFieldVersionBeforeParams struct {
+	RFID   int64
+	Before time.Time
+}
+
+func (q *Queries) GetRequestFieldVersionBefore(ctx context.Context, arg GetRequestFieldVersionBeforeParams) (RequestFieldVersion, error) {
+	row := q.queryRow(ctx, q.getRequestFieldVersionBeforeStmt, getRequestFieldVersionBefore, arg.RFID, arg.Before)
+	var i RequestFieldVersion
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.Value,
+		&i.RFID,
+		&i.PID,
+		&i.ID,
+	)
+	return i, err
+}
+
+const getRequestForUpdate = `-- name: GetRequestForUpdate :one
+SELECT created_at, updated_at, type, status, rpid, pid, id FROM requests WHERE id = ? FOR UPDATE
+`
+
+func (q *Queries) GetRequestForUpdate(ctx context.Context, id int64) (Request, error) {
+	row := q.queryRow(ctx, q.getRequestForUpdateStmt, getRequestForUpdate, id)
+	var i Request
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Type,
+		&i.Status,
+		&i.RPID,
+		&i.PID,
+		&i.ID,
+	)
+	return i, err
+}
+
 const getRequestSubfield = `-- name: GetRequestSubfield :one
 SELECT created_at, updated_at, value, rfid, id FROM request_subfields WHERE id = ?
 `
@@ -401,6 +699,65 @@ func (q *Queries) GetRequestSubfield(ctx context.Context, id int64) (RequestSubf
 	return i, err
 }
 
+const listDeletedRequestsForPlayer = `-- name: ListDeletedRequestsForPlayer :many
+SELECT
+  requests.created_at, requests.updated_at, requests.type, requests.status, requests.rpid, requests.pid, requests.id, request_deletions.created_at, request_deletions.rid, request_deletions.pid, request_deletions.id
+FROM
+  requests
+JOIN
+  request_deletions ON request_deletions.rid = requests.id
+WHERE
+  requests.pid = ? AND requests.type = ? AND request_deletions.created_at > ?
+ORDER BY
+  request_deletions.created_at DESC
+`
+
+type ListDeletedRequestsForPlayerParams struct {
+	PID       int64
+	Type      string
+	CreatedAt time.Time
+}
+
+type ListDeletedRequestsForPlayerRow struct {
+	Request         Request
+	RequestDeletion RequestDeletion
+}
+
+func (q *Queries) ListDeletedRequestsForPlayer(ctx context.Context, arg ListDeletedRequestsForPlayerParams) ([]ListDeletedRequestsForPlayerRow, error) {
+	rows, err := q.query(ctx, q.listDeletedRequestsForPlayerStmt, listDeletedRequestsForPlayer, arg.PID, arg.Type, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeletedRequestsForPlayerRow
+	for rows.Next() {
+		var i ListDeletedRequestsForPlayerRow
+		if err := rows.Scan(
+			&i.Request.CreatedAt,
+			&i.Request.UpdatedAt,
+			&i.Request.Type,
+			&i.Request.Status,
+			&i.Request.RPID,
+			&i.Request.PID,
+			&i.Request.ID,
+			&i.RequestDeletion.CreatedAt,
+			&i.RequestDeletion.RID,
+			&i.RequestDeletion.PID,
+			&i.RequestDeletion.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOpenRequestChangeRequestsByFieldID = `-- name: ListOpenRequestChangeRequestsByFieldID :many
 SELECT created_at, updated_at, value, text, rfid, pid, id FROM open_request_change_requests WHERE rfid IN (/*SLICE:rfids*/?)
 `
@@ -488,6 +845,56 @@ func (q *Queries) ListOpenRequestChangeRequestsForRequest(ctx context.Context, r
 	return items, nil
 }
 
+const listPastRequestChangeRequestsForField = `-- name: ListPastRequestChangeRequestsForField :many
+SELECT
+  past_request_change_requests.created_at, past_request_change_requests.updated_at, past_request_change_requests.value, past_request_change_requests.text, past_request_change_requests.rfid, past_request_change_requests.pid, past_request_change_requests.id, players.username
+FROM
+  past_request_change_requests
+LEFT JOIN
+  players ON players.id = past_request_change_requests.pid
+WHERE
+  past_request_change_requests.rfid = ?
+ORDER BY
+  past_request_change_requests.created_at DESC, past_request_change_requests.id DESC
+`
+
+type ListPastRequestChangeRequestsForFieldRow struct {
+	PastRequestChangeRequest PastRequestChangeRequest
+	Username                 sql.NullString
+}
+
+func (q *Queries) ListPastRequestChangeRequestsForField(ctx context.Context, rfid int64) ([]ListPastRequestChangeRequestsForFieldRow, error) {
+	rows, err := q.query(ctx, q.listPastRequestChangeRequestsForFieldStmt, listPastRequestChangeRequestsForField, rfid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPastRequestChangeRequestsForFieldRow
+	for rows.Next() {
+		var i ListPastRequestChangeRequestsForFieldRow
+		if err := rows.Scan(
+			&i.PastRequestChangeRequest.CreatedAt,
+			&i.PastRequestChangeRequest.UpdatedAt,
+			&i.PastRequestChangeRequest.Value,
+			&i.PastRequestChangeRequest.Text,
+			&i.PastRequestChangeRequest.RFID,
+			&i.PastRequestChangeRequest.PID,
+			&i.PastRequestChangeRequest.ID,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRequestChangeRequestsByFieldID = `-- name: ListRequestChangeRequestsByFieldID :many
 SELECT created_at, updated_at, value, text, rfid, pid, id FROM request_change_requests WHERE rfid IN (/*SLICE:rfids*/?)
 `
@@ -533,6 +940,145 @@ func (q *Queries) ListRequestChangeRequestsByFieldID(ctx context.Context, rfids
 	return items, nil
 }
 
+const listRequestChangeRequestsForRequest = `-- name: ListRequestChangeRequestsForRequest :many
+SELECT
+  request_change_requests.created_at, request_change_requests.updated_at, request_change_requests.value, request_change_requests.text, request_change_requests.rfid, request_change_requests.pid, request_change_requests.id
+FROM
+  request_fields
+JOIN
+  request_change_requests ON request_change_requests.rfid = request_fields.id
+WHERE
+  request_fields.rid = ?
+`
+
+func (q *Queries) ListRequestChangeRequestsForRequest(ctx context.Context, rid int64) ([]RequestChangeRequest, error) {
+	rows, err := q.query(ctx, q.listRequestChangeRequestsForRequestStmt, listRequestChangeRequestsForRequest, rid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RequestChangeRequest
+	for rows.Next() {
+		var i RequestChangeRequest
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Value,
+			&i.Text,
+			&i.RFID,
+			&i.PID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRequestFieldCommentsForField = `-- name: ListRequestFieldCommentsForField :many
+SELECT
+  request_field_comments.created_at, request_field_comments.updated_at, request_field_comments.text, request_field_comments.rfid, request_field_comments.pid, request_field_comments.id, players.username
+FROM
+  request_field_comments
+LEFT JOIN
+  players ON players.id = request_field_comments.pid
+WHERE
+  request_field_comments.rfid = ?
+ORDER BY
+  request_field_comments.created_at, request_field_comments.id
+`
+
+type ListRequestFieldCommentsForFieldRow struct {
+	RequestFieldComment RequestFieldComment
+	Username            sql.NullString
+}
+
+func (q *Queries) ListRequestFieldCommentsForField(ctx context.Context, rfid int64) ([]ListRequestFieldCommentsForFieldRow, error) {
+	rows, err := q.query(ctx, q.listRequestFieldCommentsForFieldStmt, listRequestFieldCommentsForField, rfid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRequestFieldCommentsForFieldRow
+	for rows.Next() {
+		var i ListRequestFieldCommentsForFieldRow
+		if err := rows.Scan(
+			&i.RequestFieldComment.CreatedAt,
+			&i.RequestFieldComment.UpdatedAt,
+			&i.RequestFieldComment.Text,
+			&i.RequestFieldComment.RFID,
+			&i.RequestFieldComment.PID,
+			&i.RequestFieldComment.ID,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRequestFieldVersionsForField = `-- name: ListRequestFieldVersionsForField :many
+SELECT
+  request_field_versions.created_at, request_field_versions.value, request_field_versions.rfid, request_field_versions.pid, request_field_versions.id, players.username
+FROM
+  request_field_versions
+LEFT JOIN
+  players ON players.id = request_field_versions.pid
+WHERE
+  request_field_versions.rfid = ?
+ORDER BY
+  request_field_versions.created_at DESC, request_field_versions.id DESC
+`
+
+type ListRequestFieldVersionsForFieldRow struct {
+	RequestFieldVersion RequestFieldVersion
+	Username            sql.NullString
+}
+
+func (q *Queries) ListRequestFieldVersionsForField(ctx context.Context, rfid int64) ([]ListRequestFieldVersionsForFieldRow, error) {
+	rows, err := q.query(ctx, q.listRequestFieldVersionsForFieldStmt, listRequestFieldVersionsForField, rfid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRequestFieldVersionsForFieldRow
+	for rows.Next() {
+		var i ListRequestFieldVersionsForFieldRow
+		if err := rows.Scan(
+			&i.RequestFieldVersion.CreatedAt,
+			&i.RequestFieldVersion.Value,
+			&i.RequestFieldVersion.RFID,
+			&i.RequestFieldVersion.PID,
+			&i.RequestFieldVersion.ID,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRequestFieldsForRequest = `-- name: ListRequestFieldsForRequest :many
 SELECT created_at, updated_at, value, type, status, rid, id FROM request_fields WHERE rid = ?
 `
@@ -632,6 +1178,190 @@ func (q *Queries) ListRequestFieldsForRequestWithChangeRequests(ctx context.Cont
 	return items, nil
 }
 
+const listRequestQueue = `-- name: ListRequestQueue :many
+SELECT
+  requests.created_at, requests.updated_at, requests.type, requests.status, requests.rpid, requests.pid, requests.id,
+  COALESCE(submissions.submitted_at, requests.created_at) AS submitted_at
+FROM
+  requests
+LEFT JOIN
+  (SELECT rid, MAX(created_at) AS submitted_at FROM request_status_history WHERE to_status = 'Submitted' AND from_status IN ('Ready', 'Reviewed') GROUP BY rid) AS submissions
+  ON submissions.rid = requests.id
+WHERE
+  requests.type = ?
+  AND requests.pid != ?
+  AND requests.status IN (/*SLICE:statuses*/?)
+  AND requests.rpid = COALESCE(?, requests.rpid)
+  AND requests.pid = COALESCE(?, requests.pid)
+  AND COALESCE(submissions.submitted_at, requests.created_at) <= ?
+  AND requests.id NOT IN (SELECT rid FROM request_deletions)
+ORDER BY
+  submitted_at ASC, requests.id ASC
+LIMIT ? OFFSET ?
+`
+
+type ListRequestQueueParams struct {
+	Type            string
+	Viewer          int64
+	Statuses        []string
+	RPID            sql.NullInt64
+	Author          sql.NullInt64
+	SubmittedBefore time.Time
+	Limit           int32
+	Offset          int32
+}
+
+type ListRequestQueueRow struct {
+	Request     Request
+	SubmittedAt time.Time
+}
+
+func (q *Queries) ListRequestQueue(ctx context.Context, arg ListRequestQueueParams) ([]ListRequestQueueRow, error) {
+	query := listRequestQueue
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Type)
+	queryParams = append(queryParams, arg.Viewer)
+	if len(arg.Statuses) > 0 {
+		for _, v := range arg.Statuses {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:statuses*/?", strings.Repeat(",?", len(arg.Statuses))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:statuses*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.RPID)
+	queryParams = append(queryParams, arg.Author)
+	queryParams = append(queryParams, arg.SubmittedBefore)
+	queryParams = append(queryParams, arg.Limit)
+	queryParams = append(queryParams, arg.Offset)
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRequestQueueRow
+	for rows.Next() {
+		var i ListRequestQueueRow
+		if err := rows.Scan(
+			&i.Request.CreatedAt,
+			&i.Request.UpdatedAt,
+			&i.Request.Type,
+			&i.Request.Status,
+			&i.Request.RPID,
+			&i.Request.PID,
+			&i.Request.ID,
+			&i.SubmittedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRequestStatusHistoryForRequest = `-- name: ListRequestStatusHistoryForRequest :many
+SELECT
+  request_status_history.created_at, request_status_history.note, request_status_history.from_status, request_status_history.to_status, request_status_history.rid, request_status_history.pid, request_status_history.id, players.username
+FROM
+  request_status_history
+LEFT JOIN
+  players ON players.id = request_status_history.pid
+WHERE
+  request_status_history.rid = ?
+ORDER BY
+  request_status_history.created_at, request_status_history.id
+`
+
+type ListRequestStatusHistoryForRequestRow struct {
+	RequestStatusHistory RequestStatusHistory
+	Username             sql.NullString
+}
+
+func (q *Queries) ListRequestStatusHistoryForRequest(ctx context.Context, rid int64) ([]ListRequestStatusHistoryForRequestRow, error) {
+	rows, err := q.query(ctx, q.listRequestStatusHistoryForRequestStmt, listRequestStatusHistoryForRequest, rid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRequestStatusHistoryForRequestRow
+	for rows.Next() {
+		var i ListRequestStatusHistoryForRequestRow
+		if err := rows.Scan(
+			&i.RequestStatusHistory.CreatedAt,
+			&i.RequestStatusHistory.Note,
+			&i.RequestStatusHistory.FromStatus,
+			&i.RequestStatusHistory.ToStatus,
+			&i.RequestStatusHistory.RID,
+			&i.RequestStatusHistory.PID,
+			&i.RequestStatusHistory.ID,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRequestSubfieldVersionsBefore = `-- name: ListRequestSubfieldVersionsBefore :many
+SELECT
+  created_at, value, deleted, rsid, rfid, pid, id
+FROM
+  request_subfield_versions
+WHERE
+  rfid = ? AND created_at <= ?
+ORDER BY
+  created_at, id
+`
+
+type ListRequestSubfieldVersionsBeforeParams struct {
+	RFID   int64
+	Before time.Time
+}
+
+func (q *Queries) ListRequestSubfieldVersionsBefore(ctx context.Context, arg ListRequestSubfieldVersionsBeforeParams) ([]RequestSubfieldVersion, error) {
+	rows, err := q.query(ctx, q.listRequestSubfieldVersionsBeforeStmt, listRequestSubfieldVersionsBefore, arg.RFID, arg.Before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RequestSubfieldVersion
+	for rows.Next() {
+		var i RequestSubfieldVersion
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.Value,
+			&i.Deleted,
+			&i.RSID,
+			&i.RFID,
+			&i.PID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRequestSubfieldsForField = `-- name: ListRequestSubfieldsForField :many
 SELECT created_at, updated_at, value, rfid, id FROM request_subfields WHERE rfid = ?
 `
@@ -760,7 +1490,7 @@ func (q *Queries) ListRequestsByTypeAndStatus(ctx context.Context, arg ListReque
 }
 
 const listRequestsForPlayer = `-- name: ListRequestsForPlayer :many
-SELECT created_at, updated_at, type, status, rpid, pid, id FROM requests WHERE pid = ?
+SELECT created_at, updated_at, type, status, rpid, pid, id FROM requests WHERE pid = ? AND id NOT IN (SELECT rid FROM request_deletions)
 `
 
 func (q *Queries) ListRequestsForPlayer(ctx context.Context, pid int64) ([]Request, error) {
@@ -794,6 +1524,68 @@ func (q *Queries) ListRequestsForPlayer(ctx context.Context, pid int64) ([]Reque
 	return items, nil
 }
 
+const listStaleInReviewRequests = `-- name: ListStaleInReviewRequests :many
+SELECT
+  created_at, updated_at, type, status, rpid, pid, id
+FROM
+  requests
+WHERE
+  status = 'InReview'
+  AND id NOT IN (SELECT rid FROM request_deletions)
+  AND COALESCE(
+    (SELECT MAX(created_at) FROM request_status_history WHERE rid = requests.id AND to_status = 'InReview'),
+    updated_at
+  ) <= ?
+ORDER BY
+  id
+`
+
+func (q *Queries) ListStaleInReviewRequests(ctx context.Context, before time.Time) ([]Request, error) {
+	rows, err := q.query(ctx, q.listStaleInReviewRequestsStmt, listStaleInReviewRequests, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Request
+	for rows.Next() {
+		var i Request
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Type,
+			&i.Status,
+			&i.RPID,
+			&i.PID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markRequestFieldCommentsRead = `-- name: MarkRequestFieldCommentsRead :exec
+INSERT INTO request_field_comment_reads (cid, rfid, pid) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE cid = VALUES(cid)
+`
+
+type MarkRequestFieldCommentsReadParams struct {
+	CID  int64
+	RFID int64
+	PID  int64
+}
+
+func (q *Queries) MarkRequestFieldCommentsRead(ctx context.Context, arg MarkRequestFieldCommentsReadParams) error {
+	_, err := q.exec(ctx, q.markRequestFieldCommentsReadStmt, markRequestFieldCommentsRead, arg.CID, arg.RFID, arg.PID)
+	return err
+}
+
 const updateRequestFieldStatus = `-- name: UpdateRequestFieldStatus :exec
 UPDATE request_fields SET status = ? WHERE id = ?
 `
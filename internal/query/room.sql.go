@@ -12,21 +12,41 @@ import (
 )
 
 const createRoom = `-- name: CreateRoom :execresult
-INSERT INTO rooms (title, description, size) VALUES (?, ?, ?)
+INSERT INTO rooms (title, description, size, zid) VALUES (?, ?, ?, ?)
 `
 
 type CreateRoomParams struct {
 	Title       string
 	Description string
 	Size        int32
+	ZID         int64
 }
 
 func (q *Queries) CreateRoom(ctx context.Context, arg CreateRoomParams) (sql.Result, error) {
-	return q.exec(ctx, q.createRoomStmt, createRoom, arg.Title, arg.Description, arg.Size)
+	return q.exec(ctx, q.createRoomStmt, createRoom,
+		arg.Title,
+		arg.Description,
+		arg.Size,
+		arg.ZID,
+	)
+}
+
+const deleteRoomExit = `-- name: DeleteRoomExit :exec
+DELETE FROM room_exits WHERE rid = ? AND direction = ?
+`
+
+type DeleteRoomExitParams struct {
+	RID       int64
+	Direction string
+}
+
+func (q *Queries) DeleteRoomExit(ctx context.Context, arg DeleteRoomExitParams) error {
+	_, err := q.exec(ctx, q.deleteRoomExitStmt, deleteRoomExit, arg.RID, arg.Direction)
+	return err
 }
 
 const getRoom = `-- name: GetRoom :one
-SELECT created_at, updated_at, description, title, north, northeast, east, southeast, south, southwest, west, northwest, id, size, unmodified FROM rooms WHERE id = ?
+SELECT created_at, updated_at, description, title, id, zid, size, x, y, z, placed, unmodified FROM rooms WHERE id = ?
 `
 
 func (q *Queries) GetRoom(ctx context.Context, id int64) (Room, error) {
@@ -37,23 +57,167 @@ func (q *Queries) GetRoom(ctx context.Context, id int64) (Room, error) {
 		&i.UpdatedAt,
 		&i.Description,
 		&i.Title,
-		&i.North,
-		&i.Northeast,
-		&i.East,
-		&i.Southeast,
-		&i.South,
-		&i.Southwest,
-		&i.West,
-		&i.Northwest,
 		&i.ID,
+		&i.ZID,
 		&i.Size,
+		&i.X,
+		&i.Y,
+		&i.Z,
+		&i.Placed,
 		&i.Unmodified,
 	)
 	return i, err
 }
 
+const getRoomExit = `-- name: GetRoomExit :one
+SELECT created_at, updated_at, direction, door, hidden, locked, rid, to_rid, id FROM room_exits WHERE rid = ? AND direction = ?
+`
+
+type GetRoomExitParams struct {
+	RID       int64
+	Direction string
+}
+
+func (q *Queries) GetRoomExit(ctx context.Context, arg GetRoomExitParams) (RoomExit, error) {
+	row := q.queryRow(ctx, q.getRoomExitStmt, getRoomExit, arg.RID, arg.Direction)
+	var i RoomExit
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Direction,
+		&i.Door,
+		&i.Hidden,
+		&i.Locked,
+		&i.RID,
+		&i.ToRID,
+		&i.ID,
+	)
+	return i, err
+}
+
+const listAllRoomExits = `-- name: ListAllRoomExits :many
+SELECT created_at, updated_at, direction, door, hidden, locked, rid, to_rid, id FROM room_exits
+`
+
+func (q *Queries) ListAllRoomExits(ctx context.Context) ([]RoomExit, error) {
+	rows, err := q.query(ctx, q.listAllRoomExitsStmt, listAllRoomExits)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomExit
+	for rows.Next() {
+		var i RoomExit
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Direction,
+			&i.Door,
+			&i.Hidden,
+			&i.Locked,
+			&i.RID,
+			&i.ToRID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoomExits = `-- name: ListRoomExits :many
+SELECT created_at, updated_at, direction, door, hidden, locked, rid, to_rid, id FROM room_exits WHERE rid = ?
+`
+
+func (q *Queries) ListRoomExits(ctx context.Context, rid int64) ([]RoomExit, error) {
+	rows, err := q.query(ctx, q.listRoomExitsStmt, listRoomExits, rid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomExit
+	for rows.Next() {
+		var i RoomExit
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Direction,
+			&i.Door,
+			&i.Hidden,
+			&i.Locked,
+			&i.RID,
+			&i.ToRID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoomExitsByRoomIDs = `-- name: ListRoomExitsByRoomIDs :many
+SELECT created_at, updated_at, direction, door, hidden, locked, rid, to_rid, id FROM room_exits WHERE rid IN (/*SLICE:rids*/?)
+`
+
+func (q *Queries) ListRoomExitsByRoomIDs(ctx context.Context, rids []int64) ([]RoomExit, error) {
+	query := listRoomExitsByRoomIDs
+	var queryParams []interface{}
+	if len(rids) > 0 {
+		for _, v := range rids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:rids*/?", strings.Repeat(",?", len(rids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:rids*/?", "NULL", 1)
+	}
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomExit
+	for rows.Next() {
+		var i RoomExit
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Direction,
+			&i.Door,
+			&i.Hidden,
+			&i.Locked,
+			&i.RID,
+			&i.ToRID,
+			&i.ID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRooms = `-- name: ListRooms :many
-SELECT created_at, updated_at, description, title, north, northeast, east, southeast, south, southwest, west, northwest, id, size, unmodified FROM rooms
+SELECT created_at, updated_at, description, title, id, zid, size, x, y, z, placed, unmodified FROM rooms
 `
 
 func (q *Queries) ListRooms(ctx context.Context) ([]Room, error) {
@@ -70,16 +234,13 @@ func (q *Queries) ListRooms(ctx context.Context) ([]Room, error) {
 			&i.UpdatedAt,
 			&i.Description,
 			&i.Title,
-			&i.North,
-			&i.Northeast,
-			&i.East,
-			&i.Southeast,
-			&i.South,
-			&i.Southwest,
-			&i.West,
-			&i.Northwest,
 			&i.ID,
+			&i.ZID,
 			&i.Size,
+			&i.X,
+			&i.Y,
+			&i.Z,
+			&i.Placed,
 			&i.Unmodified,
 		); err != nil {
 			return nil, err
@@ -96,7 +257,7 @@ func (q *Queries) ListRooms(ctx context.Context) ([]Room, error) {
 }
 
 const listRoomsByIDs = `-- name: ListRoomsByIDs :many
-SELECT created_at, updated_at, description, title, north, northeast, east, southeast, south, southwest, west, northwest, id, size, unmodified FROM rooms WHERE id IN (/*SLICE:ids*/?)
+SELECT created_at, updated_at, description, title, id, zid, size, x, y, z, placed, unmodified FROM rooms WHERE id IN (/*SLICE:ids*/?)
 `
 
 func (q *Queries) ListRoomsByIDs(ctx context.Context, ids []int64) ([]Room, error) {
@@ -123,16 +284,53 @@ func (q *Queries) ListRoomsByIDs(ctx context.Context, ids []int64) ([]Room, erro
 			&i.UpdatedAt,
 			&i.Description,
 			&i.Title,
-			&i.North,
-			&i.Northeast,
-			&i.East,
-			&i.Southeast,
-			&i.South,
-			&i.Southwest,
-			&i.West,
-			&i.Northwest,
 			&i.ID,
+			&i.ZID,
+			&i.Size,
+			&i.X,
+			&i.Y,
+			&i.Z,
+			&i.Placed,
+			&i.Unmodified,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoomsByZone = `-- name: ListRoomsByZone :many
+SELECT created_at, updated_at, description, title, id, zid, size, x, y, z, placed, unmodified FROM rooms WHERE zid = ?
+`
+
+func (q *Queries) ListRoomsByZone(ctx context.Context, zid int64) ([]Room, error) {
+	rows, err := q.query(ctx, q.listRoomsByZoneStmt, listRoomsByZone, zid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Room
+	for rows.Next() {
+		var i Room
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Description,
+			&i.Title,
+			&i.ID,
+			&i.ZID,
 			&i.Size,
+			&i.X,
+			&i.Y,
+			&i.Z,
+			&i.Placed,
 			&i.Unmodified,
 		); err != nil {
 			return nil, err
@@ -148,6 +346,36 @@ func (q *Queries) ListRoomsByIDs(ctx context.Context, ids []int64) ([]Room, erro
 	return items, nil
 }
 
+const setRoomExit = `-- name: SetRoomExit :exec
+INSERT INTO
+  room_exits (rid, direction, to_rid, door, hidden, locked)
+VALUES
+  (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  to_rid = VALUES(to_rid)
+`
+
+type SetRoomExitParams struct {
+	RID       int64
+	Direction string
+	ToRID     int64
+	Door      bool
+	Hidden    bool
+	Locked    bool
+}
+
+func (q *Queries) SetRoomExit(ctx context.Context, arg SetRoomExitParams) error {
+	_, err := q.exec(ctx, q.setRoomExitStmt, setRoomExit,
+		arg.RID,
+		arg.Direction,
+		arg.ToRID,
+		arg.Door,
+		arg.Hidden,
+		arg.Locked,
+	)
+	return err
+}
+
 const updateRoom = `-- name: UpdateRoom :exec
 UPDATE
   rooms
@@ -177,129 +405,68 @@ func (q *Queries) UpdateRoom(ctx context.Context, arg UpdateRoomParams) error {
 	return err
 }
 
-const updateRoomDescription = `-- name: UpdateRoomDescription :exec
-UPDATE rooms SET description = ?, unmodified = false WHERE id = ?
-`
-
-type UpdateRoomDescriptionParams struct {
-	Description string
-	ID          int64
-}
-
-func (q *Queries) UpdateRoomDescription(ctx context.Context, arg UpdateRoomDescriptionParams) error {
-	_, err := q.exec(ctx, q.updateRoomDescriptionStmt, updateRoomDescription, arg.Description, arg.ID)
-	return err
-}
-
-const updateRoomExitEast = `-- name: UpdateRoomExitEast :exec
-UPDATE rooms SET east = ? WHERE id = ?
-`
-
-type UpdateRoomExitEastParams struct {
-	East int64
-	ID   int64
-}
-
-func (q *Queries) UpdateRoomExitEast(ctx context.Context, arg UpdateRoomExitEastParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitEastStmt, updateRoomExitEast, arg.East, arg.ID)
-	return err
-}
-
-const updateRoomExitNorth = `-- name: UpdateRoomExitNorth :exec
-UPDATE rooms SET north = ? WHERE id = ?
-`
-
-type UpdateRoomExitNorthParams struct {
-	North int64
-	ID    int64
-}
-
-func (q *Queries) UpdateRoomExitNorth(ctx context.Context, arg UpdateRoomExitNorthParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitNorthStmt, updateRoomExitNorth, arg.North, arg.ID)
-	return err
-}
-
-const updateRoomExitNortheast = `-- name: UpdateRoomExitNortheast :exec
-UPDATE rooms SET northeast = ? WHERE id = ?
-`
-
-type UpdateRoomExitNortheastParams struct {
-	Northeast int64
-	ID        int64
-}
-
-func (q *Queries) UpdateRoomExitNortheast(ctx context.Context, arg UpdateRoomExitNortheastParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitNortheastStmt, updateRoomExitNortheast, arg.Northeast, arg.ID)
-	return err
-}
-
-const updateRoomExitNorthwest = `-- name: UpdateRoomExitNorthwest :exec
-UPDATE rooms SET northwest = ? WHERE id = ?
-`
-
-type UpdateRoomExitNorthwestParams struct {
-	Northwest int64
-	ID        int64
-}
-
-func (q *Queries) UpdateRoomExitNorthwest(ctx context.Context, arg UpdateRoomExitNorthwestParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitNorthwestStmt, updateRoomExitNorthwest, arg.Northwest, arg.ID)
-	return err
-}
-
-const updateRoomExitSouth = `-- name: UpdateRoomExitSouth :exec
-UPDATE rooms SET south = ? WHERE id = ?
-`
-
-type UpdateRoomExitSouthParams struct {
-	South int64
-	ID    int64
-}
-
-func (q *Queries) UpdateRoomExitSouth(ctx context.Context, arg UpdateRoomExitSouthParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitSouthStmt, updateRoomExitSouth, arg.South, arg.ID)
-	return err
-}
-
-const updateRoomExitSoutheast = `-- name: UpdateRoomExitSoutheast :exec
-UPDATE rooms SET southeast = ? WHERE id = ?
+const updateRoomCoordinates = `-- name: UpdateRoomCoordinates :exec
+UPDATE rooms SET x = ?, y = ?, z = ?, placed = true WHERE id = ?
 `
 
-type UpdateRoomExitSoutheastParams struct {
-	Southeast int64
-	ID        int64
+type UpdateRoomCoordinatesParams struct {
+	X  int32
+	Y  int32
+	Z  int32
+	ID int64
 }
 
-func (q *Queries) UpdateRoomExitSoutheast(ctx context.Context, arg UpdateRoomExitSoutheastParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitSoutheastStmt, updateRoomExitSoutheast, arg.Southeast, arg.ID)
+func (q *Queries) UpdateRoomCoordinates(ctx context.Context, arg UpdateRoomCoordinatesParams) error {
+	_, err := q.exec(ctx, q.updateRoomCoordinatesStmt, updateRoomCoordinates,
+		arg.X,
+		arg.Y,
+		arg.Z,
+		arg.ID,
+	)
 	return err
 }
 
-const updateRoomExitSouthwest = `-- name: UpdateRoomExitSouthwest :exec
-UPDATE rooms SET southwest = ? WHERE id = ?
+const updateRoomDescription = `-- name: UpdateRoomDescription :exec
+UPDATE rooms SET description = ?, unmodified = false WHERE id = ?
 `
 
-type UpdateRoomExitSouthwestParams struct {
-	Southwest int64
-	ID        int64
+type UpdateRoomDescriptionParams struct {
+	Description string
+	ID          int64
 }
 
-func (q *Queries) UpdateRoomExitSouthwest(ctx context.Context, arg UpdateRoomExitSouthwestParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitSouthwestStmt, updateRoomExitSouthwest, arg.Southwest, arg.ID)
+func (q *Queries) UpdateRoomDescription(ctx context.Context, arg UpdateRoomDescriptionParams) error {
+	_, err := q.exec(ctx, q.updateRoomDescriptionStmt, updateRoomDescription, arg.Description, arg.ID)
 	return err
 }
 
-const updateRoomExitWest = `-- name: UpdateRoomExitWest :exec
-UPDATE rooms SET west = ? WHERE id = ?
+const updateRoomExitFlags = `-- name: UpdateRoomExitFlags :exec
+UPDATE
+  room_exits
+SET
+  door = ?,
+  hidden = ?,
+  locked = ?
+WHERE
+  rid = ? AND direction = ?
 `
 
-type UpdateRoomExitWestParams struct {
-	West int64
-	ID   int64
+type UpdateRoomExitFlagsParams struct {
+	Door      bool
+	Hidden    bool
+	Locked    bool
+	RID       int64
+	Direction string
 }
 
-func (q *Queries) UpdateRoomExitWest(ctx context.Context, arg UpdateRoomExitWestParams) error {
-	_, err := q.exec(ctx, q.updateRoomExitWestStmt, updateRoomExitWest, arg.West, arg.ID)
+func (q *Queries) UpdateRoomExitFlags(ctx context.Context, arg UpdateRoomExitFlagsParams) error {
+	_, err := q.exec(ctx, q.updateRoomExitFlagsStmt, updateRoomExitFlags,
+		arg.Door,
+		arg.Hidden,
+		arg.Locked,
+		arg.RID,
+		arg.Direction,
+	)
 	return err
 }
 
@@ -330,3 +497,17 @@ func (q *Queries) UpdateRoomTitle(ctx context.Context, arg UpdateRoomTitleParams
 	_, err := q.exec(ctx, q.updateRoomTitleStmt, updateRoomTitle, arg.Title, arg.ID)
 	return err
 }
+
+const updateRoomZone = `-- name: UpdateRoomZone :exec
+UPDATE rooms SET zid = ? WHERE id = ?
+`
+
+type UpdateRoomZoneParams struct {
+	ZID int64
+	ID  int64
+}
+
+func (q *Queries) UpdateRoomZone(ctx context.Context, arg UpdateRoomZoneParams) error {
+	_, err := q.exec(ctx, q.updateRoomZoneStmt, updateRoomZone, arg.ZID, arg.ID)
+	return err
+}
@@ -1,6 +1,7 @@
 package request
 
 import (
+	"context"
 	"fmt"
 	"html/template"
 	"strings"
@@ -11,7 +12,10 @@ import (
 	"petrichormud.com/app/internal/partial"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/request/change"
+	"petrichormud.com/app/internal/request/comment"
 	"petrichormud.com/app/internal/request/field"
+	"petrichormud.com/app/internal/request/status"
+	"petrichormud.com/app/internal/request/version"
 	"petrichormud.com/app/internal/route"
 )
 
@@ -31,11 +35,57 @@ type BindFieldViewParams struct {
 	Field      *query.RequestField
 	OpenChange *query.OpenRequestChangeRequest
 	Change     *query.RequestChangeRequest
+	PastChange []query.ListPastRequestChangeRequestsForFieldRow
 	Subfields  []query.RequestSubfield
+	Comments   comment.Thread
+	Diff       *FieldDiff
+	Versions   []FieldVersion
 	PID        int64
 	Last       bool
 }
 
+// LoadFieldView fills in the parts of a field view that come from the field's history: its comment
+// thread, its earlier feedback, its revisions and what's changed since its last review. Subfields must already be set.
+// Loading the view marks the thread read for the viewer.
+func LoadFieldView(ctx context.Context, q *query.Queries, p *BindFieldViewParams) error {
+	thread, err := comment.LoadThread(ctx, q, p.Field.ID, p.PID)
+	if err != nil {
+		return err
+	}
+	if err := comment.MarkRead(ctx, q, p.Field.ID, p.PID, thread); err != nil {
+		return err
+	}
+	p.Comments = thread
+
+	past, err := q.ListPastRequestChangeRequestsForField(ctx, p.Field.ID)
+	if err != nil {
+		return err
+	}
+	p.PastChange = past
+
+	if !FieldRequiresSubfields(p.Request.Type, p.Field.Type) {
+		versions, err := FieldVersions(ctx, q, p.Field.ID)
+		if err != nil {
+			return err
+		}
+		p.Versions = versions
+	}
+
+	diff, ok, err := FieldDiffSinceReview(ctx, q, FieldDiffParams{
+		Request:   p.Request,
+		Field:     p.Field,
+		Subfields: p.Subfields,
+	})
+	if err != nil {
+		return err
+	}
+	if ok {
+		p.Diff = &diff
+	}
+
+	return nil
+}
+
 func BindFieldView(e *html.Engine, b fiber.Map, p BindFieldViewParams) (fiber.Map, error) {
 	fd, err := GetFieldDefinition(p.Request.Type, p.Field.Type)
 	if err != nil {
@@ -90,10 +140,25 @@ func BindFieldView(e *html.Engine, b fiber.Map, p BindFieldViewParams) (fiber.Ma
 		PID:        p.PID,
 		OpenChange: p.OpenChange,
 		Change:     p.Change,
+		Past:       p.PastChange,
 		Request:    p.Request,
 		Field:      p.Field,
 	})
 
+	if p.Diff != nil && version.Changed(p.Diff.Segments) {
+		b["FieldDiff"] = p.Diff
+	}
+	if len(p.Versions) > 1 {
+		b["FieldVersions"] = p.Versions
+	}
+
+	b["CommentThread"] = comment.Bind(comment.BindParams{
+		Request: p.Request,
+		Field:   p.Field,
+		Thread:  p.Comments,
+		PID:     p.PID,
+	})
+
 	return b, nil
 }
 
@@ -217,6 +282,7 @@ func BindFieldViewActions(e *html.Engine, b fiber.Map, p BindFieldViewParams) (f
 type BindOverviewParams struct {
 	Request  *query.Request
 	FieldMap field.Map
+	History  []query.ListRequestStatusHistoryForRequestRow
 	PID      int64
 }
 
@@ -234,7 +300,13 @@ func BindOverview(e *html.Engine, b fiber.Map, p BindOverviewParams) (fiber.Map,
 		"StatusIcon": NewStatusIcon(StatusIconParams{Status: p.Request.Status, IconSize: 48, IncludeText: true, TextSize: "text-xl"}),
 	}
 
-	b, err = BindOverviewActions(e, b, BindOverviewActionsParams(p))
+	b["History"] = NewHistory(p.History)
+
+	b, err = BindOverviewActions(e, b, BindOverviewActionsParams{
+		Request:  p.Request,
+		FieldMap: p.FieldMap,
+		PID:      p.PID,
+	})
 	if err != nil {
 		return b, err
 	}
@@ -251,14 +323,38 @@ type BindOverviewActionsParams struct {
 func BindOverviewActions(e *html.Engine, b fiber.Map, p BindOverviewActionsParams) (fiber.Map, error) {
 	actions := []template.HTML{}
 
+	machine, err := Machine(p.Request.Type)
+	if err != nil {
+		return b, err
+	}
+
 	if p.Request.PID == p.PID {
-		cancel, err := partial.Render(e, partial.RenderParams{
-			Template: partial.RequestOverviewActionCancel,
-		})
+		cancelable, err := machine.Can(status.Params{
+			Request: p.Request,
+			PID:     p.PID,
+		}, StatusCanceled)
 		if err != nil {
 			return b, err
 		}
-		actions = append(actions, cancel)
+		if cancelable {
+			cancel, err := partial.Render(e, partial.RenderParams{
+				Template: partial.RequestOverviewActionCancel,
+			})
+			if err != nil {
+				return b, err
+			}
+			actions = append(actions, cancel)
+		}
+
+		if IsDeletable(p.Request) {
+			del, err := partial.Render(e, partial.RenderParams{
+				Template: partial.RequestOverviewActionDelete,
+			})
+			if err != nil {
+				return b, err
+			}
+			actions = append(actions, del)
+		}
 
 		unreviewedField := false
 		for _, field := range p.FieldMap {
@@ -275,26 +371,30 @@ func BindOverviewActions(e *html.Engine, b fiber.Map, p BindOverviewActionsParam
 			}
 			actions = append(actions, submit)
 		}
-		if p.Request.Status == StatusApproved {
-			fulfilledby, err := FulfilledBy(p.Request.Type)
-			if err != nil {
-				return b, err
-			}
-			// TODO: Use a constant here
-			if fulfilledby == "Player" && p.PID == p.Request.PID {
-				fulfill, err := partial.Render(e, partial.RenderParams{
-					Template: partial.RequestOverviewActionFulfill,
-					Bind: fiber.Map{
-						// TODO: Create utility to get this for Request type
-						"Text": "Create Character",
-					},
-				})
-				if err != nil {
-					return b, err
-				}
-				actions = append(actions, fulfill)
-			}
+	}
+
+	fulfillable, err := machine.Can(status.Params{
+		Request: p.Request,
+		PID:     p.PID,
+	}, StatusFulfilled)
+	if err != nil {
+		return b, err
+	}
+	if fulfillable {
+		dialogs, ok := DialogsByType[p.Request.Type]
+		if !ok {
+			return b, ErrNoDefinition
+		}
+		fulfill, err := partial.Render(e, partial.RenderParams{
+			Template: partial.RequestOverviewActionFulfill,
+			Bind: fiber.Map{
+				"Text": dialogs.Fulfill.ButtonText,
+			},
+		})
+		if err != nil {
+			return b, err
 		}
+		actions = append(actions, fulfill)
 	}
 
 	if p.Request.Status == StatusInReview && p.Request.RPID == p.PID {
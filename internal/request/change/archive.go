@@ -0,0 +1,27 @@
+package change
+
+import (
+	"context"
+
+	"petrichormud.com/app/internal/query"
+)
+
+// Archive moves a request's change requests to the past table once they've been answered
+func Archive(ctx context.Context, q *query.Queries, rid int64) error {
+	changes, err := q.ListRequestChangeRequestsForRequest(ctx, rid)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	ids := []int64{}
+	for _, change := range changes {
+		ids = append(ids, change.ID)
+	}
+	if err := q.BatchCreatePastRequestChangeRequest(ctx, ids); err != nil {
+		return err
+	}
+	return q.BatchDeleteRequestChangeRequest(ctx, ids)
+}
@@ -4,6 +4,7 @@ import (
 	fiber "github.com/gofiber/fiber/v2"
 
 	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/format"
 	"petrichormud.com/app/internal/route"
 )
 
@@ -52,9 +53,31 @@ func Bind(p BindParams) fiber.Map {
 	return b
 }
 
+// BindPast binds the feedback a field was given in earlier rounds of review, newest first
+func BindPast(field *query.RequestField, past []query.ListPastRequestChangeRequestsForFieldRow) []fiber.Map {
+	b := []fiber.Map{}
+	for _, row := range past {
+		author := format.UnknownActor
+		if row.Username.Valid {
+			author = row.Username.String
+		}
+		feedback := fiber.Map{
+			"Text":   row.PastRequestChangeRequest.Text,
+			"Author": author,
+			"Date":   row.PastRequestChangeRequest.CreatedAt.UTC().Format(format.DateLayout),
+		}
+		if row.PastRequestChangeRequest.Value != field.Value {
+			feedback["FieldValue"] = row.PastRequestChangeRequest.Value
+		}
+		b = append(b, feedback)
+	}
+	return b
+}
+
 type BindConfigParams struct {
 	OpenChange *query.OpenRequestChangeRequest
 	Change     *query.RequestChangeRequest
+	Past       []query.ListPastRequestChangeRequestsForFieldRow
 	Request    *query.Request
 	Field      *query.RequestField
 	PID        int64
@@ -78,5 +101,8 @@ func BindConfig(p BindConfigParams) fiber.Map {
 			Field:  p.Field,
 		})
 	}
+	if len(p.Past) > 0 {
+		b["Past"] = BindPast(p.Field, p.Past)
+	}
 	return b
 }
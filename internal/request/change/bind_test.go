@@ -0,0 +1,40 @@
+package change
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/format"
+)
+
+func TestBindPast(t *testing.T) {
+	field := query.RequestField{Value: "Testified"}
+	past := []query.ListPastRequestChangeRequestsForFieldRow{
+		{
+			PastRequestChangeRequest: query.PastRequestChangeRequest{
+				CreatedAt: time.Date(2024, time.January, 2, 15, 4, 0, 0, time.UTC),
+				Text:      "Please pick a different name.",
+				Value:     "Testify",
+			},
+			Username: sql.NullString{String: "testify", Valid: true},
+		},
+		{
+			PastRequestChangeRequest: query.PastRequestChangeRequest{
+				Text:  "Still not quite right.",
+				Value: "Testified",
+			},
+		},
+	}
+
+	b := BindPast(&field, past)
+	require.Len(t, b, 2)
+	require.Equal(t, "testify", b[0]["Author"])
+	require.Equal(t, "Testify", b[0]["FieldValue"])
+	require.Equal(t, "Jan 2, 2024 at 3:04 PM UTC", b[0]["Date"])
+	require.Equal(t, format.UnknownActor, b[1]["Author"])
+	require.NotContains(t, b[1], "FieldValue")
+}
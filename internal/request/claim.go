@@ -0,0 +1,108 @@
+package request
+
+import (
+	"context"
+	"errors"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+var (
+	ErrClaimed         error = errors.New("this request is claimed by another reviewer")
+	ErrNotClaimable    error = errors.New("this request can't be claimed right now")
+	ErrNotClaimant     error = errors.New("this request isn't claimed by this reviewer")
+	ErrInvalidAssignee error = errors.New("that player can't review this request")
+)
+
+// IsClaimable reports whether a request's reviewer can still be changed
+func IsClaimable(req *query.Request) bool {
+	return req.Status == StatusSubmitted || req.Status == StatusInReview
+}
+
+type ClaimParams struct {
+	Request     *query.Request
+	Permissions *player.Permissions
+	PID         int64
+}
+
+// Claim reserves a submitted request for a reviewer before they put it in review
+func Claim(q *query.Queries, p ClaimParams) error {
+	if p.Request.PID == p.PID || !CanReview(p.Permissions, p.Request.Type) {
+		return ErrNextStatusForbidden
+	}
+	if p.Request.Status != StatusSubmitted {
+		return ErrNotClaimable
+	}
+	if p.Request.RPID == p.PID {
+		return nil
+	}
+	if p.Request.RPID != 0 {
+		return ErrClaimed
+	}
+
+	return q.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+		ID:   p.Request.ID,
+		RPID: p.PID,
+	})
+}
+
+// Unclaim gives up a reviewer's claim. A request in review is sent back to Submitted.
+func Unclaim(q *query.Queries, p ClaimParams) error {
+	if p.Request.RPID != p.PID {
+		return ErrNotClaimant
+	}
+	if !IsClaimable(p.Request) {
+		return ErrNotClaimable
+	}
+
+	if p.Request.Status == StatusInReview {
+		return UpdateStatus(q, UpdateStatusParams{
+			Request:     p.Request,
+			Permissions: p.Permissions,
+			PID:         p.PID,
+			Status:      StatusSubmitted,
+			Note:        "Unclaimed by reviewer",
+		})
+	}
+
+	return q.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+		ID:   p.Request.ID,
+		RPID: 0,
+	})
+}
+
+type AssignParams struct {
+	Request             *query.Request
+	Permissions         *player.Permissions
+	AssigneePermissions *player.Permissions
+}
+
+// Assign hands a request to a named reviewer, replacing any existing claim. It's recorded
+// in the request's status history, though the status itself doesn't change.
+func Assign(q *query.Queries, p AssignParams) error {
+	if !p.Permissions.HasPermission(player.PermissionAssignRequestReviewers.Name) {
+		return ErrNextStatusForbidden
+	}
+	if !IsClaimable(p.Request) {
+		return ErrNotClaimable
+	}
+	if p.AssigneePermissions.PID == p.Request.PID || !CanReview(p.AssigneePermissions, p.Request.Type) {
+		return ErrInvalidAssignee
+	}
+
+	if err := q.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+		ID:   p.Request.ID,
+		RPID: p.AssigneePermissions.PID,
+	}); err != nil {
+		return err
+	}
+
+	// The sweeper times a review from its latest InReview row, so a new reviewer gets a full window
+	return q.CreateRequestStatusHistory(context.Background(), query.CreateRequestStatusHistoryParams{
+		RID:        p.Request.ID,
+		PID:        p.Permissions.PID,
+		FromStatus: p.Request.Status,
+		ToStatus:   p.Request.Status,
+	})
+}
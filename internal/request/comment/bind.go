@@ -0,0 +1,77 @@
+package comment
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/format"
+	"petrichormud.com/app/internal/request/status"
+	"petrichormud.com/app/internal/route"
+)
+
+// IsOpen reports whether a request in the given status takes new comments
+func IsOpen(st string) bool {
+	switch st {
+	case status.Submitted, status.InReview, status.Reviewed:
+		return true
+	}
+	return false
+}
+
+// Unread counts the comments posted by someone else after the last one the player read
+func Unread(comments []query.ListRequestFieldCommentsForFieldRow, lastRead, pid int64) int {
+	count := 0
+	for _, c := range comments {
+		if c.RequestFieldComment.ID > lastRead && c.RequestFieldComment.PID != pid {
+			count++
+		}
+	}
+	return count
+}
+
+// LatestID is the ID of the newest comment in a thread, or zero for an empty thread
+func LatestID(comments []query.ListRequestFieldCommentsForFieldRow) int64 {
+	var latest int64 = 0
+	for _, c := range comments {
+		if c.RequestFieldComment.ID > latest {
+			latest = c.RequestFieldComment.ID
+		}
+	}
+	return latest
+}
+
+type BindParams struct {
+	Request *query.Request
+	Field   *query.RequestField
+	Thread  Thread
+	PID     int64
+}
+
+func Bind(p BindParams) fiber.Map {
+	comments := []fiber.Map{}
+	for _, c := range p.Thread.Comments {
+		author := format.UnknownActor
+		if c.Username.Valid {
+			author = c.Username.String
+		}
+		comments = append(comments, fiber.Map{
+			"Author": author,
+			"Text":   c.RequestFieldComment.Text,
+			"Date":   c.RequestFieldComment.CreatedAt.UTC().Format(format.DateLayout),
+			"Unread": c.RequestFieldComment.ID > p.Thread.LastRead && c.RequestFieldComment.PID != p.PID,
+			"Mine":   c.RequestFieldComment.PID == p.PID,
+		})
+	}
+
+	open := IsOpen(p.Request.Status)
+	return fiber.Map{
+		"Comments":     comments,
+		"Unread":       Unread(p.Thread.Comments, p.Thread.LastRead, p.PID),
+		"Resolved":     p.Thread.Resolution != nil,
+		"Path":         route.RequestFieldCommentsPath(p.Request.ID, p.Field.Type),
+		"ResolvedPath": route.RequestFieldCommentsResolvedPath(p.Request.ID, p.Field.Type),
+		"ShowForm":     open,
+		"ShowResolve":  open && len(p.Thread.Comments) > 0 && p.Thread.Resolution == nil,
+		"ShowReopen":   open && p.Thread.Resolution != nil,
+	}
+}
@@ -0,0 +1,64 @@
+package comment
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/status"
+)
+
+func testComment(id, pid int64) query.ListRequestFieldCommentsForFieldRow {
+	return query.ListRequestFieldCommentsForFieldRow{
+		RequestFieldComment: query.RequestFieldComment{ID: id, PID: pid, Text: "Test"},
+		Username:            sql.NullString{String: "testify", Valid: true},
+	}
+}
+
+func TestUnreadSkipsOwnAndReadComments(t *testing.T) {
+	comments := []query.ListRequestFieldCommentsForFieldRow{
+		testComment(1, 2),
+		testComment(2, 1),
+		testComment(3, 2),
+		testComment(4, 2),
+	}
+	require.Equal(t, 3, Unread(comments, 0, 1))
+	require.Equal(t, 2, Unread(comments, 2, 1))
+	require.Equal(t, 0, Unread(comments, 4, 1))
+}
+
+func TestLatestID(t *testing.T) {
+	require.Equal(t, int64(0), LatestID(nil))
+	require.Equal(t, int64(7), LatestID([]query.ListRequestFieldCommentsForFieldRow{testComment(3, 1), testComment(7, 2)}))
+}
+
+func TestBindClosedRequestHidesActions(t *testing.T) {
+	b := Bind(BindParams{
+		Request: &query.Request{ID: 1, Status: status.Fulfilled},
+		Field:   &query.RequestField{Type: "name"},
+		Thread: Thread{
+			Comments: []query.ListRequestFieldCommentsForFieldRow{testComment(1, 2)},
+		},
+		PID: 1,
+	})
+	require.False(t, b["ShowForm"].(bool))
+	require.False(t, b["ShowResolve"].(bool))
+	require.Equal(t, 1, b["Unread"])
+}
+
+func TestBindResolvedThread(t *testing.T) {
+	b := Bind(BindParams{
+		Request: &query.Request{ID: 1, Status: status.InReview},
+		Field:   &query.RequestField{Type: "name"},
+		Thread: Thread{
+			Comments:   []query.ListRequestFieldCommentsForFieldRow{testComment(1, 2)},
+			Resolution: &query.RequestFieldCommentResolution{RFID: 1, PID: 2},
+		},
+		PID: 1,
+	})
+	require.True(t, b["Resolved"].(bool))
+	require.False(t, b["ShowResolve"].(bool))
+	require.True(t, b["ShowReopen"].(bool))
+}
@@ -0,0 +1,113 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"petrichormud.com/app/internal/query"
+)
+
+var (
+	ErrEmptyThread error = errors.New("there are no comments to resolve")
+	ErrInvalidText error = errors.New("invalid comment text")
+)
+
+// Thread is the conversation attached to a single request field, as seen by one player
+type Thread struct {
+	Comments   []query.ListRequestFieldCommentsForFieldRow
+	Resolution *query.RequestFieldCommentResolution
+	LastRead   int64
+}
+
+func LoadThread(ctx context.Context, q *query.Queries, rfid, pid int64) (Thread, error) {
+	comments, err := q.ListRequestFieldCommentsForField(ctx, rfid)
+	if err != nil {
+		return Thread{}, err
+	}
+	thread := Thread{Comments: comments}
+
+	resolution, err := q.GetRequestFieldCommentResolution(ctx, rfid)
+	if err != nil && err != sql.ErrNoRows {
+		return Thread{}, err
+	}
+	if err == nil {
+		thread.Resolution = &resolution
+	}
+
+	read, err := q.GetRequestFieldCommentRead(ctx, query.GetRequestFieldCommentReadParams{
+		RFID: rfid,
+		PID:  pid,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return Thread{}, err
+	}
+	if err == nil {
+		thread.LastRead = read.CID
+	}
+
+	return thread, nil
+}
+
+// MarkRead records that the player has seen every comment currently in the thread
+func MarkRead(ctx context.Context, q *query.Queries, rfid, pid int64, t Thread) error {
+	latest := LatestID(t.Comments)
+	if latest <= t.LastRead {
+		return nil
+	}
+	return q.MarkRequestFieldCommentsRead(ctx, query.MarkRequestFieldCommentsReadParams{
+		CID:  latest,
+		RFID: rfid,
+		PID:  pid,
+	})
+}
+
+type PostParams struct {
+	Text string
+	RFID int64
+	PID  int64
+}
+
+// Post adds a comment to a field's thread. A reply to a resolved thread reopens it.
+func Post(ctx context.Context, q *query.Queries, p PostParams) error {
+	text := SanitizeText(p.Text)
+	if !IsTextValid(text) {
+		return ErrInvalidText
+	}
+
+	if err := q.CreateRequestFieldComment(ctx, query.CreateRequestFieldCommentParams{
+		Text: text,
+		RFID: p.RFID,
+		PID:  p.PID,
+	}); err != nil {
+		return err
+	}
+	return q.DeleteRequestFieldCommentResolution(ctx, p.RFID)
+}
+
+func Resolve(ctx context.Context, q *query.Queries, rfid, pid int64) error {
+	comments, err := q.ListRequestFieldCommentsForField(ctx, rfid)
+	if err != nil {
+		return err
+	}
+	if len(comments) == 0 {
+		return ErrEmptyThread
+	}
+
+	_, err = q.GetRequestFieldCommentResolution(ctx, rfid)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	return q.CreateRequestFieldCommentResolution(ctx, query.CreateRequestFieldCommentResolutionParams{
+		RFID: rfid,
+		PID:  pid,
+	})
+}
+
+func Reopen(ctx context.Context, q *query.Queries, rfid int64) error {
+	return q.DeleteRequestFieldCommentResolution(ctx, rfid)
+}
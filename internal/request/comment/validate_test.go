@@ -0,0 +1,30 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeTextWithoutInvalidChars(t *testing.T) {
+	text := "Could you say more about the 2nd paragraph? It reads a bit oddly."
+	require.Equal(t, text, SanitizeText(text))
+}
+
+func TestSanitizeTextWithInvalidChars(t *testing.T) {
+	text := "test<b>@"
+	expected := "testb"
+	require.Equal(t, expected, SanitizeText(text))
+}
+
+func TestIsTextValidWithValid(t *testing.T) {
+	require.True(t, IsTextValid("Sure, that works."))
+}
+
+func TestIsTextValidWithEmpty(t *testing.T) {
+	require.False(t, IsTextValid(""))
+}
+
+func TestIsTextValidWithInvalid(t *testing.T) {
+	require.False(t, IsTextValid("test@%%"))
+}
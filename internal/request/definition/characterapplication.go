@@ -2,6 +2,7 @@ package definition
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"html/template"
@@ -13,38 +14,34 @@ import (
 	"petrichormud.com/app/internal/actor"
 	"petrichormud.com/app/internal/bind"
 	"petrichormud.com/app/internal/partial"
+	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/request/dialog"
 	"petrichormud.com/app/internal/request/field"
+	"petrichormud.com/app/internal/request/status"
 	"petrichormud.com/app/internal/route"
 )
 
 // TODO: Get this in a shared package
 var (
-	ErrMissingField      error = errors.New("a field is missing")
-	ErrCurrentActorImage error = errors.New("this player already has a current actor")
+	ErrMissingField     error = errors.New("a field is missing")
+	ErrInvalidField     error = errors.New("a field is invalid")
+	ErrActorImageExists error = errors.New("an actor image already exists for this request")
 )
 
 // TODO: Create constants for FieldTypes and lift them into the Request
 
+const TypeCharacterApplication string = "CharacterApplication"
+
 type fulfillerCharacterApplication struct{}
 
-// TODO: This should return a constant
 func (f *fulfillerCharacterApplication) By() string {
-	return "Player"
+	return FulfilledByPlayer
 }
 
 // TODO: Split these individual steps out into their own functions?
-func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Request) error {
-	currentcount, err := q.CountCurrentActorImagePlayerPropertiesForPlayer(context.Background(), req.PID)
-	if err != nil {
-		return err
-	}
-	if currentcount > 0 {
-		return ErrCurrentActorImage
-	}
-
-	fields, err := q.ListRequestFieldsForRequest(context.Background(), req.ID)
+func (f *fulfillerCharacterApplication) Fulfill(ctx context.Context, q *query.Queries, req *query.Request) error {
+	fields, err := q.ListRequestFieldsForRequest(ctx, req.ID)
 	if err != nil {
 		return err
 	}
@@ -71,7 +68,20 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 	var nb strings.Builder
 	fmt.Fprintf(&nb, "%d-%d-%s", req.PID, req.ID, namefield.Value)
 	name := nb.String()
-	result, err := q.CreateActorImage(context.Background(), query.CreateActorImageParams{
+	available, err := actor.IsCharacterNameAvailable(ctx, q, namefield.Value, req.ID)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return actor.ErrCharacterNameReserved
+	}
+	// The image name is derived from the request, so an existing image means this request was already fulfilled
+	if _, err := q.GetActorImageByName(ctx, name); err == nil {
+		return ErrActorImageExists
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+	result, err := q.CreateActorImage(ctx, query.CreateActorImageParams{
 		Name:             name,
 		Gender:           genderfield.Value,
 		ShortDescription: sdescfield.Value,
@@ -85,7 +95,7 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 		return err
 	}
 
-	if err := q.UpdateActorImageUnique(context.Background(), query.UpdateActorImageUniqueParams{
+	if err := q.UpdateActorImageUnique(ctx, query.UpdateActorImageUniqueParams{
 		ID:     aiid,
 		Unique: true,
 	}); err != nil {
@@ -96,12 +106,12 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 	if !ok {
 		return ErrMissingField
 	}
-	keywordsubfields, err := q.ListRequestSubfieldsForField(context.Background(), keywordsfield.ID)
+	keywordsubfields, err := q.ListRequestSubfieldsForField(ctx, keywordsfield.ID)
 	if err != nil {
 		return err
 	}
 	for _, keywordsubfield := range keywordsubfields {
-		_, err := q.CreateActorImageKeyword(context.Background(), query.CreateActorImageKeywordParams{
+		_, err := q.CreateActorImageKeyword(ctx, query.CreateActorImageKeywordParams{
 			AIID:    aiid,
 			Keyword: keywordsubfield.Value,
 		})
@@ -113,14 +123,14 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 	// TODO: Create Actor Permissions
 
 	// TODO: Maybe add a string name to each hand?
-	_, err = q.CreateActorImageHand(context.Background(), query.CreateActorImageHandParams{
+	_, err = q.CreateActorImageHand(ctx, query.CreateActorImageHandParams{
 		AIID: aiid,
 		Hand: 1,
 	})
 	if err != nil {
 		return err
 	}
-	_, err = q.CreateActorImageHand(context.Background(), query.CreateActorImageHandParams{
+	_, err = q.CreateActorImageHand(ctx, query.CreateActorImageHandParams{
 		AIID: aiid,
 		Hand: 2,
 	})
@@ -129,7 +139,7 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 	}
 
 	// TODO: Rename Key to Type here?
-	if err := q.CreateActorImageCharacterMetadata(context.Background(), query.CreateActorImageCharacterMetadataParams{
+	if err := q.CreateActorImageCharacterMetadata(ctx, query.CreateActorImageCharacterMetadataParams{
 		AIID:  aiid,
 		Key:   FieldCharacterApplicationName.Type,
 		Value: namefield.Value,
@@ -140,7 +150,7 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 	if !ok {
 		return ErrMissingField
 	}
-	if err := q.CreateActorImageCharacterMetadata(context.Background(), query.CreateActorImageCharacterMetadataParams{
+	if err := q.CreateActorImageCharacterMetadata(ctx, query.CreateActorImageCharacterMetadataParams{
 		AIID:  aiid,
 		Key:   FieldCharacterApplicationBackstory.Type,
 		Value: backstoryfield.Value,
@@ -148,7 +158,7 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 		return err
 	}
 
-	result, err = q.CreateActorImagePlayerProperties(context.Background(), query.CreateActorImagePlayerPropertiesParams{
+	result, err = q.CreateActorImagePlayerProperties(ctx, query.CreateActorImagePlayerPropertiesParams{
 		AIID: aiid,
 		PID:  req.PID,
 	})
@@ -159,7 +169,16 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 	if err != nil {
 		return err
 	}
-	if err := q.SetActorImagePlayerPropertiesCurrent(context.Background(), query.SetActorImagePlayerPropertiesCurrentParams{
+
+	// A new character only becomes current if the player hasn't already picked one
+	currentcount, err := q.CountCurrentActorImagePlayerPropertiesForPlayer(ctx, req.PID)
+	if err != nil {
+		return err
+	}
+	if currentcount > 0 {
+		return nil
+	}
+	if err := q.SetActorImagePlayerPropertiesCurrent(ctx, query.SetActorImagePlayerPropertiesCurrentParams{
 		ID:      aippid,
 		Current: true,
 	}); err != nil {
@@ -171,6 +190,43 @@ func (f *fulfillerCharacterApplication) Fulfill(q *query.Queries, req *query.Req
 
 var FulfillerCharacterApplication fulfillerCharacterApplication = fulfillerCharacterApplication{}
 
+type hookCharacterApplication struct{}
+
+// OnStatus holds the character's name while the application is in flight
+func (h *hookCharacterApplication) OnStatus(ctx context.Context, q *query.Queries, req *query.Request, to string) error {
+	switch to {
+	case status.Submitted:
+		namefield, err := q.GetRequestFieldByType(ctx, query.GetRequestFieldByTypeParams{
+			RID:  req.ID,
+			Type: FieldCharacterApplicationName.Type,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrMissingField
+			}
+			return err
+		}
+		return actor.ReserveCharacterName(ctx, q, actor.ReserveCharacterNameParams{
+			Name: namefield.Value,
+			RID:  req.ID,
+			PID:  req.PID,
+		})
+	case status.Canceled, status.Rejected, status.Fulfilled:
+		return actor.ReleaseCharacterName(ctx, q, req.ID)
+	}
+	return nil
+}
+
+var HookCharacterApplication hookCharacterApplication = hookCharacterApplication{}
+
+var MachineCharacterApplication status.Machine = status.NewMachine(append(
+	append(
+		status.ReviewTransitions(player.PermissionReviewCharacterApplications.Name),
+		status.CancelTransitions()...,
+	),
+	status.FulfillTransition(status.ActorAuthor),
+))
+
 type titlerCharacterApplication struct{}
 
 func (t *titlerCharacterApplication) ForOverview(fields field.Map) string {
@@ -206,6 +262,20 @@ var FieldsCharacterApplication field.Group = field.NewGroup([]field.Field{
 	FieldCharacterApplicationKeywords,
 })
 
+type fieldCharacterApplicationNameFormRenderer struct{}
+
+func (f *fieldCharacterApplicationNameFormRenderer) Render(e *html.Engine, field *query.RequestField, _ []query.RequestSubfield, template string) (template.HTML, error) {
+	return partial.Render(e, partial.RenderParams{
+		Template: template,
+		Bind: fiber.Map{
+			"FormID":       "request-form",
+			"Path":         route.RequestFieldTypePath(field.RID, field.Type),
+			"ReservedPath": route.CharacterNameReservedPath(field.RID),
+			"FieldValue":   field.Value,
+		},
+	})
+}
+
 func NewFieldCharacterApplicationName() field.Field {
 	b := field.FieldBuilder()
 	b.Type("name")
@@ -215,7 +285,7 @@ func NewFieldCharacterApplicationName() field.Field {
 	b.Help(partial.RequestFieldHelpCharacterApplicationName)
 	b.Data(partial.RequestFieldDataCharacterApplicationName)
 	b.Form(partial.RequestFieldFormCharacterApplicationName)
-	b.FormRenderer(new(field.DefaultRenderer))
+	b.FormRenderer(new(fieldCharacterApplicationNameFormRenderer))
 	b.Validator(&actor.CharacterNameValidator)
 	return b.Build()
 }
@@ -382,4 +452,11 @@ var DialogsCharacterApplication dialog.DefinitionGroup = dialog.DefinitionGroup{
 		Variable:   dialog.VariableFulfill,
 		Type:       dialog.TypePrimary,
 	},
+	Delete: dialog.Definition{
+		Header:     "Delete This Application?",
+		Text:       template.HTML("Once deleted, this application will be hidden from your characters. You can restore it for a week after deleting it."),
+		ButtonText: "Delete This Application",
+		Variable:   dialog.VariableDelete,
+		Type:       dialog.TypeDestructive,
+	},
 }
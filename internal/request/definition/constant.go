@@ -0,0 +1,7 @@
+package definition
+
+// Who is responsible for fulfilling an approved request
+const (
+	FulfilledByPlayer   string = "Player"
+	FulfilledByReviewer string = "Reviewer"
+)
@@ -0,0 +1,241 @@
+package definition
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+
+	fiber "github.com/gofiber/fiber/v2"
+	html "github.com/gofiber/template/html/v2"
+
+	"petrichormud.com/app/internal/bind"
+	"petrichormud.com/app/internal/partial"
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/dialog"
+	"petrichormud.com/app/internal/request/field"
+	"petrichormud.com/app/internal/request/status"
+	"petrichormud.com/app/internal/room"
+	"petrichormud.com/app/internal/route"
+)
+
+const TypeRoomProposal string = "RoomProposal"
+
+type fulfillerRoomProposal struct{}
+
+func (f *fulfillerRoomProposal) By() string {
+	return FulfilledByReviewer
+}
+
+func (f *fulfillerRoomProposal) Fulfill(ctx context.Context, q *query.Queries, req *query.Request) error {
+	fields, err := q.ListRequestFieldsForRequest(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	fieldmap := field.NewMap(fields)
+
+	titlefield, ok := fieldmap[FieldRoomProposalTitle.Type]
+	if !ok {
+		return ErrMissingField
+	}
+	descfield, ok := fieldmap[FieldRoomProposalDescription.Type]
+	if !ok {
+		return ErrMissingField
+	}
+	sizefield, ok := fieldmap[FieldRoomProposalSize.Type]
+	if !ok {
+		return ErrMissingField
+	}
+
+	if !FieldRoomProposalTitle.IsValid(titlefield.Value) {
+		return ErrInvalidField
+	}
+	if !FieldRoomProposalDescription.IsValid(descfield.Value) {
+		return ErrInvalidField
+	}
+	if !FieldRoomProposalSize.IsValid(sizefield.Value) {
+		return ErrInvalidField
+	}
+	size, err := strconv.ParseInt(sizefield.Value, 10, 32)
+	if err != nil {
+		return ErrInvalidField
+	}
+
+	if _, err := q.CreateRoom(ctx, query.CreateRoomParams{
+		Title:       titlefield.Value,
+		Description: descfield.Value,
+		Size:        int32(size),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var FulfillerRoomProposal fulfillerRoomProposal = fulfillerRoomProposal{}
+
+var MachineRoomProposal status.Machine = status.NewMachine(append(
+	append(
+		status.ReviewTransitions(player.PermissionReviewRoomProposals.Name),
+		status.CancelTransitions()...,
+	),
+	status.FulfillTransition(status.ActorReviewer),
+))
+
+type titlerRoomProposal struct{}
+
+func (t *titlerRoomProposal) ForOverview(fields field.Map) string {
+	var sb strings.Builder
+	title := "Untitled"
+	field, ok := fields[FieldRoomProposalTitle.Type]
+	if ok && len(field.Value) > 0 {
+		title = field.Value
+	}
+	fmt.Fprintf(&sb, "Room Proposal (%s)", title)
+	return sb.String()
+}
+
+var TitlerRoomProposal titlerRoomProposal = titlerRoomProposal{}
+
+var (
+	FieldRoomProposalTitle       field.Field = NewFieldRoomProposalTitle()
+	FieldRoomProposalDescription field.Field = NewFieldRoomProposalDescription()
+	FieldRoomProposalSize        field.Field = NewFieldRoomProposalSize()
+)
+
+var FieldsRoomProposal field.Group = field.NewGroup([]field.Field{
+	FieldRoomProposalTitle,
+	FieldRoomProposalDescription,
+	FieldRoomProposalSize,
+})
+
+func NewFieldRoomProposalTitle() field.Field {
+	b := field.FieldBuilder()
+	b.Type("title")
+	b.For(field.ForPlayer)
+	b.Label("Title")
+	b.Description("The title shown at the top of the room")
+	b.Help(partial.RequestFieldHelpRoomProposalTitle)
+	b.Data(partial.RequestFieldDataRoomProposalTitle)
+	b.Form(partial.RequestFieldFormRoomProposalTitle)
+	b.FormRenderer(new(field.DefaultRenderer))
+	b.Validator(&room.TitleValidator)
+	return b.Build()
+}
+
+func NewFieldRoomProposalDescription() field.Field {
+	b := field.FieldBuilder()
+	b.Type("desc")
+	b.For(field.ForPlayer)
+	b.Label("Description")
+	b.Description("What players will see when they look around the room")
+	b.Help(partial.RequestFieldHelpRoomProposalDescription)
+	b.Data(partial.RequestFieldDataRoomProposalDescription)
+	b.Form(partial.RequestFieldFormRoomProposalDescription)
+	b.FormRenderer(new(field.DefaultRenderer))
+	b.Validator(&room.DescriptionValidator)
+	return b.Build()
+}
+
+type fieldRoomProposalSizeFormRenderer struct{}
+
+func (f *fieldRoomProposalSizeFormRenderer) Render(e *html.Engine, field *query.RequestField, _ []query.RequestSubfield, template string) (template.HTML, error) {
+	b := fiber.Map{
+		"FormID":     "request-form",
+		"Path":       route.RequestFieldTypePath(field.RID, field.Type),
+		"FieldValue": field.Value,
+	}
+	radios := []bind.Radio{}
+	for size := int32(0); size <= 4; size++ {
+		value := strconv.Itoa(int(size))
+		label := room.SizeToString(size)
+		radios = append(radios, bind.Radio{
+			ID:       "edit-request-room-proposal-size-" + strings.ToLower(label),
+			Name:     "value",
+			Variable: "size",
+			Value:    value,
+			Label:    label,
+			Active:   field.Value == value,
+		})
+	}
+	b["SizeRadioGroup"] = radios
+	return partial.Render(e, partial.RenderParams{
+		Template: template,
+		Bind:     b,
+	})
+}
+
+func NewFieldRoomProposalSize() field.Field {
+	b := field.FieldBuilder()
+	b.Type("size")
+	b.For(field.ForPlayer)
+	b.Label("Size")
+	b.Description("How large the room is, from Tiny to Huge")
+	b.Help(partial.RequestFieldHelpRoomProposalSize)
+	b.Data(partial.RequestFieldDataRoomProposalSize)
+	b.Form(partial.RequestFieldFormRoomProposalSize)
+	b.FormRenderer(new(fieldRoomProposalSizeFormRenderer))
+	b.Validator(&room.SizeValidator)
+	return b.Build()
+}
+
+var DialogsRoomProposal dialog.DefinitionGroup = dialog.DefinitionGroup{
+	Submit: dialog.Definition{
+		Header:     "Submit This Proposal?",
+		Text:       "Once your room proposal is put in review, this cannot be undone.",
+		ButtonText: "Submit This Proposal",
+		Variable:   dialog.VariableSubmit,
+		Type:       dialog.TypePrimary,
+	},
+	Cancel: dialog.Definition{
+		Header:     "Cancel This Proposal?",
+		Text:       "Once you've canceled this proposal, it cannot be undone. If you want to propose this room again in the future, you'll need to create a new proposal.",
+		ButtonText: "Cancel This Proposal",
+		Variable:   dialog.VariableCancel,
+		Type:       dialog.TypeDestructive,
+	},
+	PutInReview: dialog.Definition{
+		Header:     "Put This Proposal In Review?",
+		Text:       template.HTML("After picking up this proposal, you'll be the only reviewer able to review it."),
+		ButtonText: "I'm Ready to Review This Proposal",
+		Variable:   dialog.VariablePutInReview,
+		Type:       dialog.TypePrimary,
+	},
+	Approve: dialog.Definition{
+		Header:     "Approve This Room Proposal?",
+		Text:       template.HTML("Once approved, <span class=\"font-semibold\">this cannot be undone</span>. You'll then be able to build the room."),
+		ButtonText: "Approve Proposal",
+		Variable:   dialog.VariableApprove,
+		Type:       dialog.TypePrimary,
+	},
+	FinishReview: dialog.Definition{
+		Header:     "Finish Reviewing This Room Proposal?",
+		Text:       template.HTML("Once you finish reviewing, <span class=\"font-semibold\">this cannot be undone</span>. It will be sent back for the player to update and re-submit. Please make sure your change requests are clear!"),
+		ButtonText: "Finish Review",
+		Variable:   dialog.VariableFinishReview,
+		Type:       dialog.TypePrimary,
+	},
+	Reject: dialog.Definition{
+		Header:     "Reject This Room Proposal?",
+		Text:       template.HTML("Once rejected, this Proposal <span class=\"font-semibold\">cannot be re-opened</span>. Please be absolutely certain before doing this."),
+		ButtonText: "Reject",
+		Variable:   dialog.VariableReject,
+		Type:       dialog.TypeDestructive,
+	},
+	Fulfill: dialog.Definition{
+		Header:     "Build This Room?",
+		Text:       template.HTML("This will create the room from this proposal. It won't be linked to the grid until a builder adds its exits."),
+		ButtonText: "Build Room",
+		Variable:   dialog.VariableFulfill,
+		Type:       dialog.TypePrimary,
+	},
+	Delete: dialog.Definition{
+		Header:     "Delete This Proposal?",
+		Text:       template.HTML("Once deleted, this proposal will be hidden from your proposals. You can restore it for a week after deleting it."),
+		ButtonText: "Delete This Proposal",
+		Variable:   dialog.VariableDelete,
+		Type:       dialog.TypeDestructive,
+	},
+}
@@ -0,0 +1,91 @@
+package request
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"petrichormud.com/app/internal/query"
+)
+
+// DeletionGracePeriod is how long a deleted request can be restored by its author
+const DeletionGracePeriod time.Duration = 7 * 24 * time.Hour
+
+var (
+	ErrNotDeletable  error = errors.New("this request can't be deleted")
+	ErrNotDeleted    error = errors.New("this request hasn't been deleted")
+	ErrNotRestorable error = errors.New("this request can no longer be restored")
+)
+
+func IsDeletable(req *query.Request) bool {
+	return req.Status == StatusCanceled || req.Status == StatusArchived
+}
+
+func IsDeleted(q *query.Queries, rid int64) (bool, error) {
+	_, err := q.GetRequestDeletion(context.Background(), rid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func IsRestorable(deletion *query.RequestDeletion, now time.Time) bool {
+	return now.Before(deletion.CreatedAt.Add(DeletionGracePeriod))
+}
+
+// RestorableSince yields the earliest deletion time that can still be restored
+func RestorableSince(now time.Time) time.Time {
+	return now.Add(-DeletionGracePeriod)
+}
+
+type DeleteParams struct {
+	Request *query.Request
+	PID     int64
+}
+
+func Delete(q *query.Queries, p DeleteParams) error {
+	if !IsDeletable(p.Request) {
+		return ErrNotDeletable
+	}
+
+	deleted, err := IsDeleted(q, p.Request.ID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return ErrNotDeletable
+	}
+
+	if err := q.CreateRequestDeletion(context.Background(), query.CreateRequestDeletionParams{
+		RID: p.Request.ID,
+		PID: p.PID,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func Restore(q *query.Queries, rid int64) error {
+	deletion, err := q.GetRequestDeletion(context.Background(), rid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNotDeleted
+		}
+		return err
+	}
+
+	if !IsRestorable(&deletion, time.Now()) {
+		return ErrNotRestorable
+	}
+
+	if err := q.DeleteRequestDeletion(context.Background(), rid); err != nil {
+		return err
+	}
+
+	return nil
+}
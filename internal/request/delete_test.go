@@ -0,0 +1,23 @@
+package request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+)
+
+func TestIsDeletable(t *testing.T) {
+	require.True(t, IsDeletable(&query.Request{Status: StatusCanceled}))
+	require.True(t, IsDeletable(&query.Request{Status: StatusArchived}))
+	require.False(t, IsDeletable(&query.Request{Status: StatusSubmitted}))
+	require.False(t, IsDeletable(&query.Request{Status: StatusFulfilled}))
+}
+
+func TestIsRestorable(t *testing.T) {
+	now := time.Now()
+	require.True(t, IsRestorable(&query.RequestDeletion{CreatedAt: now.Add(-time.Hour)}, now))
+	require.False(t, IsRestorable(&query.RequestDeletion{CreatedAt: now.Add(-DeletionGracePeriod - time.Hour)}, now))
+}
@@ -4,13 +4,10 @@ import (
 	fiber "github.com/gofiber/fiber/v2"
 
 	"petrichormud.com/app/internal/query"
-	"petrichormud.com/app/internal/request/definition"
 	"petrichormud.com/app/internal/request/dialog"
 )
 
-var DialogsByType map[string]*dialog.DefinitionGroup = map[string]*dialog.DefinitionGroup{
-	TypeCharacterApplication: &definition.DialogsCharacterApplication,
-}
+var DialogsByType map[string]*dialog.DefinitionGroup = map[string]*dialog.DefinitionGroup{}
 
 func BindDialogs(b fiber.Map, req *query.Request) (fiber.Map, error) {
 	dialogs, ok := DialogsByType[req.Type]
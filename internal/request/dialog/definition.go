@@ -14,6 +14,7 @@ const (
 	VariableFinishReview = "showFinishReviewDialog"
 	VariableReject       = "showRejectDialog"
 	VariableFulfill      = "showFulfillDialog"
+	VariableDelete       = "showDeleteDialog"
 )
 
 const (
@@ -38,6 +39,7 @@ type DefinitionGroup struct {
 	FinishReview Definition
 	Reject       Definition
 	Fulfill      Definition
+	Delete       Definition
 }
 
 func (d *DefinitionGroup) Slice() []Definition {
@@ -49,6 +51,7 @@ func (d *DefinitionGroup) Slice() []Definition {
 		d.FinishReview,
 		d.Reject,
 		d.Fulfill,
+		d.Delete,
 	}
 }
 
@@ -59,5 +62,7 @@ func (d *DefinitionGroup) SetPath(rid int64) {
 	d.PutInReview.Path = path
 	d.Approve.Path = path
 	d.FinishReview.Path = path
+	d.Reject.Path = path
 	d.Fulfill.Path = path
+	d.Delete.Path = route.RequestPath(rid)
 }
@@ -17,6 +17,10 @@ func DeleteTestRequest(t *testing.T, i *service.Interfaces, rid int64) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	_, err = i.Database.Exec("DELETE FROM request_status_history WHERE rid = ?;", rid)
+	if err != nil {
+		t.Fatal(err)
+	}
 }
 
 func TestIsEditablePlayerFieldFalseNotPlayer(t *testing.T) {
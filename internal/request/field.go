@@ -7,13 +7,10 @@ import (
 	html "github.com/gofiber/template/html/v2"
 
 	"petrichormud.com/app/internal/query"
-	"petrichormud.com/app/internal/request/definition"
 	"petrichormud.com/app/internal/request/field"
 )
 
-var FieldsByType map[string]field.Group = map[string]field.Group{
-	TypeCharacterApplication: definition.FieldsCharacterApplication,
-}
+var FieldsByType map[string]field.Group = map[string]field.Group{}
 
 func GetFieldDefinition(t, ft string) (field.Field, error) {
 	fg, ok := FieldsByType[t]
@@ -53,6 +50,13 @@ func UpdateField(q *query.Queries, p UpdateFieldParams) error {
 	}); err != nil {
 		return err
 	}
+	if err := q.CreateRequestFieldVersion(context.Background(), query.CreateRequestFieldVersionParams{
+		Value: p.Value,
+		RFID:  p.Field.ID,
+		PID:   p.PID,
+	}); err != nil {
+		return err
+	}
 	if err := q.UpdateRequestFieldStatus(context.Background(), query.UpdateRequestFieldStatusParams{
 		ID:     p.Field.ID,
 		Status: FieldStatusNotReviewed,
@@ -0,0 +1,7 @@
+package format
+
+// DateLayout is how dates are shown alongside a request's history, versions, comments and feedback
+const DateLayout = "Jan 2, 2006 at 3:04 PM MST"
+
+// UnknownActor stands in for a player that's no longer around to name
+const UnknownActor = "Someone"
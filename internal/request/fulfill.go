@@ -1,33 +1,64 @@
 package request
 
 import (
+	"context"
+	"database/sql"
+
+	"petrichormud.com/app/internal/actor"
+	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/request/definition"
 )
 
+// Fulfiller performs the side effects of a request once it's approved. Every
+// write must go through the passed Queries, which are bound to the fulfillment's transaction.
 type Fulfiller interface {
 	By() string
-	Fulfill(q *query.Queries, req *query.Request) error
+	Fulfill(ctx context.Context, q *query.Queries, req *query.Request) error
 }
 
-var FulfillersByType map[string]Fulfiller = map[string]Fulfiller{
-	TypeCharacterApplication: &definition.FulfillerCharacterApplication,
+var (
+	ErrActorImageExists      error = definition.ErrActorImageExists
+	ErrCharacterNameReserved error = actor.ErrCharacterNameReserved
+)
+
+var FulfillersByType map[string]Fulfiller = map[string]Fulfiller{}
+
+type FulfillParams struct {
+	Permissions *player.Permissions
+	RID         int64
+	PID         int64
 }
 
-func Fulfill(q *query.Queries, pid int64, req *query.Request) error {
+// Fulfill runs a request's fulfiller and moves it to Fulfilled inside a single transaction.
+// The request row is locked for the duration, so a retried or concurrent fulfillment
+// of a request that's already been fulfilled is a no-op.
+func Fulfill(ctx context.Context, tx *sql.Tx, q *query.Queries, p FulfillParams) error {
+	qtx := q.WithTx(tx)
+
+	req, err := qtx.GetRequestForUpdate(ctx, p.RID)
+	if err != nil {
+		return err
+	}
+
+	if req.Status == StatusFulfilled {
+		return nil
+	}
+
 	fulfiller, ok := FulfillersByType[req.Type]
 	if !ok {
 		return ErrNoDefinition
 	}
 
-	if err := fulfiller.Fulfill(q, req); err != nil {
+	if err := fulfiller.Fulfill(ctx, qtx, &req); err != nil {
 		return err
 	}
 
-	if err := UpdateStatus(q, UpdateStatusParams{
-		PID:     pid,
-		Request: req,
-		Status:  StatusFulfilled,
+	if err := updateStatus(ctx, qtx, UpdateStatusParams{
+		Request:     &req,
+		Permissions: p.Permissions,
+		PID:         p.PID,
+		Status:      StatusFulfilled,
 	}); err != nil {
 		return err
 	}
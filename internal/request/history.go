@@ -0,0 +1,66 @@
+package request
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/format"
+)
+
+type HistoryEntry struct {
+	Text       template.HTML
+	Note       string
+	Date       string
+	StatusIcon StatusIcon
+}
+
+func NewHistory(rows []query.ListRequestStatusHistoryForRequestRow) []HistoryEntry {
+	entries := []HistoryEntry{}
+	for _, row := range rows {
+		entries = append(entries, NewHistoryEntry(row))
+	}
+	return entries
+}
+
+func NewHistoryEntry(row query.ListRequestStatusHistoryForRequestRow) HistoryEntry {
+	actor := format.UnknownActor
+	if row.Username.Valid {
+		actor = row.Username.String
+	}
+
+	return HistoryEntry{
+		Text:       HistoryText(actor, row.RequestStatusHistory.FromStatus, row.RequestStatusHistory.ToStatus),
+		Note:       row.RequestStatusHistory.Note,
+		Date:       row.RequestStatusHistory.CreatedAt.UTC().Format(format.DateLayout),
+		StatusIcon: NewStatusIcon(StatusIconParams{Status: row.RequestStatusHistory.ToStatus, IconSize: 24}),
+	}
+}
+
+func HistoryText(actor, from, to string) template.HTML {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<span class=\"font-semibold\">%s</span> ", template.HTMLEscapeString(actor))
+
+	if len(from) == 0 {
+		fmt.Fprint(&sb, "created this request")
+		return template.HTML(sb.String())
+	}
+
+	// Assigning a reviewer is recorded without a change in status
+	if from == to {
+		fmt.Fprint(&sb, "assigned this request to a new reviewer")
+		return template.HTML(sb.String())
+	}
+
+	fromtext, ok := StatusTexts[from]
+	if !ok {
+		fromtext = from
+	}
+	totext, ok := StatusTexts[to]
+	if !ok {
+		totext = to
+	}
+	fmt.Fprintf(&sb, "moved this request from %s to <span class=\"font-semibold\">%s</span>", fromtext, totext)
+	return template.HTML(sb.String())
+}
@@ -0,0 +1,49 @@
+package request
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/format"
+)
+
+func TestHistoryTextCreated(t *testing.T) {
+	text := HistoryText("testify", "", StatusIncomplete)
+	require.Contains(t, string(text), "testify")
+	require.Contains(t, string(text), "created this request")
+}
+
+func TestHistoryTextTransition(t *testing.T) {
+	text := HistoryText("testify", StatusSubmitted, StatusInReview)
+	require.Contains(t, string(text), "from Submitted to")
+	require.Contains(t, string(text), StatusTexts[StatusInReview])
+}
+
+func TestHistoryTextAssigned(t *testing.T) {
+	text := HistoryText("testify", StatusInReview, StatusInReview)
+	require.Contains(t, string(text), "assigned this request")
+}
+
+func TestHistoryTextEscapesActor(t *testing.T) {
+	text := HistoryText("<script>", StatusSubmitted, StatusInReview)
+	require.NotContains(t, string(text), "<script>")
+}
+
+func TestNewHistoryEntryUnknownActor(t *testing.T) {
+	entry := NewHistoryEntry(query.ListRequestStatusHistoryForRequestRow{
+		RequestStatusHistory: query.RequestStatusHistory{
+			CreatedAt:  time.Date(2024, time.January, 2, 15, 4, 0, 0, time.UTC),
+			FromStatus: StatusInReview,
+			ToStatus:   StatusSubmitted,
+			Note:       "Released after timeout",
+		},
+		Username: sql.NullString{},
+	})
+	require.Contains(t, string(entry.Text), format.UnknownActor)
+	require.Equal(t, "Released after timeout", entry.Note)
+	require.Equal(t, "Jan 2, 2024 at 3:04 PM UTC", entry.Date)
+}
@@ -42,6 +42,14 @@ func New(q *query.Queries, p NewParams) (int64, error) {
 		return 0, err
 	}
 
+	if err := q.CreateRequestStatusHistory(context.Background(), query.CreateRequestStatusHistoryParams{
+		RID:      rid,
+		PID:      p.PID,
+		ToStatus: status.Default,
+	}); err != nil {
+		return 0, err
+	}
+
 	for _, field := range fields.List() {
 		if err := q.CreateRequestField(context.Background(), query.CreateRequestFieldParams{
 			RID:  rid,
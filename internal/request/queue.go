@@ -0,0 +1,125 @@
+package request
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strconv"
+	"time"
+
+	"petrichormud.com/app/internal/query"
+)
+
+const QueuePageSize int = 25
+
+// QueueStatuses are the statuses a request can be in while it's waiting on a reviewer
+var QueueStatuses []string = []string{
+	StatusSubmitted,
+	StatusInReview,
+}
+
+// QueueStatusesFor narrows the queue to a single status, or the whole queue for anything else
+func QueueStatusesFor(status string) []string {
+	for _, s := range QueueStatuses {
+		if s == status {
+			return []string{status}
+		}
+	}
+	return QueueStatuses
+}
+
+type ListQueueParams struct {
+	Now      time.Time
+	Type     string
+	Status   string
+	Reviewer sql.NullInt64
+	Author   sql.NullInt64
+	MinAge   time.Duration
+	Page     int
+	PID      int64
+}
+
+// ListQueue yields one page of a request type's queue, oldest submission first, and whether there's a page after it.
+// The viewer's own requests are never included.
+func ListQueue(q *query.Queries, p ListQueueParams) ([]query.ListRequestQueueRow, bool, error) {
+	page := p.Page
+	if page < 1 {
+		page = 1
+	}
+
+	reqs, err := q.ListRequestQueue(context.Background(), query.ListRequestQueueParams{
+		Type:            p.Type,
+		Viewer:          p.PID,
+		Statuses:        QueueStatusesFor(p.Status),
+		RPID:            p.Reviewer,
+		Author:          p.Author,
+		SubmittedBefore: p.Now.Add(-p.MinAge),
+		// Ask for one extra request to find out if there's another page
+		Limit:  int32(QueuePageSize + 1),
+		Offset: int32((page - 1) * QueuePageSize),
+	})
+	if err != nil {
+		return []query.ListRequestQueueRow{}, false, err
+	}
+
+	if len(reqs) > QueuePageSize {
+		return reqs[:QueuePageSize], true, nil
+	}
+	return reqs, false, nil
+}
+
+const (
+	QueueFilterAny       = ""
+	QueueFilterMe        = "me"
+	QueueFilterUnclaimed = "unclaimed"
+)
+
+// QueueFilter is how a reviewer narrows down a queue, as read from the page's query string
+type QueueFilter struct {
+	Status   string
+	Reviewer string
+	Author   string
+	Age      int
+	Page     int
+}
+
+// IsAuthorValid reports whether the author filter can match anything. The viewer's own requests are
+// never in their queue and every request has an author, so "me" and "unclaimed" only apply to reviewers.
+func (f *QueueFilter) IsAuthorValid() bool {
+	return f.Author != QueueFilterMe && f.Author != QueueFilterUnclaimed
+}
+
+// PagePath builds a link to another page of the queue with the same filter
+func (f *QueueFilter) PagePath(base string, page int) string {
+	values := url.Values{}
+	if len(f.Status) > 0 {
+		values.Set("status", f.Status)
+	}
+	if len(f.Reviewer) > 0 {
+		values.Set("reviewer", f.Reviewer)
+	}
+	if len(f.Author) > 0 {
+		values.Set("author", f.Author)
+	}
+	if f.Age > 0 {
+		values.Set("age", strconv.Itoa(f.Age))
+	}
+	values.Set("page", strconv.Itoa(page))
+	return base + "?" + values.Encode()
+}
+
+type QueueStatusOption struct {
+	Value string
+	Text  string
+}
+
+func QueueStatusOptions() []QueueStatusOption {
+	options := []QueueStatusOption{}
+	for _, status := range QueueStatuses {
+		options = append(options, QueueStatusOption{
+			Value: status,
+			Text:  StatusTexts[status],
+		})
+	}
+	return options
+}
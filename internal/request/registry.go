@@ -0,0 +1,128 @@
+package request
+
+import (
+	"context"
+	"errors"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/definition"
+	"petrichormud.com/app/internal/request/dialog"
+	"petrichormud.com/app/internal/request/field"
+	"petrichormud.com/app/internal/request/status"
+	"petrichormud.com/app/internal/route"
+)
+
+// Definition bundles everything a request type needs to be created, reviewed and fulfilled
+type Definition struct {
+	Dialogs   *dialog.DefinitionGroup
+	Machine   *status.Machine
+	Titler    Titler
+	Fulfiller Fulfiller
+	// Hook is optional, and runs whenever a request of this type changes status
+	Hook   StatusHook
+	Fields field.Group
+	Type   string
+	// ReviewPermission is the permission a player needs to review requests of this type
+	ReviewPermission string
+	// ListPath is the page where an author finds their own requests of this type
+	ListPath string
+}
+
+var (
+	ErrTypeRegistered       error = errors.New("a request type with that name is already registered")
+	ErrIncompleteDefinition error = errors.New("request definitions need a type, dialogs, machine, titler, fulfiller, review permission and list path")
+)
+
+var ReviewPermissionsByType map[string]string = map[string]string{}
+
+var ListPathsByType map[string]string = map[string]string{}
+
+// StatusHook lets a request type react to status changes, like reserving a resource on submit.
+// It runs inside the same transaction as the status change; returning an error aborts the change.
+type StatusHook interface {
+	OnStatus(ctx context.Context, q *query.Queries, req *query.Request, to string) error
+}
+
+var StatusHooksByType map[string]StatusHook = map[string]StatusHook{}
+
+func Register(d Definition) error {
+	if len(d.Type) == 0 || d.Dialogs == nil || d.Machine == nil || d.Titler == nil || d.Fulfiller == nil || len(d.ReviewPermission) == 0 || len(d.ListPath) == 0 {
+		return ErrIncompleteDefinition
+	}
+
+	if IsTypeValid(d.Type) {
+		return ErrTypeRegistered
+	}
+
+	Types = append(Types, d.Type)
+	FieldsByType[d.Type] = d.Fields
+	DialogsByType[d.Type] = d.Dialogs
+	TitlersByType[d.Type] = d.Titler
+	FulfillersByType[d.Type] = d.Fulfiller
+	MachinesByType[d.Type] = d.Machine
+	ReviewPermissionsByType[d.Type] = d.ReviewPermission
+	ListPathsByType[d.Type] = d.ListPath
+	if d.Hook != nil {
+		StatusHooksByType[d.Type] = d.Hook
+	}
+
+	return nil
+}
+
+func MustRegister(d Definition) {
+	if err := Register(d); err != nil {
+		panic(err)
+	}
+}
+
+func init() {
+	MustRegister(Definition{
+		Type:             TypeCharacterApplication,
+		Fields:           definition.FieldsCharacterApplication,
+		Dialogs:          &definition.DialogsCharacterApplication,
+		Titler:           &definition.TitlerCharacterApplication,
+		Fulfiller:        &definition.FulfillerCharacterApplication,
+		Machine:          &definition.MachineCharacterApplication,
+		Hook:             &definition.HookCharacterApplication,
+		ReviewPermission: player.PermissionReviewCharacterApplications.Name,
+		ListPath:         route.Characters,
+	})
+	MustRegister(Definition{
+		Type:             TypeRoomProposal,
+		Fields:           definition.FieldsRoomProposal,
+		Dialogs:          &definition.DialogsRoomProposal,
+		Titler:           &definition.TitlerRoomProposal,
+		Fulfiller:        &definition.FulfillerRoomProposal,
+		Machine:          &definition.MachineRoomProposal,
+		ReviewPermission: player.PermissionReviewRoomProposals.Name,
+		ListPath:         route.RoomProposals,
+	})
+}
+
+// ListPath is where an author's requests of a type are listed, or home for a type that isn't registered
+func ListPath(t string) string {
+	path, ok := ListPathsByType[t]
+	if !ok {
+		return route.Home
+	}
+	return path
+}
+
+// CanReview reports whether a set of permissions allows reviewing requests of a type
+func CanReview(perms *player.Permissions, t string) bool {
+	permission, ok := ReviewPermissionsByType[t]
+	if !ok {
+		return false
+	}
+	return perms.HasPermission(permission)
+}
+
+// ReviewPermissions lists every permission that allows reviewing some request type
+func ReviewPermissions() []string {
+	permissions := []string{}
+	for _, t := range Types {
+		permissions = append(permissions, ReviewPermissionsByType[t])
+	}
+	return permissions
+}
@@ -0,0 +1,48 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/definition"
+	"petrichormud.com/app/internal/route"
+)
+
+func TestRegisterDuplicateType(t *testing.T) {
+	err := Register(Definition{
+		Type:             TypeRoomProposal,
+		Fields:           definition.FieldsRoomProposal,
+		Dialogs:          &definition.DialogsRoomProposal,
+		Titler:           &definition.TitlerRoomProposal,
+		Fulfiller:        &definition.FulfillerRoomProposal,
+		Machine:          &definition.MachineRoomProposal,
+		ReviewPermission: player.PermissionReviewRoomProposals.Name,
+		ListPath:         route.RoomProposals,
+	})
+	require.Equal(t, ErrTypeRegistered, err)
+}
+
+func TestRegisterIncompleteDefinition(t *testing.T) {
+	err := Register(Definition{
+		Type: "Incomplete",
+	})
+	require.Equal(t, ErrIncompleteDefinition, err)
+	require.False(t, IsTypeValid("Incomplete"))
+}
+
+func TestListPath(t *testing.T) {
+	require.Equal(t, route.Characters, ListPath(TypeCharacterApplication))
+	require.Equal(t, route.RoomProposals, ListPath(TypeRoomProposal))
+	require.Equal(t, route.Home, ListPath("Unregistered"))
+}
+
+func TestCanReview(t *testing.T) {
+	perms := player.NewPermissions(1, []query.PlayerPermission{
+		{PID: 1, Name: player.PermissionReviewRoomProposals.Name},
+	})
+	require.True(t, CanReview(&perms, TypeRoomProposal))
+	require.False(t, CanReview(&perms, TypeCharacterApplication))
+}
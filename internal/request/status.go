@@ -9,6 +9,7 @@ import (
 
 	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/change"
 	"petrichormud.com/app/internal/request/field"
 	"petrichormud.com/app/internal/request/status"
 )
@@ -138,82 +139,53 @@ func MakeDefaultStatusIcon(iconsize int, textsize string, includeText bool) Stat
 	return result
 }
 
-// TODO: Split these out into better errors
-var ErrNextStatusForbidden error = errors.New("that status update is forbidden")
+var MachinesByType map[string]*status.Machine = map[string]*status.Machine{}
 
-type NextStatusParams struct {
-	Query   *query.Queries
-	Request *query.Request
-	PID     int64
-}
-
-func NextStatus(p NextStatusParams) (string, error) {
-	switch p.Request.Status {
-	case StatusReady:
-		if p.Request.PID != p.PID {
-			return "", ErrNextStatusForbidden
-		}
-
-		return StatusSubmitted, nil
-	case StatusSubmitted:
-		if p.Request.PID == p.PID {
-			return "", ErrNextStatusForbidden
-		}
-
-		return StatusInReview, nil
-	case StatusInReview:
-		if p.Request.PID == p.PID {
-			return "", ErrNextStatusForbidden
-		}
-
-		// TODO: Do this by the fieldmap instead of counting the change requests
-		count, err := p.Query.CountOpenRequestChangeRequestsForRequest(context.Background(), p.Request.ID)
-		if err != nil {
-			return "", err
-		}
-
-		if count > 0 {
-			return StatusReviewed, nil
-		} else {
-			return StatusApproved, nil
-		}
-	case StatusReviewed:
-		if p.Request.PID != p.PID {
-			return "", ErrNextStatusForbidden
-		}
+func Machine(t string) (*status.Machine, error) {
+	if !IsTypeValid(t) {
+		return nil, ErrInvalidType
+	}
 
-		return StatusSubmitted, nil
-	case StatusApproved:
-		fulfilledby, err := FulfilledBy(p.Request.Type)
-		if err != nil {
-			return "", err
-		}
+	machine, ok := MachinesByType[t]
+	if !ok {
+		return nil, ErrNoDefinition
+	}
 
-		if fulfilledby == "Player" && p.Request.PID == p.PID {
-			return StatusFulfilled, nil
-		}
+	return machine, nil
+}
 
-		if fulfilledby == "Reviewer" && p.Request.RPID == p.PID {
-			return StatusFulfilled, nil
-		}
+var (
+	ErrNextStatusForbidden error = status.ErrForbidden
+	ErrInvalidTransition   error = status.ErrInvalidTransition
+)
 
-		// TODO: Figure out resolving an approved request
-		return "", ErrNextStatusForbidden
-	case StatusRejected:
-		if p.Request.PID != p.PID {
-			return "", ErrNextStatusForbidden
-		}
+type NextStatusParams struct {
+	Query       *query.Queries
+	Request     *query.Request
+	Permissions *player.Permissions
+	PID         int64
+}
 
-		return StatusArchived, nil
-	default:
-		return "", ErrNextStatusForbidden
+func NextStatus(p NextStatusParams) (string, error) {
+	machine, err := Machine(p.Request.Type)
+	if err != nil {
+		return "", err
 	}
+
+	return machine.Next(status.Params{
+		Query:       p.Query,
+		Request:     p.Request,
+		Permissions: p.Permissions,
+		PID:         p.PID,
+	})
 }
 
 type UpdateStatusParams struct {
-	Request *query.Request
-	Status  string
-	PID     int64
+	Request     *query.Request
+	Permissions *player.Permissions
+	Status      string
+	Note        string
+	PID         int64
 }
 
 var (
@@ -221,18 +193,35 @@ var (
 	ErrInvalidReviewerID error = errors.New("invalid reviewer ID")
 )
 
-// TODO: Invalidate an invalid status? i.e., make this a state machine?
 func UpdateStatus(q *query.Queries, p UpdateStatusParams) error {
+	return updateStatus(context.Background(), q, p)
+}
+
+func updateStatus(ctx context.Context, q *query.Queries, p UpdateStatusParams) error {
 	if !IsStatusValid(p.Status) {
 		return ErrInvalidStatus
 	}
 
+	machine, err := Machine(p.Request.Type)
+	if err != nil {
+		return err
+	}
+
+	if err := machine.Check(statusParams(q, p.Request, p.Permissions, p.PID), p.Status); err != nil {
+		return err
+	}
+
+	return applyStatus(ctx, q, p)
+}
+
+// applyStatus writes a status change that's already been checked against the request's machine
+func applyStatus(ctx context.Context, q *query.Queries, p UpdateStatusParams) error {
 	if p.Status == StatusInReview {
 		if p.PID == 0 {
 			return ErrInvalidReviewerID
 		}
 
-		if err := q.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+		if err := q.UpdateRequestReviewer(ctx, query.UpdateRequestReviewerParams{
 			ID:   p.Request.ID,
 			RPID: p.PID,
 		}); err != nil {
@@ -240,32 +229,63 @@ func UpdateStatus(q *query.Queries, p UpdateStatusParams) error {
 		}
 	}
 
-	if p.Status == StatusFulfilled {
-		// TODO: Validate that the correct person is attempting to fulfill this?
-		fulfilledby, err := FulfilledBy(p.Request.Type)
-		if err != nil {
+	if releasesReviewer(p.Status) && p.Request.RPID != 0 {
+		if err := q.UpdateRequestReviewer(ctx, query.UpdateRequestReviewerParams{
+			ID:   p.Request.ID,
+			RPID: 0,
+		}); err != nil {
 			return err
 		}
-		// TODO: Use a constant here instead
-		if fulfilledby == "Player" && p.PID != p.Request.PID {
-			return ErrNextStatusForbidden
-		}
-		// TODO: Use a constant here instead
-		if fulfilledby == "Reviewer" && p.PID != p.Request.RPID {
-			return ErrNextStatusForbidden
+	}
+
+	// Resubmitting answers the last round of change requests
+	if p.Request.Status == StatusReviewed && p.Status == StatusSubmitted {
+		if err := change.Archive(ctx, q, p.Request.ID); err != nil {
+			return err
 		}
 	}
 
-	if err := q.UpdateRequestStatus(context.Background(), query.UpdateRequestStatusParams{
+	if err := q.UpdateRequestStatus(ctx, query.UpdateRequestStatusParams{
 		ID:     p.Request.ID,
 		Status: p.Status,
 	}); err != nil {
 		return err
 	}
 
+	if err := q.CreateRequestStatusHistory(ctx, query.CreateRequestStatusHistoryParams{
+		RID:        p.Request.ID,
+		PID:        p.PID,
+		FromStatus: p.Request.Status,
+		ToStatus:   p.Status,
+		Note:       p.Note,
+	}); err != nil {
+		return err
+	}
+
+	if hook, ok := StatusHooksByType[p.Request.Type]; ok {
+		if err := hook.OnStatus(ctx, q, p.Request, p.Status); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// releasesReviewer reports whether moving to a status gives up the request's reviewer.
+// Any trip back to Submitted does, including a resubmit, so the request goes back into the open queue.
+func releasesReviewer(to string) bool {
+	return to == StatusCanceled || to == StatusSubmitted
+}
+
+func statusParams(q *query.Queries, req *query.Request, perms *player.Permissions, pid int64) status.Params {
+	return status.Params{
+		Query:       q,
+		Request:     req,
+		Permissions: perms,
+		PID:         pid,
+	}
+}
+
 type CanBePutInReviewParams struct {
 	Request     *query.Request
 	Permissions *player.Permissions
@@ -273,16 +293,20 @@ type CanBePutInReviewParams struct {
 }
 
 func CanBePutInReview(p CanBePutInReviewParams) bool {
-	if p.PID == p.Request.PID {
-		return false
-	}
-	if p.Request.Status != StatusSubmitted {
+	machine, err := Machine(p.Request.Type)
+	if err != nil {
 		return false
 	}
-	if !p.Permissions.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+
+	ok, err := machine.Can(status.Params{
+		Request:     p.Request,
+		Permissions: p.Permissions,
+		PID:         p.PID,
+	}, StatusInReview)
+	if err != nil {
 		return false
 	}
-	return true
+	return ok
 }
 
 type ReviewerTextParams struct {
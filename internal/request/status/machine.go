@@ -0,0 +1,216 @@
+package status
+
+import (
+	"context"
+	"errors"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+// Actors describe who is allowed to take a transition
+const (
+	// The player that authored the request
+	ActorAuthor = "Author"
+	// The player currently assigned to review the request
+	ActorReviewer = "Reviewer"
+	// Any player other than the author holding the transition's Permission
+	ActorStaff = "Staff"
+)
+
+var (
+	ErrForbidden         error = errors.New("that status update is forbidden")
+	ErrInvalidTransition error = errors.New("invalid status transition")
+	ErrNoQuery           error = errors.New("this guard requires a query")
+)
+
+type Params struct {
+	Query       *query.Queries
+	Request     *query.Request
+	Permissions *player.Permissions
+	PID         int64
+}
+
+type Guard interface {
+	Allow(p Params) (bool, error)
+}
+
+type Transition struct {
+	Guards     []Guard
+	From       string
+	To         string
+	Actor      string
+	Permission string
+	// Advance marks this edge as a candidate for moving the request to its next status
+	Advance bool
+}
+
+func (t *Transition) IsActor(p Params) bool {
+	if len(t.Permission) > 0 {
+		if p.Permissions == nil || !p.Permissions.HasPermission(t.Permission) {
+			return false
+		}
+	}
+
+	switch t.Actor {
+	case ActorAuthor:
+		return p.PID == p.Request.PID
+	case ActorReviewer:
+		return p.PID != p.Request.PID && p.PID == p.Request.RPID
+	case ActorStaff:
+		return p.PID != p.Request.PID && len(t.Permission) > 0
+	default:
+		return false
+	}
+}
+
+func (t *Transition) Allow(p Params) (bool, error) {
+	if !t.IsActor(p) {
+		return false, nil
+	}
+
+	for _, guard := range t.Guards {
+		ok, err := guard.Allow(p)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+type Machine struct {
+	transitions []Transition
+}
+
+func NewMachine(transitions []Transition) Machine {
+	return Machine{
+		transitions: transitions,
+	}
+}
+
+func (m *Machine) From(from string) []Transition {
+	transitions := []Transition{}
+	for _, t := range m.transitions {
+		if t.From == from {
+			transitions = append(transitions, t)
+		}
+	}
+	return transitions
+}
+
+func (m *Machine) Get(from, to string) (Transition, bool) {
+	for _, t := range m.transitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// Can reports whether the player described by the params may move the request to a status
+func (m *Machine) Can(p Params, to string) (bool, error) {
+	t, ok := m.Get(p.Request.Status, to)
+	if !ok {
+		return false, nil
+	}
+	return t.Allow(p)
+}
+
+// Check is like Can, but reports why a transition isn't allowed
+func (m *Machine) Check(p Params, to string) error {
+	t, ok := m.Get(p.Request.Status, to)
+	if !ok {
+		return ErrInvalidTransition
+	}
+	ok, err := t.Allow(p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// Next yields the first status the player can advance the request to
+func (m *Machine) Next(p Params) (string, error) {
+	for _, t := range m.From(p.Request.Status) {
+		if !t.Advance {
+			continue
+		}
+		ok, err := t.Allow(p)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return t.To, nil
+		}
+	}
+	return "", ErrForbidden
+}
+
+type guardOpenChangeRequests struct {
+	want bool
+}
+
+func (g *guardOpenChangeRequests) Allow(p Params) (bool, error) {
+	if p.Query == nil {
+		return false, ErrNoQuery
+	}
+	count, err := p.Query.CountOpenRequestChangeRequestsForRequest(context.Background(), p.Request.ID)
+	if err != nil {
+		return false, err
+	}
+	return (count > 0) == g.want, nil
+}
+
+var (
+	GuardOpenChangeRequests   guardOpenChangeRequests = guardOpenChangeRequests{want: true}
+	GuardNoOpenChangeRequests guardOpenChangeRequests = guardOpenChangeRequests{want: false}
+)
+
+type guardClaim struct{}
+
+// Allow lets a player act on a request nobody has claimed, or one they've claimed themselves
+func (g *guardClaim) Allow(p Params) (bool, error) {
+	return p.Request.RPID == 0 || p.Request.RPID == p.PID, nil
+}
+
+var GuardUnclaimedOrClaimant guardClaim = guardClaim{}
+
+// ReviewTransitions builds the edges shared by every reviewed request type,
+// from the first draft through a review's outcome
+func ReviewTransitions(permission string) []Transition {
+	return []Transition{
+		{From: Incomplete, To: Ready, Actor: ActorAuthor},
+		{From: Ready, To: Incomplete, Actor: ActorAuthor},
+		{From: Ready, To: Submitted, Actor: ActorAuthor, Advance: true},
+		{From: Submitted, To: InReview, Actor: ActorStaff, Permission: permission, Advance: true, Guards: []Guard{&GuardUnclaimedOrClaimant}},
+		{From: Submitted, To: Rejected, Actor: ActorStaff, Permission: permission, Guards: []Guard{&GuardUnclaimedOrClaimant}},
+		{From: InReview, To: Submitted, Actor: ActorReviewer},
+		{From: InReview, To: Reviewed, Actor: ActorReviewer, Advance: true, Guards: []Guard{&GuardOpenChangeRequests}},
+		{From: InReview, To: Approved, Actor: ActorReviewer, Advance: true, Guards: []Guard{&GuardNoOpenChangeRequests}},
+		{From: InReview, To: Rejected, Actor: ActorReviewer},
+		{From: Reviewed, To: Submitted, Actor: ActorAuthor, Advance: true},
+		{From: Rejected, To: Archived, Actor: ActorAuthor, Advance: true},
+	}
+}
+
+// FulfillTransition builds the edge from Approved to Fulfilled for the given actor
+func FulfillTransition(actor string) Transition {
+	return Transition{From: Approved, To: Fulfilled, Actor: actor, Advance: true}
+}
+
+// CancelTransitions builds the edges that let an author withdraw a request before it's decided
+func CancelTransitions() []Transition {
+	return []Transition{
+		{From: Incomplete, To: Canceled, Actor: ActorAuthor},
+		{From: Ready, To: Canceled, Actor: ActorAuthor},
+		{From: Submitted, To: Canceled, Actor: ActorAuthor},
+		{From: Reviewed, To: Canceled, Actor: ActorAuthor},
+	}
+}
@@ -0,0 +1,208 @@
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+const (
+	testAuthorPID   int64 = 1
+	testReviewerPID int64 = 2
+	testStaffPID    int64 = 3
+)
+
+type guardTest struct {
+	allow bool
+}
+
+func (g *guardTest) Allow(_ Params) (bool, error) {
+	return g.allow, nil
+}
+
+func newTestMachine() Machine {
+	return NewMachine(append(
+		ReviewTransitions(player.PermissionReviewCharacterApplications.Name),
+		FulfillTransition(ActorAuthor),
+	))
+}
+
+func newTestPermissions(pid int64, names ...string) *player.Permissions {
+	records := []query.PlayerPermission{}
+	for _, name := range names {
+		records = append(records, query.PlayerPermission{PID: pid, Name: name})
+	}
+	perms := player.NewPermissions(pid, records)
+	return &perms
+}
+
+func TestNextAuthorSubmitsReadyRequest(t *testing.T) {
+	m := newTestMachine()
+	next, err := m.Next(Params{
+		Request: &query.Request{PID: testAuthorPID, Status: Ready},
+		PID:     testAuthorPID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, Submitted, next)
+}
+
+func TestNextForbiddenForNonAuthorOnReadyRequest(t *testing.T) {
+	m := newTestMachine()
+	_, err := m.Next(Params{
+		Request: &query.Request{PID: testAuthorPID, Status: Ready},
+		PID:     testStaffPID,
+	})
+	require.Equal(t, ErrForbidden, err)
+}
+
+func TestNextStaffPutsSubmittedRequestInReview(t *testing.T) {
+	m := newTestMachine()
+	next, err := m.Next(Params{
+		Request:     &query.Request{PID: testAuthorPID, Status: Submitted},
+		Permissions: newTestPermissions(testStaffPID, player.PermissionReviewCharacterApplications.Name),
+		PID:         testStaffPID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, InReview, next)
+}
+
+func TestNextForbiddenForStaffWithoutPermission(t *testing.T) {
+	m := newTestMachine()
+	_, err := m.Next(Params{
+		Request:     &query.Request{PID: testAuthorPID, Status: Submitted},
+		Permissions: newTestPermissions(testStaffPID),
+		PID:         testStaffPID,
+	})
+	require.Equal(t, ErrForbidden, err)
+}
+
+func TestNextForbiddenForAuthorWithPermission(t *testing.T) {
+	m := newTestMachine()
+	_, err := m.Next(Params{
+		Request:     &query.Request{PID: testAuthorPID, Status: Submitted},
+		Permissions: newTestPermissions(testAuthorPID, player.PermissionReviewCharacterApplications.Name),
+		PID:         testAuthorPID,
+	})
+	require.Equal(t, ErrForbidden, err)
+}
+
+func TestNextSkipsTransitionsWithFailingGuards(t *testing.T) {
+	m := NewMachine([]Transition{
+		{From: InReview, To: Reviewed, Actor: ActorReviewer, Advance: true, Guards: []Guard{&guardTest{allow: false}}},
+		{From: InReview, To: Approved, Actor: ActorReviewer, Advance: true, Guards: []Guard{&guardTest{allow: true}}},
+	})
+	next, err := m.Next(Params{
+		Request: &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: InReview},
+		PID:     testReviewerPID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, Approved, next)
+}
+
+func TestNextIgnoresTransitionsThatDoNotAdvance(t *testing.T) {
+	m := newTestMachine()
+	_, err := m.Next(Params{
+		Request: &query.Request{PID: testAuthorPID, Status: Incomplete},
+		PID:     testAuthorPID,
+	})
+	require.Equal(t, ErrForbidden, err)
+}
+
+func TestCheckInvalidTransition(t *testing.T) {
+	m := newTestMachine()
+	err := m.Check(Params{
+		Request: &query.Request{PID: testAuthorPID, Status: Incomplete},
+		PID:     testAuthorPID,
+	}, Approved)
+	require.Equal(t, ErrInvalidTransition, err)
+}
+
+func TestCheckForbiddenForWrongActor(t *testing.T) {
+	m := newTestMachine()
+	err := m.Check(Params{
+		Request: &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: InReview},
+		PID:     testAuthorPID,
+	}, Rejected)
+	require.Equal(t, ErrForbidden, err)
+}
+
+func TestCheckReviewerRejects(t *testing.T) {
+	m := newTestMachine()
+	err := m.Check(Params{
+		Request: &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: InReview},
+		PID:     testReviewerPID,
+	}, Rejected)
+	require.NoError(t, err)
+}
+
+func TestCheckGuardWithoutQuery(t *testing.T) {
+	m := newTestMachine()
+	err := m.Check(Params{
+		Request: &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: InReview},
+		PID:     testReviewerPID,
+	}, Approved)
+	require.Equal(t, ErrNoQuery, err)
+}
+
+func TestCanAuthorFulfillsApprovedRequest(t *testing.T) {
+	m := newTestMachine()
+	ok, err := m.Can(Params{
+		Request: &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: Approved},
+		PID:     testAuthorPID,
+	}, Fulfilled)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = m.Can(Params{
+		Request: &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: Approved},
+		PID:     testReviewerPID,
+	}, Fulfilled)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestCheckAuthorCancels(t *testing.T) {
+	m := NewMachine(CancelTransitions())
+	for _, from := range []string{Incomplete, Ready, Submitted, Reviewed} {
+		err := m.Check(Params{
+			Request: &query.Request{PID: testAuthorPID, Status: from},
+			PID:     testAuthorPID,
+		}, Canceled)
+		require.NoError(t, err)
+	}
+}
+
+func TestCheckCancelInvalidAfterReview(t *testing.T) {
+	m := NewMachine(CancelTransitions())
+	for _, from := range []string{InReview, Approved, Rejected, Fulfilled, Archived, Canceled} {
+		err := m.Check(Params{
+			Request: &query.Request{PID: testAuthorPID, Status: from},
+			PID:     testAuthorPID,
+		}, Canceled)
+		require.Equal(t, ErrInvalidTransition, err)
+	}
+}
+
+func TestNextForbiddenForStaffWhenClaimedByAnother(t *testing.T) {
+	m := newTestMachine()
+	_, err := m.Next(Params{
+		Request:     &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: Submitted},
+		Permissions: newTestPermissions(testStaffPID, player.PermissionReviewCharacterApplications.Name),
+		PID:         testStaffPID,
+	})
+	require.Equal(t, ErrForbidden, err)
+}
+
+func TestNextClaimantPutsSubmittedRequestInReview(t *testing.T) {
+	m := newTestMachine()
+	next, err := m.Next(Params{
+		Request:     &query.Request{PID: testAuthorPID, RPID: testReviewerPID, Status: Submitted},
+		Permissions: newTestPermissions(testReviewerPID, player.PermissionReviewCharacterApplications.Name),
+		PID:         testReviewerPID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, InReview, next)
+}
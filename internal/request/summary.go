@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"time"
 
 	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/query"
@@ -20,10 +21,16 @@ type SummaryForQueue struct {
 	Link            string
 	AuthorUsername  string
 	ReviewerText    template.HTML
+	ClaimPath       string
+	ReviewerPath    string
+	SubmittedAt     string
 	StatusIcon      StatusIcon
 	ID              int64
 	PID             int64
 	ShowPutInReview bool
+	ShowClaim       bool
+	ShowUnclaim     bool
+	ShowAssign      bool
 }
 
 type NewSummaryForQueueParams struct {
@@ -33,16 +40,18 @@ type NewSummaryForQueueParams struct {
 	ReviewerPermissions *player.Permissions
 	PlayerUsername      string
 	ReviewerUsername    string
-	PID                 int64
+	// SubmittedAt is when the request last went into the queue; it falls back to when it was created
+	SubmittedAt time.Time
+	PID         int64
 }
 
 func NewSummaryForQueue(p NewSummaryForQueueParams) (SummaryForQueue, error) {
 	// TODO: Move this into locals instead of updating the params
-	player, err := p.Query.GetPlayer(context.Background(), p.Request.PID)
+	author, err := p.Query.GetPlayer(context.Background(), p.Request.PID)
 	if err != nil {
 		return SummaryForQueue{}, err
 	}
-	p.PlayerUsername = player.Username
+	p.PlayerUsername = author.Username
 	if p.Request.RPID != 0 {
 		reviewer, err := p.Query.GetPlayer(context.Background(), p.Request.RPID)
 		if err != nil {
@@ -76,6 +85,16 @@ func NewSummaryForQueue(p NewSummaryForQueueParams) (SummaryForQueue, error) {
 		},
 	)
 
+	submittedAt := p.SubmittedAt
+	if submittedAt.IsZero() {
+		submittedAt = p.Request.CreatedAt
+	}
+
+	showClaim := p.Request.Status == StatusSubmitted &&
+		p.Request.RPID == 0 &&
+		p.Request.PID != p.PID &&
+		CanReview(p.ReviewerPermissions, p.Request.Type)
+
 	// TODO: Make this resilient to a request with an invalid status
 	return SummaryForQueue{
 		ID:              p.Request.ID,
@@ -88,6 +107,47 @@ func NewSummaryForQueue(p NewSummaryForQueueParams) (SummaryForQueue, error) {
 		ReviewerText:    reviewerText,
 		Dialogs:         dialogs,
 		AuthorUsername:  p.PlayerUsername,
+		ClaimPath:       route.RequestClaimPath(p.Request.ID),
+		ReviewerPath:    route.RequestReviewerPath(p.Request.ID),
+		SubmittedAt:     submittedAt.Format(QueueDateLayout),
 		ShowPutInReview: showPutInReview,
+		ShowClaim:       showClaim,
+		ShowUnclaim:     IsClaimable(p.Request) && p.Request.RPID == p.PID,
+		ShowAssign:      IsClaimable(p.Request) && p.ReviewerPermissions.HasPermission(player.PermissionAssignRequestReviewers.Name),
+	}, nil
+}
+
+// TODO: Get this into a shared layout with the history dates
+const (
+	DeletedSummaryDateLayout = "Jan 2, 2006"
+	QueueDateLayout          = "Jan 2, 2006"
+)
+
+type DeletedSummary struct {
+	Title           string
+	RestorePath     string
+	RestorableUntil string
+	StatusIcon      StatusIcon
+	ID              int64
+}
+
+type NewDeletedSummaryParams struct {
+	FieldMap field.Map
+	Request  *query.Request
+	Deletion *query.RequestDeletion
+}
+
+func NewDeletedSummary(p NewDeletedSummaryParams) (DeletedSummary, error) {
+	title, err := Title(p.Request.Type, p.FieldMap)
+	if err != nil {
+		return DeletedSummary{}, err
+	}
+
+	return DeletedSummary{
+		ID:              p.Request.ID,
+		Title:           title,
+		RestorePath:     route.RequestRestorePath(p.Request.ID),
+		RestorableUntil: p.Deletion.CreatedAt.Add(DeletionGracePeriod).UTC().Format(DeletedSummaryDateLayout),
+		StatusIcon:      NewStatusIcon(StatusIconParams{Status: p.Request.Status, IconSize: 48, IncludeText: false}),
 	}, nil
 }
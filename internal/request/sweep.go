@@ -0,0 +1,105 @@
+package request
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"petrichormud.com/app/internal/query"
+)
+
+const StaleReviewNote = "Released back to the queue after the review timed out"
+
+type SweepStaleReviewsParams struct {
+	Timeout time.Duration
+	Now     time.Time
+}
+
+// SweepStaleReviews moves requests that have been in review longer than the timeout back to
+// Submitted and clears their reviewer. It returns how many requests were released.
+func SweepStaleReviews(ctx context.Context, db *sql.DB, q *query.Queries, p SweepStaleReviewsParams) (int, error) {
+	stale, err := q.ListStaleInReviewRequests(ctx, p.Now.Add(-p.Timeout))
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, req := range stale {
+		ok, err := releaseStaleReview(ctx, db, q, &req)
+		if err != nil {
+			return released, err
+		}
+		if ok {
+			released++
+		}
+	}
+
+	return released, nil
+}
+
+func releaseStaleReview(ctx context.Context, db *sql.DB, q *query.Queries, stale *query.Request) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	req, err := qtx.GetRequestForUpdate(ctx, stale.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// The reviewer may have acted on the request since the stale list was read
+	if req.Status != StatusInReview || req.RPID != stale.RPID || !req.UpdatedAt.Equal(stale.UpdatedAt) {
+		return false, nil
+	}
+
+	// There's no actor here to check against the machine; the release is the system's
+	if err := applyStatus(ctx, qtx, UpdateStatusParams{
+		Request: &req,
+		Status:  StatusSubmitted,
+		Note:    StaleReviewNote,
+	}); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+type ReviewSweeperParams struct {
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// RunReviewSweeper sweeps stale reviews on every interval until the context is done
+func RunReviewSweeper(ctx context.Context, db *sql.DB, q *query.Queries, p ReviewSweeperParams) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			released, err := SweepStaleReviews(ctx, db, q, SweepStaleReviewsParams{
+				Timeout: p.Timeout,
+				Now:     now,
+			})
+			if err != nil {
+				log.Printf("review sweeper: %v", err)
+			}
+			if released > 0 {
+				log.Printf("review sweeper: released %d stale review(s)", released)
+			}
+		}
+	}
+}
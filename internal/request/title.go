@@ -1,17 +1,12 @@
 package request
 
-import (
-	"petrichormud.com/app/internal/request/definition"
-	"petrichormud.com/app/internal/request/field"
-)
+import "petrichormud.com/app/internal/request/field"
 
 type Titler interface {
 	ForOverview(fields field.Map) string
 }
 
-var TitlersByType map[string]Titler = map[string]Titler{
-	TypeCharacterApplication: &definition.TitlerCharacterApplication,
-}
+var TitlersByType map[string]Titler = map[string]Titler{}
 
 // TODO: Error output?
 func Title(t string, fields field.Map) (string, error) {
@@ -1,12 +1,17 @@
 package request
 
-import "slices"
+import (
+	"slices"
 
-const TypeCharacterApplication string = "CharacterApplication"
+	"petrichormud.com/app/internal/request/definition"
+)
 
-var Types []string = []string{
-	TypeCharacterApplication,
-}
+const (
+	TypeCharacterApplication string = definition.TypeCharacterApplication
+	TypeRoomProposal         string = definition.TypeRoomProposal
+)
+
+var Types []string = []string{}
 
 func IsTypeValid(t string) bool {
 	return slices.Contains(Types, t)
@@ -0,0 +1,197 @@
+package request
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/format"
+	"petrichormud.com/app/internal/request/version"
+)
+
+type CreateSubfieldParams struct {
+	Field *query.RequestField
+	Value string
+	PID   int64
+}
+
+func CreateSubfield(ctx context.Context, q *query.Queries, p CreateSubfieldParams) error {
+	result, err := q.CreateRequestSubfield(ctx, query.CreateRequestSubfieldParams{
+		RFID:  p.Field.ID,
+		Value: p.Value,
+	})
+	if err != nil {
+		return err
+	}
+	rsid, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return q.CreateRequestSubfieldVersion(ctx, query.CreateRequestSubfieldVersionParams{
+		Value: p.Value,
+		RSID:  rsid,
+		RFID:  p.Field.ID,
+		PID:   p.PID,
+	})
+}
+
+type UpdateSubfieldParams struct {
+	Subfield *query.RequestSubfield
+	Value    string
+	PID      int64
+}
+
+func UpdateSubfield(ctx context.Context, q *query.Queries, p UpdateSubfieldParams) error {
+	if err := q.UpdateRequestSubfield(ctx, query.UpdateRequestSubfieldParams{
+		ID:    p.Subfield.ID,
+		Value: p.Value,
+	}); err != nil {
+		return err
+	}
+
+	return q.CreateRequestSubfieldVersion(ctx, query.CreateRequestSubfieldVersionParams{
+		Value: p.Value,
+		RSID:  p.Subfield.ID,
+		RFID:  p.Subfield.RFID,
+		PID:   p.PID,
+	})
+}
+
+type DeleteSubfieldParams struct {
+	Subfield *query.RequestSubfield
+	PID      int64
+}
+
+func DeleteSubfield(ctx context.Context, q *query.Queries, p DeleteSubfieldParams) error {
+	if err := q.DeleteRequestSubfield(ctx, p.Subfield.ID); err != nil {
+		return err
+	}
+
+	return q.CreateRequestSubfieldVersion(ctx, query.CreateRequestSubfieldVersionParams{
+		Deleted: true,
+		RSID:    p.Subfield.ID,
+		RFID:    p.Subfield.RFID,
+		PID:     p.PID,
+	})
+}
+
+type FieldVersion struct {
+	Value  string
+	Author string
+	Date   string
+}
+
+func FieldVersions(ctx context.Context, q *query.Queries, rfid int64) ([]FieldVersion, error) {
+	rows, err := q.ListRequestFieldVersionsForField(ctx, rfid)
+	if err != nil {
+		return []FieldVersion{}, err
+	}
+
+	versions := []FieldVersion{}
+	for _, row := range rows {
+		author := format.UnknownActor
+		if row.Username.Valid {
+			author = row.Username.String
+		}
+		versions = append(versions, FieldVersion{
+			Value:  row.RequestFieldVersion.Value,
+			Author: author,
+			Date:   row.RequestFieldVersion.CreatedAt.UTC().Format(format.DateLayout),
+		})
+	}
+	return versions, nil
+}
+
+// FieldDiff is what a player changed in a field since it was last sent back to them
+type FieldDiff struct {
+	Segments []version.Segment
+	Since    string
+}
+
+type FieldDiffParams struct {
+	Request   *query.Request
+	Field     *query.RequestField
+	Subfields []query.RequestSubfield
+}
+
+// FieldDiffSinceReview diffs a field's value at its last review against its current value.
+// It reports false if the request hasn't been reviewed, or the field has no history from then.
+func FieldDiffSinceReview(ctx context.Context, q *query.Queries, p FieldDiffParams) (FieldDiff, bool, error) {
+	if p.Request.Status != StatusSubmitted && p.Request.Status != StatusInReview {
+		return FieldDiff{}, false, nil
+	}
+
+	review, err := q.GetLatestRequestStatusHistoryTo(ctx, query.GetLatestRequestStatusHistoryToParams{
+		RID:      p.Request.ID,
+		ToStatus: StatusReviewed,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return FieldDiff{}, false, nil
+		}
+		return FieldDiff{}, false, err
+	}
+
+	diff := FieldDiff{
+		Since: review.CreatedAt.UTC().Format(format.DateLayout),
+	}
+
+	if FieldRequiresSubfields(p.Request.Type, p.Field.Type) {
+		versions, err := q.ListRequestSubfieldVersionsBefore(ctx, query.ListRequestSubfieldVersionsBeforeParams{
+			RFID:   p.Field.ID,
+			Before: review.CreatedAt,
+		})
+		if err != nil {
+			return FieldDiff{}, false, err
+		}
+		if len(versions) == 0 {
+			return FieldDiff{}, false, nil
+		}
+
+		current := []string{}
+		for _, subfield := range p.Subfields {
+			current = append(current, subfield.Value)
+		}
+		diff.Segments = version.Items(SubfieldValuesAt(versions), current)
+		return diff, true, nil
+	}
+
+	reviewed, err := q.GetRequestFieldVersionBefore(ctx, query.GetRequestFieldVersionBeforeParams{
+		RFID:   p.Field.ID,
+		Before: review.CreatedAt,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return FieldDiff{}, false, nil
+		}
+		return FieldDiff{}, false, err
+	}
+
+	diff.Segments = version.Words(reviewed.Value, p.Field.Value)
+	return diff, true, nil
+}
+
+// SubfieldValuesAt replays a field's subfield versions into the values it held after the last one
+func SubfieldValuesAt(versions []query.RequestSubfieldVersion) []string {
+	latest := map[int64]query.RequestSubfieldVersion{}
+	for _, v := range versions {
+		latest[v.RSID] = v
+	}
+
+	rsids := []int64{}
+	for rsid := range latest {
+		rsids = append(rsids, rsid)
+	}
+	slices.Sort(rsids)
+
+	values := []string{}
+	for _, rsid := range rsids {
+		if latest[rsid].Deleted {
+			continue
+		}
+		values = append(values, latest[rsid].Value)
+	}
+	return values
+}
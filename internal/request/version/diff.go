@@ -0,0 +1,112 @@
+package version
+
+import (
+	"strings"
+)
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+// MaxDiffCells caps the size of the table used to diff two values. Anything larger
+// is shown as the old value being replaced wholesale by the new one.
+const MaxDiffCells = 1_000_000
+
+type Segment struct {
+	Op   string
+	Text string
+}
+
+// Words diffs two values word by word
+func Words(old, current string) []Segment {
+	segments := diff(strings.Fields(old), strings.Fields(current))
+	return join(segments, " ")
+}
+
+// Items diffs two lists of values, like a field's subfields
+func Items(old, current []string) []Segment {
+	return diff(old, current)
+}
+
+// Changed reports whether a diff has anything other than equal segments in it
+func Changed(segments []Segment) bool {
+	for _, segment := range segments {
+		if segment.Op != OpEqual {
+			return true
+		}
+	}
+	return false
+}
+
+func diff(a, b []string) []Segment {
+	if len(a)*len(b) > MaxDiffCells {
+		return replace(a, b)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	segments := []Segment{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			segments = append(segments, Segment{Op: OpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			segments = append(segments, Segment{Op: OpDelete, Text: a[i]})
+			i++
+		default:
+			segments = append(segments, Segment{Op: OpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		segments = append(segments, Segment{Op: OpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		segments = append(segments, Segment{Op: OpInsert, Text: b[j]})
+	}
+
+	return segments
+}
+
+func replace(a, b []string) []Segment {
+	segments := []Segment{}
+	for _, text := range a {
+		segments = append(segments, Segment{Op: OpDelete, Text: text})
+	}
+	for _, text := range b {
+		segments = append(segments, Segment{Op: OpInsert, Text: text})
+	}
+	return segments
+}
+
+// join merges runs of segments with the same op, so a changed phrase reads as one segment
+func join(segments []Segment, sep string) []Segment {
+	joined := []Segment{}
+	for _, segment := range segments {
+		last := len(joined) - 1
+		if last >= 0 && joined[last].Op == segment.Op {
+			joined[last].Text = joined[last].Text + sep + segment.Text
+			continue
+		}
+		joined = append(joined, segment)
+	}
+	return joined
+}
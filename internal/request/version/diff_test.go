@@ -0,0 +1,50 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordsUnchanged(t *testing.T) {
+	segments := Words("A tall man", "A  tall man")
+	require.False(t, Changed(segments))
+	require.Equal(t, []Segment{{Op: OpEqual, Text: "A tall man"}}, segments)
+}
+
+func TestWordsReplacedPhrase(t *testing.T) {
+	segments := Words("A tall, thin man", "A short, stout man")
+	require.True(t, Changed(segments))
+	require.Equal(t, []Segment{
+		{Op: OpEqual, Text: "A"},
+		{Op: OpDelete, Text: "tall, thin"},
+		{Op: OpInsert, Text: "short, stout"},
+		{Op: OpEqual, Text: "man"},
+	}, segments)
+}
+
+func TestWordsFromEmpty(t *testing.T) {
+	require.Equal(t, []Segment{{Op: OpInsert, Text: "Testify"}}, Words("", "Testify"))
+}
+
+func TestItems(t *testing.T) {
+	segments := Items([]string{"tall", "thin", "bald"}, []string{"tall", "bald", "scarred"})
+	require.Equal(t, []Segment{
+		{Op: OpEqual, Text: "tall"},
+		{Op: OpDelete, Text: "thin"},
+		{Op: OpEqual, Text: "bald"},
+		{Op: OpInsert, Text: "scarred"},
+	}, segments)
+}
+
+func TestDiffTooLargeIsReplaced(t *testing.T) {
+	a := make([]string, 1001)
+	b := make([]string, 1001)
+	for i := range a {
+		a[i] = "a"
+		b[i] = "a"
+	}
+	segments := Items(a, b)
+	require.Len(t, segments, 2002)
+	require.Equal(t, OpDelete, segments[0].Op)
+}
@@ -0,0 +1,24 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+)
+
+func TestSubfieldValuesAt(t *testing.T) {
+	versions := []query.RequestSubfieldVersion{
+		{RSID: 2, Value: "tall"},
+		{RSID: 1, Value: "thin"},
+		{RSID: 3, Value: "bald"},
+		{RSID: 2, Value: "short"},
+		{RSID: 3, Deleted: true},
+	}
+	require.Equal(t, []string{"thin", "short"}, SubfieldValuesAt(versions))
+}
+
+func TestSubfieldValuesAtEmpty(t *testing.T) {
+	require.Equal(t, []string{}, SubfieldValuesAt(nil))
+}
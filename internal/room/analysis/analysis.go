@@ -0,0 +1,241 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/room"
+)
+
+var (
+	ErrRoomNotFound error = errors.New("no room found with that ID")
+	ErrNoPath       error = errors.New("no path between those rooms")
+)
+
+// Graph is every room and every exit between them. Unlike room.BuildGraph, it isn't
+// bounded to the neighborhood around one room.
+type Graph struct {
+	Rooms map[int64]query.Room
+	// Exits holds each room's exits, with the standard directions first
+	Exits map[int64][]query.RoomExit
+	// Entrances counts the exits leading into each room from another one
+	Entrances map[int64]int
+	IDs       []int64
+}
+
+// Step is one move along a path, through an exit of the From room
+type Step struct {
+	Direction string
+	From      int64
+	To        int64
+}
+
+func Load(q *query.Queries) (Graph, error) {
+	rooms, err := q.ListRooms(context.Background())
+	if err != nil {
+		return Graph{}, room.ErrListingRooms
+	}
+	exits, err := q.ListAllRoomExits(context.Background())
+	if err != nil {
+		return Graph{}, room.ErrListingExits
+	}
+	return New(rooms, exits), nil
+}
+
+// New builds a graph from rooms and exits. Exits to rooms that aren't listed are dropped.
+func New(rooms []query.Room, exits []query.RoomExit) Graph {
+	g := Graph{
+		Rooms:     map[int64]query.Room{},
+		Exits:     map[int64][]query.RoomExit{},
+		Entrances: map[int64]int{},
+		IDs:       []int64{},
+	}
+	for _, rm := range rooms {
+		g.Rooms[rm.ID] = rm
+		g.IDs = append(g.IDs, rm.ID)
+	}
+	sort.Slice(g.IDs, func(i, j int) bool {
+		return g.IDs[i] < g.IDs[j]
+	})
+
+	for _, exit := range exits {
+		if _, ok := g.Rooms[exit.RID]; !ok {
+			continue
+		}
+		if _, ok := g.Rooms[exit.ToRID]; !ok {
+			continue
+		}
+		g.Exits[exit.RID] = append(g.Exits[exit.RID], exit)
+		if exit.RID != exit.ToRID {
+			g.Entrances[exit.ToRID]++
+		}
+	}
+	for rid, roomExits := range g.Exits {
+		sorted := []query.RoomExit{}
+		for _, dir := range room.ExitDirections(roomExits) {
+			for _, exit := range roomExits {
+				if exit.Direction == dir {
+					sorted = append(sorted, exit)
+				}
+			}
+		}
+		g.Exits[rid] = sorted
+	}
+
+	return g
+}
+
+func (g *Graph) HasRoom(id int64) bool {
+	_, ok := g.Rooms[id]
+	return ok
+}
+
+// Components groups rooms that are connected by exits in either direction,
+// largest group first. Each group is in order of room ID.
+func (g *Graph) Components() [][]int64 {
+	neighbors := map[int64][]int64{}
+	for rid, roomExits := range g.Exits {
+		for _, exit := range roomExits {
+			neighbors[rid] = append(neighbors[rid], exit.ToRID)
+			neighbors[exit.ToRID] = append(neighbors[exit.ToRID], rid)
+		}
+	}
+
+	seen := map[int64]bool{}
+	components := [][]int64{}
+	for _, id := range g.IDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		component := []int64{}
+		queue := []int64{id}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			component = append(component, current)
+			for _, next := range neighbors[current] {
+				if seen[next] {
+					continue
+				}
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+		sort.Slice(component, func(i, j int) bool {
+			return component[i] < component[j]
+		})
+		components = append(components, component)
+	}
+
+	// A stable sort keeps groups of the same size in order of their lowest room ID
+	sort.SliceStable(components, func(i, j int) bool {
+		return len(components[i]) > len(components[j])
+	})
+	return components
+}
+
+// Reachable reports every room that can be walked to from the origin, including the origin
+func (g *Graph) Reachable(origin int64) map[int64]bool {
+	reachable := map[int64]bool{}
+	if !g.HasRoom(origin) {
+		return reachable
+	}
+	reachable[origin] = true
+	queue := []int64{origin}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, exit := range g.Exits[current] {
+			if reachable[exit.ToRID] {
+				continue
+			}
+			reachable[exit.ToRID] = true
+			queue = append(queue, exit.ToRID)
+		}
+	}
+	return reachable
+}
+
+// Unreachable lists the rooms that can't be walked to from the origin
+func (g *Graph) Unreachable(origin int64) []int64 {
+	reachable := g.Reachable(origin)
+	unreachable := []int64{}
+	for _, id := range g.IDs {
+		if !reachable[id] {
+			unreachable = append(unreachable, id)
+		}
+	}
+	return unreachable
+}
+
+// Orphans lists the rooms with no exits, in or out
+func (g *Graph) Orphans() []int64 {
+	orphans := []int64{}
+	for _, id := range g.IDs {
+		if len(g.Exits[id]) == 0 && g.Entrances[id] == 0 {
+			orphans = append(orphans, id)
+		}
+	}
+	return orphans
+}
+
+// DeadEnds lists the rooms that can be walked into, but not back out of
+func (g *Graph) DeadEnds() []int64 {
+	deadEnds := []int64{}
+	for _, id := range g.IDs {
+		if len(g.Exits[id]) == 0 && g.Entrances[id] > 0 {
+			deadEnds = append(deadEnds, id)
+		}
+	}
+	return deadEnds
+}
+
+// ShortestPath finds the fewest steps from one room to another. Where paths tie,
+// the one taking standard directions earliest wins.
+func (g *Graph) ShortestPath(from, to int64) ([]Step, error) {
+	if !g.HasRoom(from) || !g.HasRoom(to) {
+		return []Step{}, ErrRoomNotFound
+	}
+	if from == to {
+		return []Step{}, nil
+	}
+
+	previous := map[int64]Step{}
+	seen := map[int64]bool{from: true}
+	queue := []int64{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, exit := range g.Exits[current] {
+			if seen[exit.ToRID] {
+				continue
+			}
+			seen[exit.ToRID] = true
+			previous[exit.ToRID] = Step{
+				Direction: exit.Direction,
+				From:      current,
+				To:        exit.ToRID,
+			}
+			if exit.ToRID == to {
+				return walkBack(previous, from, to), nil
+			}
+			queue = append(queue, exit.ToRID)
+		}
+	}
+
+	return []Step{}, ErrNoPath
+}
+
+func walkBack(previous map[int64]Step, from, to int64) []Step {
+	steps := []Step{}
+	for current := to; current != from; current = previous[current].From {
+		steps = append(steps, previous[current])
+	}
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+	return steps
+}
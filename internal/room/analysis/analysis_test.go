@@ -0,0 +1,88 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/room"
+)
+
+// testGraph is a square of rooms 1-4, with 5 hanging off 4 one way, 6 leading
+// into the square one way, and 7 on its own
+func testGraph() Graph {
+	rooms := []query.Room{}
+	for id := int64(1); id <= 7; id++ {
+		rooms = append(rooms, query.Room{ID: id})
+	}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: room.DirectionEast},
+		{RID: 2, ToRID: 1, Direction: room.DirectionWest},
+		{RID: 2, ToRID: 3, Direction: room.DirectionSouth},
+		{RID: 3, ToRID: 2, Direction: room.DirectionNorth},
+		{RID: 3, ToRID: 4, Direction: room.DirectionWest},
+		{RID: 4, ToRID: 3, Direction: room.DirectionEast},
+		{RID: 4, ToRID: 1, Direction: room.DirectionNorth},
+		{RID: 1, ToRID: 4, Direction: "ladder"},
+		{RID: 1, ToRID: 4, Direction: room.DirectionSouth},
+		{RID: 4, ToRID: 5, Direction: room.DirectionDown},
+		{RID: 6, ToRID: 1, Direction: room.DirectionUp},
+		{RID: 1, ToRID: 99, Direction: room.DirectionNorth},
+	}
+	return New(rooms, exits)
+}
+
+func TestNewDropsExitsToMissingRooms(t *testing.T) {
+	g := testGraph()
+	for _, exit := range g.Exits[1] {
+		require.NotEqual(t, int64(99), exit.ToRID)
+	}
+	require.Equal(t, room.DirectionEast, g.Exits[1][0].Direction)
+	require.Equal(t, "ladder", g.Exits[1][2].Direction)
+}
+
+func TestComponents(t *testing.T) {
+	g := testGraph()
+	require.Equal(t, [][]int64{{1, 2, 3, 4, 5, 6}, {7}}, g.Components())
+}
+
+func TestUnreachable(t *testing.T) {
+	g := testGraph()
+	require.Equal(t, []int64{6, 7}, g.Unreachable(1))
+	require.Equal(t, []int64{1, 2, 3, 4, 6, 7}, g.Unreachable(5))
+	require.Equal(t, g.IDs, g.Unreachable(99))
+}
+
+func TestOrphansAndDeadEnds(t *testing.T) {
+	g := testGraph()
+	require.Equal(t, []int64{7}, g.Orphans())
+	require.Equal(t, []int64{5}, g.DeadEnds())
+}
+
+func TestShortestPath(t *testing.T) {
+	g := testGraph()
+
+	steps, err := g.ShortestPath(2, 5)
+	require.NoError(t, err)
+	require.Equal(t, []Step{
+		{Direction: room.DirectionSouth, From: 2, To: 3},
+		{Direction: room.DirectionWest, From: 3, To: 4},
+		{Direction: room.DirectionDown, From: 4, To: 5},
+	}, steps)
+
+	// The south exit wins the tie with the ladder
+	steps, err = g.ShortestPath(1, 4)
+	require.NoError(t, err)
+	require.Equal(t, []Step{{Direction: room.DirectionSouth, From: 1, To: 4}}, steps)
+
+	steps, err = g.ShortestPath(3, 3)
+	require.NoError(t, err)
+	require.Empty(t, steps)
+
+	_, err = g.ShortestPath(5, 1)
+	require.ErrorIs(t, err, ErrNoPath)
+
+	_, err = g.ShortestPath(1, 99)
+	require.ErrorIs(t, err, ErrRoomNotFound)
+}
@@ -0,0 +1,65 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/room"
+	"petrichormud.com/app/internal/route"
+)
+
+func (g *Graph) BindRoom(id int64) fiber.Map {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%d] %s", id, g.Rooms[id].Title)
+	return fiber.Map{
+		"ID":       id,
+		"Title":    sb.String(),
+		"Path":     route.RoomPath(id),
+		"EditPath": route.EditRoomPath(id),
+	}
+}
+
+func (g *Graph) BindRooms(ids []int64) []fiber.Map {
+	rooms := []fiber.Map{}
+	for _, id := range ids {
+		rooms = append(rooms, g.BindRoom(id))
+	}
+	return rooms
+}
+
+func (g *Graph) BindComponents() []fiber.Map {
+	components := []fiber.Map{}
+	for _, component := range g.Components() {
+		components = append(components, fiber.Map{
+			"Size":  len(component),
+			"Rooms": g.BindRooms(component),
+		})
+	}
+	return components
+}
+
+func (g *Graph) BindPath(steps []Step) []fiber.Map {
+	path := []fiber.Map{}
+	for _, step := range steps {
+		path = append(path, fiber.Map{
+			"Direction": room.DirectionTitle(step.Direction),
+			"From":      g.BindRoom(step.From),
+			"To":        g.BindRoom(step.To),
+		})
+	}
+	return path
+}
+
+func (g *Graph) BindGeometryIssues(issues []room.GeometryIssue) []fiber.Map {
+	b := []fiber.Map{}
+	for _, issue := range issues {
+		b = append(b, fiber.Map{
+			"Description": issue.String(),
+			"Room":        g.BindRoom(issue.RoomID),
+			"Other":       g.BindRoom(issue.OtherID),
+		})
+	}
+	return b
+}
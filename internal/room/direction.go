@@ -1,5 +1,10 @@
 package room
 
+import (
+	"sort"
+	"strings"
+)
+
 const (
 	DirectionNorth     string = "north"
 	DirectionNortheast string = "northeast"
@@ -9,6 +14,10 @@ const (
 	DirectionSouthwest string = "southwest"
 	DirectionWest      string = "west"
 	DirectionNorthwest string = "northwest"
+	DirectionUp        string = "up"
+	DirectionDown      string = "down"
+	DirectionIn        string = "in"
+	DirectionOut       string = "out"
 )
 
 const (
@@ -20,6 +29,10 @@ const (
 	DirectionLetterSouthwest string = "sw"
 	DirectionLetterWest      string = "w"
 	DirectionLetterNorthwest string = "nw"
+	DirectionLetterUp        string = "u"
+	DirectionLetterDown      string = "d"
+	DirectionLetterIn        string = "in"
+	DirectionLetterOut       string = "out"
 )
 
 const (
@@ -31,6 +44,10 @@ const (
 	DirectionTitleSouthwest string = "Southwest"
 	DirectionTitleWest      string = "West"
 	DirectionTitleNorthwest string = "Northwest"
+	DirectionTitleUp        string = "Up"
+	DirectionTitleDown      string = "Down"
+	DirectionTitleIn        string = "In"
+	DirectionTitleOut       string = "Out"
 )
 
 const (
@@ -44,6 +61,7 @@ const (
 	DirectionBindIDNorthwest string = "Northwest"
 )
 
+// DirectionsList holds the compass directions, which are the ones laid out on the grid
 var DirectionsList []string = []string{
 	DirectionNorth,
 	DirectionNortheast,
@@ -55,6 +73,36 @@ var DirectionsList []string = []string{
 	DirectionNorthwest,
 }
 
+// GridDirectionsList holds the directions laid out on the grid, across a level and between them
+var GridDirectionsList []string = []string{
+	DirectionNorth,
+	DirectionNortheast,
+	DirectionEast,
+	DirectionSoutheast,
+	DirectionSouth,
+	DirectionSouthwest,
+	DirectionWest,
+	DirectionNorthwest,
+	DirectionUp,
+	DirectionDown,
+}
+
+// StandardDirectionsList holds every direction an exit can have without a keyword
+var StandardDirectionsList []string = []string{
+	DirectionNorth,
+	DirectionNortheast,
+	DirectionEast,
+	DirectionSoutheast,
+	DirectionSouth,
+	DirectionSouthwest,
+	DirectionWest,
+	DirectionNorthwest,
+	DirectionUp,
+	DirectionDown,
+	DirectionIn,
+	DirectionOut,
+}
+
 var Directions map[string]bool = map[string]bool{
 	DirectionNorth:     true,
 	DirectionNortheast: true,
@@ -64,6 +112,21 @@ var Directions map[string]bool = map[string]bool{
 	DirectionSouthwest: true,
 	DirectionWest:      true,
 	DirectionNorthwest: true,
+	DirectionUp:        true,
+	DirectionDown:      true,
+	DirectionIn:        true,
+	DirectionOut:       true,
+}
+
+var CompassDirections map[string]bool = map[string]bool{
+	DirectionNorth:     true,
+	DirectionNortheast: true,
+	DirectionEast:      true,
+	DirectionSoutheast: true,
+	DirectionSouth:     true,
+	DirectionSouthwest: true,
+	DirectionWest:      true,
+	DirectionNorthwest: true,
 }
 
 var DirectionOpposites map[string]string = map[string]string{
@@ -75,6 +138,10 @@ var DirectionOpposites map[string]string = map[string]string{
 	DirectionSouthwest: DirectionNortheast,
 	DirectionWest:      DirectionEast,
 	DirectionNorthwest: DirectionSoutheast,
+	DirectionUp:        DirectionDown,
+	DirectionDown:      DirectionUp,
+	DirectionIn:        DirectionOut,
+	DirectionOut:       DirectionIn,
 }
 
 var DirectionLetters map[string]string = map[string]string{
@@ -86,6 +153,10 @@ var DirectionLetters map[string]string = map[string]string{
 	DirectionSouthwest: DirectionLetterSouthwest,
 	DirectionWest:      DirectionLetterWest,
 	DirectionNorthwest: DirectionLetterNorthwest,
+	DirectionUp:        DirectionLetterUp,
+	DirectionDown:      DirectionLetterDown,
+	DirectionIn:        DirectionLetterIn,
+	DirectionOut:       DirectionLetterOut,
 }
 
 var DirectionTitles map[string]string = map[string]string{
@@ -97,6 +168,10 @@ var DirectionTitles map[string]string = map[string]string{
 	DirectionSouthwest: DirectionTitleSouthwest,
 	DirectionWest:      DirectionTitleWest,
 	DirectionNorthwest: DirectionTitleNorthwest,
+	DirectionUp:        DirectionTitleUp,
+	DirectionDown:      DirectionTitleDown,
+	DirectionIn:        DirectionTitleIn,
+	DirectionOut:       DirectionTitleOut,
 }
 
 var DirectionBindIDs map[string]string = map[string]string{
@@ -115,6 +190,16 @@ func IsDirectionValid(dir string) bool {
 	return ok
 }
 
+func IsCompassDirection(dir string) bool {
+	_, ok := CompassDirections[dir]
+	return ok
+}
+
+// IsExitValid reports whether an exit can be named dir, either as a standard direction or a keyword
+func IsExitValid(dir string) bool {
+	return IsDirectionValid(dir) || IsExitKeywordValid(dir)
+}
+
 func DirectionOpposite(dir string) string {
 	opposite, ok := DirectionOpposites[dir]
 	if !ok {
@@ -126,6 +211,9 @@ func DirectionOpposite(dir string) string {
 func DirectionLetter(dir string) string {
 	letter, ok := DirectionLetters[dir]
 	if !ok {
+		if IsExitKeywordValid(dir) {
+			return dir
+		}
 		return ""
 	}
 	return letter
@@ -134,6 +222,9 @@ func DirectionLetter(dir string) string {
 func DirectionTitle(dir string) string {
 	title, ok := DirectionTitles[dir]
 	if !ok {
+		if IsExitKeywordValid(dir) {
+			return KeywordTitle(dir)
+		}
 		return ""
 	}
 	return title
@@ -146,3 +237,37 @@ func DirectionBindID(dir string) string {
 	}
 	return bindID
 }
+
+// KeywordTitle turns a keyword into a title, i.e. "rope-ladder" into "Rope Ladder"
+func KeywordTitle(keyword string) string {
+	words := strings.Split(keyword, "-")
+	for i, word := range words {
+		if len(word) == 0 {
+			continue
+		}
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// SortDirections puts the standard directions first, in order, with keywords after them alphabetically
+func SortDirections(dirs []string) []string {
+	order := map[string]int{}
+	for i, dir := range StandardDirectionsList {
+		order[dir] = i
+	}
+	sorted := make([]string, len(dirs))
+	copy(sorted, dirs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		oi, iok := order[sorted[i]]
+		oj, jok := order[sorted[j]]
+		if iok && jok {
+			return oi < oj
+		}
+		if iok != jok {
+			return iok
+		}
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
@@ -20,3 +20,35 @@ func TestIsDirectionValid(t *testing.T) {
 func TestIsDirectionValidInvalid(t *testing.T) {
 	require.False(t, IsDirectionValid("weast"))
 }
+
+func TestIsExitValid(t *testing.T) {
+	require.True(t, IsExitValid(DirectionUp))
+	require.True(t, IsExitValid(DirectionOut))
+	require.True(t, IsExitValid("tent"))
+	require.True(t, IsExitValid("rope-ladder"))
+	require.False(t, IsExitValid("t"))
+	require.False(t, IsExitValid("Tent"))
+	require.False(t, IsExitValid("the tent"))
+	require.False(t, IsExitValid("-tent"))
+}
+
+func TestIsExitKeywordValidStandardDirection(t *testing.T) {
+	require.False(t, IsExitKeywordValid(DirectionNorth))
+	require.False(t, IsExitKeywordValid(DirectionIn))
+}
+
+func TestDirectionOppositeKeyword(t *testing.T) {
+	require.Equal(t, DirectionDown, DirectionOpposite(DirectionUp))
+	require.Equal(t, DirectionIn, DirectionOpposite(DirectionOut))
+	require.Equal(t, "", DirectionOpposite("tent"))
+}
+
+func TestDirectionTitleKeyword(t *testing.T) {
+	require.Equal(t, "Rope Ladder", DirectionTitle("rope-ladder"))
+	require.Equal(t, "rope-ladder", DirectionLetter("rope-ladder"))
+}
+
+func TestSortDirections(t *testing.T) {
+	sorted := SortDirections([]string{"tent", DirectionDown, "hatch", DirectionNorth})
+	require.Equal(t, []string{DirectionNorth, DirectionDown, "hatch", "tent"}, sorted)
+}
@@ -10,6 +10,10 @@ import (
 
 var ErrExitIDNotFound error = errors.New("no exit found for that RID")
 
+// NewExitElementKey stands in for a direction in the elements for adding a keyword exit.
+// Keywords can't have underscores, so it can't collide with one.
+const NewExitElementKey string = "_new"
+
 func ExitEditElementID(dir string) string {
 	var sb strings.Builder
 	fmt.Fprintf(&sb, "edit-room-exits-edit-%s", dir)
@@ -22,79 +26,64 @@ func ExitSelectElementID(dir string) string {
 	return sb.String()
 }
 
-func ExitIDs(room *query.Room) []int64 {
+func ExitIDs(exits []query.RoomExit) []int64 {
 	ids := []int64{}
-	for _, dir := range DirectionsList {
-		ids = append(ids, ExitID(room, dir))
+	for _, exit := range exits {
+		ids = append(ids, exit.ToRID)
 	}
 	return ids
 }
 
-func ExitID(room *query.Room, dir string) int64 {
-	switch dir {
-	case DirectionNorth:
-		return room.North
-	case DirectionNortheast:
-		return room.Northeast
-	case DirectionEast:
-		return room.East
-	case DirectionSoutheast:
-		return room.Southeast
-	case DirectionSouth:
-		return room.South
-	case DirectionSouthwest:
-		return room.Southwest
-	case DirectionWest:
-		return room.West
-	case DirectionNorthwest:
-		return room.Northwest
-	default:
-		return 0
+func ExitDirections(exits []query.RoomExit) []string {
+	dirs := []string{}
+	for _, exit := range exits {
+		dirs = append(dirs, exit.Direction)
 	}
+	return SortDirections(dirs)
 }
 
-func ExitDirection(room *query.Room, id int64) (string, error) {
-	switch id {
-	case room.North:
-		return DirectionNorth, nil
-	case room.Northeast:
-		return DirectionNortheast, nil
-	case room.East:
-		return DirectionEast, nil
-	case room.Southeast:
-		return DirectionSoutheast, nil
-	case room.South:
-		return DirectionSouth, nil
-	case room.Southwest:
-		return DirectionSouthwest, nil
-	case room.West:
-		return DirectionWest, nil
-	case room.Northwest:
-		return DirectionNorthwest, nil
-	default:
-		return "", ErrExitIDNotFound
+func ExitID(exits []query.RoomExit, dir string) int64 {
+	for _, exit := range exits {
+		if exit.Direction == dir {
+			return exit.ToRID
+		}
 	}
+	return 0
 }
 
-func IsExitTwoWay(room *query.Room, exitRoom *query.Room, dir string) bool {
-	if !IsDirectionValid(dir) {
+// ExitDirection finds the direction of an exit leading to a room. Where more than
+// one does, the standard directions win out over keywords.
+func ExitDirection(exits []query.RoomExit, id int64) (string, error) {
+	for _, dir := range ExitDirections(exits) {
+		if ExitID(exits, dir) == id {
+			return dir, nil
+		}
+	}
+	return "", ErrExitIDNotFound
+}
+
+// IsExitTwoWay reports whether the room an exit leads to has a way back. For the standard
+// directions that has to be the opposite exit; a keyword has no opposite, so any exit back counts.
+func IsExitTwoWay(rid int64, exits []query.RoomExit, exitRoomExits []query.RoomExit, dir string) bool {
+	if !IsExitValid(dir) {
 		return false
 	}
 
-	roomExitID := ExitID(room, dir)
+	roomExitID := ExitID(exits, dir)
 	if roomExitID == 0 {
 		return false
 	}
 
 	opposite := DirectionOpposite(dir)
 	if len(opposite) == 0 {
-		return false
+		_, err := ExitDirection(exitRoomExits, rid)
+		return err == nil
 	}
 
-	exitRoomExitID := ExitID(exitRoom, opposite)
+	exitRoomExitID := ExitID(exitRoomExits, opposite)
 	if exitRoomExitID == 0 {
 		return false
 	}
 
-	return exitRoomExitID == room.ID
+	return exitRoomExitID == rid
 }
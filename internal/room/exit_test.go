@@ -17,17 +17,17 @@ func TestIsExitTwoWayFalseUnlinked(t *testing.T) {
 	ridOne := test.CreateTestRoom(t, &i, test.TestRoom)
 	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
 
-	rm, err := i.Queries.GetRoom(context.Background(), ridOne)
+	exits, err := i.Queries.ListRoomExits(context.Background(), ridOne)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	exitrm, err := i.Queries.GetRoom(context.Background(), ridTwo)
+	exitRoomExits, err := i.Queries.ListRoomExits(context.Background(), ridTwo)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	require.False(t, IsExitTwoWay(&rm, &exitrm, DirectionNorth))
+	require.False(t, IsExitTwoWay(ridOne, exits, exitRoomExits, DirectionNorth))
 }
 
 func TestIsExitTwoWayFalseOneWay(t *testing.T) {
@@ -47,17 +47,17 @@ func TestIsExitTwoWayFalseOneWay(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	rm, err := i.Queries.GetRoom(context.Background(), ridOne)
+	exits, err := i.Queries.ListRoomExits(context.Background(), ridOne)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	exitrm, err := i.Queries.GetRoom(context.Background(), ridTwo)
+	exitRoomExits, err := i.Queries.ListRoomExits(context.Background(), ridTwo)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	require.False(t, IsExitTwoWay(&rm, &exitrm, DirectionNorth))
+	require.False(t, IsExitTwoWay(ridOne, exits, exitRoomExits, DirectionNorth))
 }
 
 func TestIsExitTwoWayFalseOneWayOpposite(t *testing.T) {
@@ -77,17 +77,17 @@ func TestIsExitTwoWayFalseOneWayOpposite(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	rm, err := i.Queries.GetRoom(context.Background(), ridOne)
+	exits, err := i.Queries.ListRoomExits(context.Background(), ridOne)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	exitrm, err := i.Queries.GetRoom(context.Background(), ridTwo)
+	exitRoomExits, err := i.Queries.ListRoomExits(context.Background(), ridTwo)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	require.False(t, IsExitTwoWay(&rm, &exitrm, DirectionNorth))
+	require.False(t, IsExitTwoWay(ridOne, exits, exitRoomExits, DirectionNorth))
 }
 
 func TestIsExitTwoWayTrue(t *testing.T) {
@@ -107,15 +107,49 @@ func TestIsExitTwoWayTrue(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	rm, err := i.Queries.GetRoom(context.Background(), ridOne)
+	exits, err := i.Queries.ListRoomExits(context.Background(), ridOne)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	exitrm, err := i.Queries.GetRoom(context.Background(), ridTwo)
+	exitRoomExits, err := i.Queries.ListRoomExits(context.Background(), ridTwo)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	require.True(t, IsExitTwoWay(&rm, &exitrm, DirectionNorth))
+	require.True(t, IsExitTwoWay(ridOne, exits, exitRoomExits, DirectionNorth))
+}
+
+func TestIsExitTwoWayKeyword(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	ridOne := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridOne)
+	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridTwo)
+
+	if err := Link(LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: "tent",
+		Return:    DirectionOut,
+		TwoWay:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	exits, err := i.Queries.ListRoomExits(context.Background(), ridOne)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exitRoomExits, err := i.Queries.ListRoomExits(context.Background(), ridTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.True(t, IsExitTwoWay(ridOne, exits, exitRoomExits, "tent"))
+	require.True(t, IsExitTwoWay(ridTwo, exitRoomExits, exits, DirectionOut))
 }
@@ -0,0 +1,392 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"petrichormud.com/app/internal/query"
+)
+
+const (
+	GeometryIssueOverlap   string = "overlap"
+	GeometryIssueMisplaced string = "misplaced"
+	GeometryIssueOpposite  string = "opposite"
+	GeometryIssueOneWay    string = "one-way"
+)
+
+var ErrOriginNotFound error = errors.New("no room found to start placing from")
+
+// Coordinates place a room on the map. X runs east, Y runs north and Z runs up.
+type Coordinates struct {
+	X int32
+	Y int32
+	Z int32
+}
+
+func (c Coordinates) Add(o Coordinates) Coordinates {
+	return Coordinates{X: c.X + o.X, Y: c.Y + o.Y, Z: c.Z + o.Z}
+}
+
+func (c Coordinates) Sub(o Coordinates) Coordinates {
+	return Coordinates{X: c.X - o.X, Y: c.Y - o.Y, Z: c.Z - o.Z}
+}
+
+// DirectionOffsets holds how far one step in a direction moves on the map.
+// In, out and keywords have no place on the map, so they're left out.
+var DirectionOffsets map[string]Coordinates = map[string]Coordinates{
+	DirectionNorth:     {Y: 1},
+	DirectionNortheast: {X: 1, Y: 1},
+	DirectionEast:      {X: 1},
+	DirectionSoutheast: {X: 1, Y: -1},
+	DirectionSouth:     {Y: -1},
+	DirectionSouthwest: {X: -1, Y: -1},
+	DirectionWest:      {X: -1},
+	DirectionNorthwest: {X: -1, Y: 1},
+	DirectionUp:        {Z: 1},
+	DirectionDown:      {Z: -1},
+}
+
+func RoomCoordinates(rm *query.Room) Coordinates {
+	return Coordinates{X: rm.X, Y: rm.Y, Z: rm.Z}
+}
+
+type PlaceParams struct {
+	Queries   *query.Queries
+	Direction string
+	ID        int64
+	To        int64
+}
+
+// Place stores coordinates for the rooms on either side of an exit, working from whichever
+// one is already on the map. The unplaced room brings the rest of its unplaced rooms along,
+// laid out relative to where it lands. Rooms that are both unplaced stay that way until
+// they're linked to a room on the map; Backfill is what puts the first rooms there.
+// Rooms that are both placed are left where they are, even if the exit doesn't line up.
+func Place(in PlaceParams) error {
+	offset, ok := DirectionOffsets[in.Direction]
+	if !ok {
+		return nil
+	}
+
+	rm, err := in.Queries.GetRoom(context.Background(), in.ID)
+	if err != nil {
+		return err
+	}
+	to, err := in.Queries.GetRoom(context.Background(), in.To)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case rm.Placed && to.Placed:
+		return nil
+	case rm.Placed:
+		return placeComponent(in.Queries, to.ID, RoomCoordinates(&rm).Add(offset))
+	case to.Placed:
+		return placeComponent(in.Queries, rm.ID, RoomCoordinates(&to).Sub(offset))
+	default:
+		return nil
+	}
+}
+
+func placeComponent(q *query.Queries, id int64, c Coordinates) error {
+	rooms, err := q.ListRooms(context.Background())
+	if err != nil {
+		return ErrListingRooms
+	}
+	exits, err := q.ListAllRoomExits(context.Background())
+	if err != nil {
+		return ErrListingExits
+	}
+
+	layout, err := ComponentLayout(rooms, exits, id, c)
+	if err != nil {
+		return err
+	}
+	return storeLayout(q, layout)
+}
+
+// ComponentLayout works out coordinates for a room placed at c and every unplaced room that
+// can be reached from it without crossing a room that's already on the map.
+func ComponentLayout(rooms []query.Room, exits []query.RoomExit, id int64, c Coordinates) (map[int64]Coordinates, error) {
+	unplaced := []query.Room{}
+	for _, rm := range rooms {
+		if rm.ID == id {
+			rm.X, rm.Y, rm.Z = c.X, c.Y, c.Z
+			rm.Placed = true
+			unplaced = append(unplaced, rm)
+			continue
+		}
+		if !rm.Placed {
+			unplaced = append(unplaced, rm)
+		}
+	}
+	return Layout(unplaced, exits, id)
+}
+
+func storeLayout(q *query.Queries, layout map[int64]Coordinates) error {
+	ids := []int64{}
+	for id := range layout {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		if err := placeRoom(q, id, layout[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func placeRoom(q *query.Queries, id int64, c Coordinates) error {
+	return q.UpdateRoomCoordinates(context.Background(), query.UpdateRoomCoordinatesParams{
+		X:  c.X,
+		Y:  c.Y,
+		Z:  c.Z,
+		ID: id,
+	})
+}
+
+type BackfillParams struct {
+	Queries *query.Queries
+	Origin  int64
+}
+
+// Backfill lays out every room that can be reached from the origin, in or out, and stores
+// their coordinates. Place only works out from rooms already on the map, so this is how
+// the first rooms get there, along with any linked before coordinates were stored.
+// It returns how many rooms were placed.
+func Backfill(in BackfillParams) (int, error) {
+	rooms, err := in.Queries.ListRooms(context.Background())
+	if err != nil {
+		return 0, ErrListingRooms
+	}
+	exits, err := in.Queries.ListAllRoomExits(context.Background())
+	if err != nil {
+		return 0, ErrListingExits
+	}
+
+	layout, err := Layout(rooms, exits, in.Origin)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := storeLayout(in.Queries, layout); err != nil {
+		return 0, err
+	}
+	return len(layout), nil
+}
+
+// Layout works out coordinates for every room that can be reached from the origin, walking
+// exits in either direction. The origin keeps its coordinates if it has them. Where exits
+// disagree about where a room goes, the first one walked wins; CheckGeometry reports the rest.
+func Layout(rooms []query.Room, exits []query.RoomExit, origin int64) (map[int64]Coordinates, error) {
+	roomsByID := map[int64]*query.Room{}
+	for i := range rooms {
+		roomsByID[rooms[i].ID] = &rooms[i]
+	}
+	rm, ok := roomsByID[origin]
+	if !ok {
+		return map[int64]Coordinates{}, ErrOriginNotFound
+	}
+
+	exitsByRoomID := map[int64][]query.RoomExit{}
+	entrancesByRoomID := map[int64][]query.RoomExit{}
+	for _, exit := range exits {
+		if _, ok := roomsByID[exit.RID]; !ok {
+			continue
+		}
+		if _, ok := roomsByID[exit.ToRID]; !ok {
+			continue
+		}
+		exitsByRoomID[exit.RID] = append(exitsByRoomID[exit.RID], exit)
+		entrancesByRoomID[exit.ToRID] = append(entrancesByRoomID[exit.ToRID], exit)
+	}
+
+	layout := map[int64]Coordinates{}
+	if rm.Placed {
+		layout[origin] = RoomCoordinates(rm)
+	} else {
+		layout[origin] = Coordinates{}
+	}
+	queue := []int64{origin}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		c := layout[current]
+
+		roomExits := exitsByRoomID[current]
+		for _, dir := range ExitDirections(roomExits) {
+			offset, ok := DirectionOffsets[dir]
+			if !ok {
+				continue
+			}
+			id := ExitID(roomExits, dir)
+			if _, ok := layout[id]; ok {
+				continue
+			}
+			layout[id] = c.Add(offset)
+			queue = append(queue, id)
+		}
+
+		entrances := append([]query.RoomExit{}, entrancesByRoomID[current]...)
+		sort.SliceStable(entrances, func(i, j int) bool {
+			return entrances[i].RID < entrances[j].RID
+		})
+		for _, exit := range entrances {
+			offset, ok := DirectionOffsets[exit.Direction]
+			if !ok {
+				continue
+			}
+			if _, ok := layout[exit.RID]; ok {
+				continue
+			}
+			layout[exit.RID] = c.Sub(offset)
+			queue = append(queue, exit.RID)
+		}
+	}
+
+	return layout, nil
+}
+
+type GeometryIssue struct {
+	Kind      string
+	Direction string
+	RoomID    int64
+	OtherID   int64
+}
+
+func (gi GeometryIssue) String() string {
+	var sb strings.Builder
+	switch gi.Kind {
+	case GeometryIssueOverlap:
+		fmt.Fprintf(&sb, "rooms %d and %d are mapped to the same place", gi.RoomID, gi.OtherID)
+	case GeometryIssueMisplaced:
+		fmt.Fprintf(&sb, "the %s exit from room %d leads to room %d, which isn't mapped %s of it", gi.Direction, gi.RoomID, gi.OtherID, gi.Direction)
+	case GeometryIssueOpposite:
+		fmt.Fprintf(&sb, "the %s exit from room %d leads to room %d, whose %s exit leads somewhere else", gi.Direction, gi.RoomID, gi.OtherID, DirectionOpposite(gi.Direction))
+	case GeometryIssueOneWay:
+		fmt.Fprintf(&sb, "the %s exit from room %d to room %d has no way back", gi.Direction, gi.RoomID, gi.OtherID)
+	}
+	return sb.String()
+}
+
+type ValidateGeometryParams struct {
+	Queries *query.Queries
+	ID      int64
+}
+
+// ValidateGeometry checks the map made up of every room that can be reached from a room
+func ValidateGeometry(in ValidateGeometryParams) ([]GeometryIssue, error) {
+	seen := map[int64]bool{in.ID: true}
+	frontier := []int64{in.ID}
+	exits := []query.RoomExit{}
+	for len(frontier) > 0 {
+		frontierExits, err := in.Queries.ListRoomExitsByRoomIDs(context.Background(), frontier)
+		if err != nil {
+			return []GeometryIssue{}, ErrListingExits
+		}
+		exits = append(exits, frontierExits...)
+
+		frontier = []int64{}
+		for _, exit := range frontierExits {
+			if seen[exit.ToRID] {
+				continue
+			}
+			seen[exit.ToRID] = true
+			frontier = append(frontier, exit.ToRID)
+		}
+	}
+
+	ids := []int64{}
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	rooms, err := in.Queries.ListRoomsByIDs(context.Background(), ids)
+	if err != nil {
+		return []GeometryIssue{}, ErrListingRooms
+	}
+
+	return CheckGeometry(rooms, exits), nil
+}
+
+// CheckGeometry reports rooms mapped to the same place, exits that don't line up with
+// where their rooms are mapped and exits without a way back. Unplaced rooms are only
+// checked for a way back.
+func CheckGeometry(rooms []query.Room, exits []query.RoomExit) []GeometryIssue {
+	rooms = append([]query.Room{}, rooms...)
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].ID < rooms[j].ID
+	})
+
+	roomsByID := map[int64]*query.Room{}
+	for i := range rooms {
+		roomsByID[rooms[i].ID] = &rooms[i]
+	}
+	exitsByRoomID := map[int64][]query.RoomExit{}
+	for _, exit := range exits {
+		exitsByRoomID[exit.RID] = append(exitsByRoomID[exit.RID], exit)
+	}
+
+	issues := []GeometryIssue{}
+
+	placedByCoordinates := map[Coordinates]int64{}
+	for _, rm := range rooms {
+		if !rm.Placed {
+			continue
+		}
+		c := RoomCoordinates(&rm)
+		if id, ok := placedByCoordinates[c]; ok {
+			issues = append(issues, GeometryIssue{
+				Kind:    GeometryIssueOverlap,
+				RoomID:  id,
+				OtherID: rm.ID,
+			})
+			continue
+		}
+		placedByCoordinates[c] = rm.ID
+	}
+
+	for _, rm := range rooms {
+		roomExits := exitsByRoomID[rm.ID]
+		for _, dir := range ExitDirections(roomExits) {
+			to, ok := roomsByID[ExitID(roomExits, dir)]
+			if !ok {
+				continue
+			}
+
+			offset, ok := DirectionOffsets[dir]
+			if ok && rm.Placed && to.Placed && RoomCoordinates(&rm).Add(offset) != RoomCoordinates(to) {
+				issues = append(issues, GeometryIssue{
+					Kind:      GeometryIssueMisplaced,
+					Direction: dir,
+					RoomID:    rm.ID,
+					OtherID:   to.ID,
+				})
+			}
+
+			toExits := exitsByRoomID[to.ID]
+			if IsExitTwoWay(rm.ID, roomExits, toExits, dir) {
+				continue
+			}
+			kind := GeometryIssueOneWay
+			opposite := DirectionOpposite(dir)
+			if len(opposite) > 0 && ExitID(toExits, opposite) != 0 {
+				kind = GeometryIssueOpposite
+			}
+			issues = append(issues, GeometryIssue{
+				Kind:      kind,
+				Direction: dir,
+				RoomID:    rm.ID,
+				OtherID:   to.ID,
+			})
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,153 @@
+package room
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/query"
+)
+
+func TestCheckGeometryConsistent(t *testing.T) {
+	rooms := []query.Room{
+		{ID: 1, Placed: true},
+		{ID: 2, Y: 1, Placed: true},
+	}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionNorth},
+		{RID: 2, ToRID: 1, Direction: DirectionSouth},
+	}
+	require.Empty(t, CheckGeometry(rooms, exits))
+}
+
+func TestCheckGeometryOverlap(t *testing.T) {
+	rooms := []query.Room{
+		{ID: 2, X: 1, Placed: true},
+		{ID: 1, X: 1, Placed: true},
+		{ID: 3},
+		{ID: 4},
+	}
+	issues := CheckGeometry(rooms, []query.RoomExit{})
+	require.Equal(t, []GeometryIssue{
+		{Kind: GeometryIssueOverlap, RoomID: 1, OtherID: 2},
+	}, issues)
+}
+
+func TestCheckGeometryMisplaced(t *testing.T) {
+	rooms := []query.Room{
+		{ID: 1, Placed: true},
+		{ID: 2, X: 1, Placed: true},
+	}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionNorth},
+		{RID: 2, ToRID: 1, Direction: DirectionSouth},
+	}
+	issues := CheckGeometry(rooms, exits)
+	require.Equal(t, 2, len(issues))
+	require.Equal(t, GeometryIssueMisplaced, issues[0].Kind)
+	require.Equal(t, DirectionNorth, issues[0].Direction)
+	require.Equal(t, GeometryIssueMisplaced, issues[1].Kind)
+	require.Equal(t, DirectionSouth, issues[1].Direction)
+}
+
+func TestCheckGeometryOpposite(t *testing.T) {
+	rooms := []query.Room{{ID: 1}, {ID: 2}, {ID: 3}}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionNorth},
+		{RID: 2, ToRID: 3, Direction: DirectionSouth},
+	}
+	issues := CheckGeometry(rooms, exits)
+	require.Contains(t, issues, GeometryIssue{
+		Kind:      GeometryIssueOpposite,
+		Direction: DirectionNorth,
+		RoomID:    1,
+		OtherID:   2,
+	})
+}
+
+func TestCheckGeometryOneWay(t *testing.T) {
+	rooms := []query.Room{{ID: 1}, {ID: 2}}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionEast},
+		{RID: 1, ToRID: 2, Direction: "ladder"},
+	}
+	issues := CheckGeometry(rooms, exits)
+	require.Equal(t, []GeometryIssue{
+		{Kind: GeometryIssueOneWay, Direction: DirectionEast, RoomID: 1, OtherID: 2},
+		{Kind: GeometryIssueOneWay, Direction: "ladder", RoomID: 1, OtherID: 2},
+	}, issues)
+}
+
+func TestGeometryIssueString(t *testing.T) {
+	issue := GeometryIssue{Kind: GeometryIssueOpposite, Direction: DirectionNorth, RoomID: 1, OtherID: 2}
+	require.Equal(t, "the north exit from room 1 leads to room 2, whose south exit leads somewhere else", issue.String())
+}
+
+func TestLayout(t *testing.T) {
+	rooms := []query.Room{
+		{ID: 1},
+		{ID: 2},
+		{ID: 3},
+		{ID: 4},
+		{ID: 5},
+	}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionNorth},
+		{RID: 2, ToRID: 1, Direction: DirectionSouth},
+		{RID: 2, ToRID: 3, Direction: DirectionUp},
+		// Only reachable by walking this exit backwards
+		{RID: 4, ToRID: 1, Direction: DirectionEast},
+		// In and out have no place on the map
+		{RID: 1, ToRID: 5, Direction: DirectionIn},
+	}
+	layout, err := Layout(rooms, exits, 1)
+	require.NoError(t, err)
+	require.Equal(t, map[int64]Coordinates{
+		1: {},
+		2: {Y: 1},
+		3: {Y: 1, Z: 1},
+		4: {X: -1},
+	}, layout)
+}
+
+func TestLayoutKeepsPlacedOrigin(t *testing.T) {
+	rooms := []query.Room{
+		{ID: 1, X: 3, Y: 4, Placed: true},
+		{ID: 2},
+	}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionWest},
+	}
+	layout, err := Layout(rooms, exits, 1)
+	require.NoError(t, err)
+	require.Equal(t, map[int64]Coordinates{
+		1: {X: 3, Y: 4},
+		2: {X: 2, Y: 4},
+	}, layout)
+}
+
+func TestComponentLayout(t *testing.T) {
+	rooms := []query.Room{
+		{ID: 1, Placed: true},
+		{ID: 2},
+		{ID: 3},
+		{ID: 4, X: 5, Placed: true},
+	}
+	exits := []query.RoomExit{
+		{RID: 1, ToRID: 2, Direction: DirectionEast},
+		{RID: 2, ToRID: 3, Direction: DirectionNorth},
+		// Already on the map, so it's left where it is
+		{RID: 3, ToRID: 4, Direction: DirectionEast},
+	}
+	layout, err := ComponentLayout(rooms, exits, 2, Coordinates{X: 1})
+	require.NoError(t, err)
+	require.Equal(t, map[int64]Coordinates{
+		2: {X: 1},
+		3: {X: 1, Y: 1},
+	}, layout)
+}
+
+func TestLayoutOriginNotFound(t *testing.T) {
+	_, err := Layout([]query.Room{{ID: 1}}, []query.RoomExit{}, 2)
+	require.ErrorIs(t, err, ErrOriginNotFound)
+}
@@ -3,6 +3,7 @@ package room
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	fiber "github.com/gofiber/fiber/v2"
 
@@ -11,26 +12,33 @@ import (
 	"petrichormud.com/app/internal/route"
 )
 
-const errListingRooms string = "error listing rooms from database"
+const (
+	errListingRooms string = "error listing rooms from database"
+	errListingExits string = "error listing room exits from database"
+)
 
-var ErrListingRooms error = errors.New(errListingRooms)
+var (
+	ErrListingRooms error = errors.New(errListingRooms)
+	ErrListingExits error = errors.New(errListingExits)
+)
+
+// NodeExit is one of a Node's exits, along with the Node it leads to
+type NodeExit struct {
+	Node   *Node
+	Door   bool
+	Hidden bool
+	Locked bool
+}
 
 type Node struct {
-	North       *Node
-	Northeast   *Node
-	East        *Node
-	Southeast   *Node
-	South       *Node
-	Southwest   *Node
-	West        *Node
-	Northwest   *Node
+	Exits       map[string]NodeExit
 	Title       string
 	Description string
+	Coordinates Coordinates
 	ID          int64
+	Placed      bool
 }
 
-var nilNode *Node = nil
-
 func (n *Node) IsExitEmpty(dir string) bool {
 	exitNode := n.Exit(dir)
 	if exitNode == nil {
@@ -46,26 +54,34 @@ func (n *Node) ExitID(dir string) int64 {
 	return n.Exit(dir).ID
 }
 
+// Exit returns the Node an exit leads to, or an empty Node if there's no exit that way
 func (n *Node) Exit(dir string) *Node {
-	switch dir {
-	case DirectionNorth:
-		return n.North
-	case DirectionNortheast:
-		return n.Northeast
-	case DirectionEast:
-		return n.East
-	case DirectionSoutheast:
-		return n.Southeast
-	case DirectionSouth:
-		return n.South
-	case DirectionSouthwest:
-		return n.Southwest
-	case DirectionWest:
-		return n.West
-	case DirectionNorthwest:
-		return n.Northwest
+	exit, ok := n.Exits[dir]
+	if !ok || exit.Node == nil {
+		emptyNode := EmptyGraphNode()
+		return &emptyNode
+	}
+	return exit.Node
+}
+
+// ExitDirections lists the directions of the Node's exits, with the standard ones first
+func (n *Node) ExitDirections() []string {
+	dirs := []string{}
+	for dir := range n.Exits {
+		dirs = append(dirs, dir)
+	}
+	return SortDirections(dirs)
+}
+
+// KeywordExitDirections lists the directions of the Node's exits that are named with keywords
+func (n *Node) KeywordExitDirections() []string {
+	dirs := []string{}
+	for _, dir := range n.ExitDirections() {
+		if IsExitKeywordValid(dir) {
+			dirs = append(dirs, dir)
+		}
 	}
-	return nil
+	return dirs
 }
 
 func (n *Node) GetExitID(dir string) int64 {
@@ -89,6 +105,11 @@ func (n *Node) Bind() fiber.Map {
 		bind[bindID] = n.BindGridExit(dir)
 	}
 
+	// Up and down don't have a place on the grid, so the room gets a marker for them instead
+	bind["Up"] = n.BindGridExit(DirectionUp)
+	bind["Down"] = n.BindGridExit(DirectionDown)
+	bind["Vertical"] = !n.IsExitEmpty(DirectionUp) || !n.IsExitEmpty(DirectionDown)
+
 	return bind
 }
 
@@ -116,57 +137,92 @@ func MatrixCoordinateForDirection(dir string, row, col int) (int, int) {
 		return row, col - 1
 	case DirectionNorthwest:
 		return row - 1, col - 1
+	case DirectionUp, DirectionDown:
+		return row, col
 	}
 	return 0, 0
 }
 
+// MatrixLevelForDirection gives the level an exit leads to. Up and down are the only
+// directions that leave the level.
+func MatrixLevelForDirection(dir string, z int) int {
+	switch dir {
+	case DirectionUp:
+		return z + 1
+	case DirectionDown:
+		return z - 1
+	}
+	return z
+}
+
 type BindMatrixParams struct {
 	Matrix   [][]fiber.Map
 	Priority []int64
-	Row      int
-	Col      int
-	Shallow  bool
+	// Center is the node in the middle of the matrix. When it and the node being bound
+	// are both on the map, the node goes where its coordinates put it.
+	Center *Node
+	Row    int
+	Col    int
+	// Z is the level of the node being bound, relative to the root node at zero.
+	// Only nodes on the matrix's Level are bound into it.
+	Z       int
+	Level   int
+	Shallow bool
 }
 
+// BindMatrix binds the node and the nodes it leads to into the matrix. Nodes on the map are
+// laid out by their coordinates relative to the center; the rest go where their exits lead.
 func (n *Node) BindMatrix(p BindMatrixParams) [][]fiber.Map {
-	// TODO: Set up an algorithm for this to write *out* from the center node
-	// This would predicate the grid being an odd-sized square: i.e. 5x5, 7x7, etc
-	// Bind the center cell to the data of the root node
-
 	priorityMap := PriorityMap(p.Priority)
 
+	if offset, ok := n.OffsetFrom(p.Center); ok {
+		p.Row = len(p.Matrix)/2 - int(offset.Y)
+		p.Col = len(p.Matrix)/2 + int(offset.X)
+		p.Z = int(offset.Z)
+	}
+
 	if !IsValidMatrixCoordinate(p.Matrix, p.Row, p.Col) {
 		return p.Matrix
 	}
 
 	if !p.Shallow {
-		for _, dir := range DirectionsList {
+		for _, dir := range GridDirectionsList {
 			row, col := MatrixCoordinateForDirection(dir, p.Row, p.Col)
 			if n.IsExitEmpty(dir) {
 				continue
 			}
 			p.Matrix = n.Exit(dir).BindMatrix(BindMatrixParams{
 				Matrix:  p.Matrix,
+				Center:  p.Center,
 				Row:     row,
 				Col:     col,
+				Z:       MatrixLevelForDirection(dir, p.Z),
+				Level:   p.Level,
 				Shallow: true,
 			})
 		}
 
-		for _, dir := range DirectionsList {
+		for _, dir := range GridDirectionsList {
 			row, col := MatrixCoordinateForDirection(dir, p.Row, p.Col)
 			if n.IsExitEmpty(dir) {
 				continue
 			}
 			p.Matrix = n.Exit(dir).BindMatrix(BindMatrixParams{
 				Matrix:  p.Matrix,
+				Center:  p.Center,
 				Row:     row,
 				Col:     col,
+				Z:       MatrixLevelForDirection(dir, p.Z),
+				Level:   p.Level,
 				Shallow: false,
 			})
 		}
 	}
 
+	if p.Z != p.Level {
+		return p.Matrix
+	}
+
 	visitedID := p.Matrix[p.Row][p.Col]["ID"].(int64)
 	if visitedID == int64(0) || Priority(priorityMap, n.ID, visitedID) {
 		p.Matrix[p.Row][p.Col] = n.Bind()
@@ -175,6 +231,74 @@ func (n *Node) BindMatrix(p BindMatrixParams) [][]fiber.Map {
 	return p.Matrix
 }
 
+// OffsetFrom gives how far the node is from another on the map, if they're both on it
+func (n *Node) OffsetFrom(o *Node) (Coordinates, bool) {
+	if o == nil || !o.Placed || !n.Placed {
+		return Coordinates{}, false
+	}
+	return n.Coordinates.Sub(o.Coordinates), true
+}
+
+// Levels reports the lowest and highest levels the graph reaches, relative to this node
+func (n *Node) Levels() (int, int) {
+	return n.levels(n, 0)
+}
+
+func (n *Node) levels(center *Node, z int) (int, int) {
+	if offset, ok := n.OffsetFrom(center); ok {
+		z = int(offset.Z)
+	}
+	lowest, highest := z, z
+	for _, dir := range GridDirectionsList {
+		if n.IsExitEmpty(dir) {
+			continue
+		}
+		low, high := n.Exit(dir).levels(center, MatrixLevelForDirection(dir, z))
+		lowest = min(lowest, low)
+		highest = max(highest, high)
+	}
+	return lowest, highest
+}
+
+// BindGrid binds one level of the grid around the node, along with the levels to switch between
+func (n *Node) BindGrid(b fiber.Map, level int) fiber.Map {
+	grid := n.BindMatrix(BindMatrixParams{
+		Matrix:  EmptyBindMatrix(5),
+		Center:  n,
+		Row:     2,
+		Col:     2,
+		Level:   level,
+		Shallow: false,
+	})
+	b["RoomGrid"] = AnnotateMatrixExits(grid)
+	b["RoomGridLevel"] = level
+	b["RoomGridLevels"] = n.BindGridLevels(level)
+	return b
+}
+
+func (n *Node) BindGridLevels(level int) []fiber.Map {
+	lowest, highest := n.Levels()
+	levels := []fiber.Map{}
+	// Highest first, so the levels stack the way they're built
+	for z := highest; z >= lowest; z-- {
+		levels = append(levels, fiber.Map{
+			"Level":  z,
+			"Label":  LevelLabel(z),
+			"Path":   route.RoomGridLevelPath(n.ID, z),
+			"Active": z == level,
+		})
+	}
+	return levels
+}
+
+// LevelLabel names a level relative to the room at the center of the grid
+func LevelLabel(z int) string {
+	if z > 0 {
+		return fmt.Sprintf("+%d", z)
+	}
+	return fmt.Sprintf("%d", z)
+}
+
 func IsValidMatrixCoordinate(matrix [][]fiber.Map, row, col int) bool {
 	if row < 0 {
 		return false
@@ -223,8 +347,11 @@ func BuildGraph(p BuildGraphParams) (Node, error) {
 	if maxDepth == 0 {
 		maxDepth = constant.DefaultRoomGraphDepth
 	}
-	exitIDs := ExitIDs(p.Room)
-	exitRooms, err := p.Queries.ListRoomsByIDs(context.Background(), exitIDs)
+	exits, err := p.Queries.ListRoomExits(context.Background(), p.Room.ID)
+	if err != nil {
+		return Node{ID: p.Room.ID}, ErrListingExits
+	}
+	exitRooms, err := p.Queries.ListRoomsByIDs(context.Background(), ExitIDs(exits))
 	if err != nil {
 		return Node{ID: p.Room.ID}, ErrListingRooms
 	}
@@ -232,59 +359,40 @@ func BuildGraph(p BuildGraphParams) (Node, error) {
 	for _, exitRoom := range exitRooms {
 		exitRoomByID[exitRoom.ID] = exitRoom
 	}
-	nodesByDirection := EmptyNodesByDirection()
-	for _, dir := range DirectionsList {
-		exitID := ExitID(p.Room, dir)
-		if exitID == 0 {
+
+	node := TerminalNode(p.Room)
+	for _, exit := range exits {
+		exitRoom, ok := exitRoomByID[exit.ToRID]
+		if !ok {
 			continue
+		}
+		var exitNode Node
+		if p.Depth >= maxDepth {
+			exitNode = TerminalNode(&exitRoom)
 		} else {
-			exitRoom, ok := exitRoomByID[ExitID(p.Room, dir)]
-			if !ok {
-				continue
-			}
-			if p.Depth >= maxDepth {
-				node := TerminalNode(&exitRoom)
-				nodesByDirection[dir] = node
-			} else {
-				node, err := BuildGraph(BuildGraphParams{
-					Queries:  p.Queries,
-					Room:     &exitRoom,
-					MaxDepth: maxDepth,
-					Depth:    p.Depth + 1,
-				})
-				if err != nil {
-					return EmptyGraphNode(), err
-				}
-				nodesByDirection[dir] = node
+			exitNode, err = BuildGraph(BuildGraphParams{
+				Queries:  p.Queries,
+				Room:     &exitRoom,
+				MaxDepth: maxDepth,
+				Depth:    p.Depth + 1,
+			})
+			if err != nil {
+				return EmptyGraphNode(), err
 			}
 		}
+		node.Exits[exit.Direction] = NodeExit{
+			Node:   &exitNode,
+			Door:   exit.Door,
+			Hidden: exit.Hidden,
+			Locked: exit.Locked,
+		}
 	}
 
-	northNode := nodesByDirection[DirectionNorth]
-	northeastNode := nodesByDirection[DirectionNortheast]
-	eastNode := nodesByDirection[DirectionEast]
-	southeastNode := nodesByDirection[DirectionSoutheast]
-	southNode := nodesByDirection[DirectionSouth]
-	southwestNode := nodesByDirection[DirectionSouthwest]
-	westNode := nodesByDirection[DirectionWest]
-	northwestNode := nodesByDirection[DirectionNorthwest]
-	return Node{
-		ID:          p.Room.ID,
-		Title:       p.Room.Title,
-		Description: p.Room.Description,
-		North:       &northNode,
-		Northeast:   &northeastNode,
-		East:        &eastNode,
-		Southeast:   &southeastNode,
-		South:       &southNode,
-		Southwest:   &southwestNode,
-		West:        &westNode,
-		Northwest:   &northwestNode,
-	}, nil
+	return node, nil
 }
 
 func (n *Node) IsExitTwoWay(en *Node, dir string) bool {
-	if !IsDirectionValid(dir) {
+	if !IsExitValid(dir) {
 		return false
 	}
 
@@ -293,8 +401,14 @@ func (n *Node) IsExitTwoWay(en *Node, dir string) bool {
 		return false
 	}
 
+	// A keyword has no opposite, so any exit back counts
 	opposite := DirectionOpposite(dir)
 	if len(opposite) == 0 {
+		for _, exitDir := range en.ExitDirections() {
+			if en.ExitID(exitDir) == n.ID {
+				return true
+			}
+		}
 		return false
 	}
 
@@ -308,21 +422,25 @@ func (n *Node) IsExitTwoWay(en *Node, dir string) bool {
 
 func (n *Node) BuildExitRooms() map[string]*Node {
 	exitRooms := map[string]*Node{}
-	for _, dir := range DirectionsList {
-		if n.IsExitEmpty(dir) {
-			emptyNode := EmptyGraphNode()
-			exitRooms[dir] = &emptyNode
-		} else {
-			exitRooms[dir] = n.Exit(dir)
-		}
+	for _, dir := range n.bindDirections() {
+		exitRooms[dir] = n.Exit(dir)
 	}
 	return exitRooms
 }
 
+// bindDirections lists every standard direction, whether there's an exit that way or not,
+// followed by the keyword exits
+func (n *Node) bindDirections() []string {
+	dirs := []string{}
+	dirs = append(dirs, StandardDirectionsList...)
+	dirs = append(dirs, n.KeywordExitDirections()...)
+	return dirs
+}
+
 func (n *Node) BindExits() []fiber.Map {
 	exits := []fiber.Map{}
 
-	for _, dir := range DirectionsList {
+	for _, dir := range n.bindDirections() {
 		if n.IsExitEmpty(dir) {
 			exits = append(exits, n.BindEmptyExit(dir))
 		} else {
@@ -346,14 +464,17 @@ func (n *Node) BindEmptyExit(dir string) fiber.Map {
 		"RoomsPath":       route.Rooms,
 		"RoomExitsPath":   route.RoomExitsPath(n.ID),
 		"RoomExitPath":    route.RoomExitPath(n.ID, dir),
+		"Keyword":         IsExitKeywordValid(dir),
 		"CreateDialog": fiber.Map{
 			"Exit":          dir,
+			"Keyword":       IsExitKeywordValid(dir),
 			"RoomID":        n.ID,
 			"RoomsPath":     route.Rooms,
 			"EditElementID": ExitEditElementID(dir),
 		},
 		"LinkDialog": fiber.Map{
 			"Exit":          dir,
+			"Keyword":       IsExitKeywordValid(dir),
 			"RoomExitsPath": route.RoomExitsPath(n.ID),
 			"EditElementID": ExitEditElementID(dir),
 		},
@@ -372,39 +493,32 @@ func (n *Node) BindExit(dir string) fiber.Map {
 	exit["ExitPath"] = route.RoomPath(en.ID)
 	exit["ExitEditPath"] = route.EditRoomPath(en.ID)
 	exit["TwoWay"] = n.IsExitTwoWay(en, dir)
+	exit["Door"] = n.Exits[dir].Door
+	exit["Hidden"] = n.Exits[dir].Hidden
+	exit["Locked"] = n.Exits[dir].Locked
+	exit["RoomExitFlagsPath"] = route.RoomExitFlagsPath(n.ID, dir)
 	return exit
 }
 
+// BindNewExit binds the form for adding an exit named with a keyword
+func (n *Node) BindNewExit() fiber.Map {
+	return fiber.Map{
+		"RoomID":        n.ID,
+		"RoomExitsPath": route.RoomExitsPath(n.ID),
+		"ExitLetter":    NewExitElementKey,
+		"EditElementID": ExitEditElementID(NewExitElementKey),
+	}
+}
+
 func TerminalNode(room *query.Room) Node {
-	northNode := EmptyGraphNode()
-	northeastNode := EmptyGraphNode()
-	eastNode := EmptyGraphNode()
-	southeastNode := EmptyGraphNode()
-	southNode := EmptyGraphNode()
-	southwestNode := EmptyGraphNode()
-	westNode := EmptyGraphNode()
-	northwestNode := EmptyGraphNode()
 	return Node{
 		ID:          room.ID,
 		Title:       room.Title,
 		Description: room.Description,
-		North:       &northNode,
-		Northeast:   &northeastNode,
-		East:        &eastNode,
-		Southeast:   &southeastNode,
-		South:       &southNode,
-		Southwest:   &southwestNode,
-		West:        &westNode,
-		Northwest:   &northwestNode,
-	}
-}
-
-func EmptyNodesByDirection() map[string]Node {
-	nodesByDir := map[string]Node{}
-	for _, dir := range DirectionsList {
-		nodesByDir[dir] = EmptyGraphNode()
+		Coordinates: RoomCoordinates(room),
+		Placed:      room.Placed,
+		Exits:       map[string]NodeExit{},
 	}
-	return nodesByDir
 }
 
 func EmptyGraphNode() Node {
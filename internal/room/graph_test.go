@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/require"
 
 	"petrichormud.com/app/internal/service"
@@ -236,3 +237,108 @@ func TestIsValidMatrixCoordinate(t *testing.T) {
 	require.False(t, IsValidMatrixCoordinate(matrix, 5, 4))
 	require.False(t, IsValidMatrixCoordinate(matrix, 4, 5))
 }
+
+func TestNodeBindExitsKeyword(t *testing.T) {
+	tent := Node{ID: 2, Exits: map[string]NodeExit{}}
+	node := Node{
+		ID: 1,
+		Exits: map[string]NodeExit{
+			"tent": {Node: &tent, Door: true},
+		},
+	}
+
+	exits := node.BindExits()
+	require.Equal(t, len(StandardDirectionsList)+1, len(exits))
+	last := exits[len(exits)-1]
+	require.Equal(t, "tent", last["Exit"])
+	require.Equal(t, int64(2), last["ID"])
+	require.Equal(t, true, last["Door"])
+	require.Equal(t, true, last["Keyword"])
+}
+
+func TestMatrixLevelForDirection(t *testing.T) {
+	require.Equal(t, 1, MatrixLevelForDirection(DirectionUp, 0))
+	require.Equal(t, -1, MatrixLevelForDirection(DirectionDown, 0))
+	for _, dir := range DirectionsList {
+		require.Equal(t, 0, MatrixLevelForDirection(dir, 0))
+	}
+}
+
+func TestNodeBindGridLevels(t *testing.T) {
+	attic := Node{ID: 3, Exits: map[string]NodeExit{}}
+	hall := Node{
+		ID: 2,
+		Exits: map[string]NodeExit{
+			DirectionUp: {Node: &attic},
+		},
+	}
+	cellar := Node{ID: 4, Exits: map[string]NodeExit{}}
+	node := Node{
+		ID: 1,
+		Exits: map[string]NodeExit{
+			DirectionNorth: {Node: &hall},
+			DirectionDown:  {Node: &cellar},
+		},
+	}
+
+	lowest, highest := node.Levels()
+	require.Equal(t, -1, lowest)
+	require.Equal(t, 1, highest)
+
+	b := node.BindGrid(fiber.Map{}, 0)
+	grid := b["RoomGrid"].([][]fiber.Map)
+	require.Equal(t, int64(1), grid[2][2]["ID"])
+	require.Equal(t, true, grid[2][2]["Vertical"])
+	require.Equal(t, int64(2), grid[1][2]["ID"])
+	require.Equal(t, true, grid[1][2]["Vertical"])
+	levels := b["RoomGridLevels"].([]fiber.Map)
+	require.Equal(t, 3, len(levels))
+	require.Equal(t, "+1", levels[0]["Label"])
+	require.Equal(t, true, levels[1]["Active"])
+
+	b = node.BindGrid(fiber.Map{}, 1)
+	grid = b["RoomGrid"].([][]fiber.Map)
+	require.Equal(t, int64(3), grid[1][2]["ID"])
+	require.NotEqual(t, int64(1), grid[2][2]["ID"])
+
+	b = node.BindGrid(fiber.Map{}, -1)
+	grid = b["RoomGrid"].([][]fiber.Map)
+	require.Equal(t, int64(4), grid[2][2]["ID"])
+}
+
+func TestLevelLabel(t *testing.T) {
+	require.Equal(t, "+2", LevelLabel(2))
+	require.Equal(t, "0", LevelLabel(0))
+	require.Equal(t, "-1", LevelLabel(-1))
+}
+
+func TestNodeBindGridByCoordinates(t *testing.T) {
+	// The north exit leads to a room mapped northeast, so the grid follows the map
+	hall := Node{ID: 2, Coordinates: Coordinates{X: 11, Y: 21}, Placed: true, Exits: map[string]NodeExit{}}
+	shed := Node{ID: 3, Exits: map[string]NodeExit{}}
+	attic := Node{ID: 4, Coordinates: Coordinates{X: 10, Y: 20, Z: 2}, Placed: true, Exits: map[string]NodeExit{}}
+	node := Node{
+		ID:          1,
+		Coordinates: Coordinates{X: 10, Y: 20},
+		Placed:      true,
+		Exits: map[string]NodeExit{
+			DirectionNorth: {Node: &hall},
+			DirectionWest:  {Node: &shed},
+			DirectionUp:    {Node: &attic},
+		},
+	}
+
+	lowest, highest := node.Levels()
+	require.Equal(t, 0, lowest)
+	require.Equal(t, 2, highest)
+
+	grid := node.BindGrid(fiber.Map{}, 0)["RoomGrid"].([][]fiber.Map)
+	require.Equal(t, int64(1), grid[2][2]["ID"])
+	require.Equal(t, int64(2), grid[1][3]["ID"])
+	require.Equal(t, int64(0), grid[1][2]["ID"])
+	// Unplaced rooms still go where their exits lead
+	require.Equal(t, int64(3), grid[2][1]["ID"])
+
+	grid = node.BindGrid(fiber.Map{}, 2)["RoomGrid"].([][]fiber.Map)
+	require.Equal(t, int64(4), grid[2][2]["ID"])
+}
@@ -10,25 +10,37 @@ import (
 )
 
 const (
-	errInvalidDirection string = "invalid direction"
-	errLinkSelf         string = "cannot link a room to itself"
+	errInvalidDirection  string = "invalid direction"
+	errLinkSelf          string = "cannot link a room to itself"
+	errNoReturnDirection string = "a two-way keyword exit needs a return direction"
+	errLockWithoutDoor   string = "only an exit with a door can be locked"
 )
 
 var (
-	ErrInvalidDirection error = errors.New(errInvalidDirection)
-	ErrLinkSelf         error = errors.New(errLinkSelf)
+	ErrInvalidDirection  error = errors.New(errInvalidDirection)
+	ErrLinkSelf          error = errors.New(errLinkSelf)
+	ErrNoReturnDirection error = errors.New(errNoReturnDirection)
+	ErrLockWithoutDoor   error = errors.New(errLockWithoutDoor)
 )
 
 type LinkParams struct {
 	Queries   *query.Queries
 	Direction string
-	To        int64
-	ID        int64
-	TwoWay    bool
+	// Return names the exit back for a two-way link. It defaults to the opposite
+	// direction, so it's only needed for keywords.
+	Return string
+	To     int64
+	ID     int64
+	TwoWay bool
+	Door   bool
+	Hidden bool
+	Locked bool
 }
 
+// Link points a room's exit at another room and places the rooms on the map. Relinking an exit keeps its flags.
+// A two-way link shares the door and lock with the exit back, but not whether it's hidden.
 func Link(in LinkParams) error {
-	if !IsDirectionValid(in.Direction) {
+	if !IsExitValid(in.Direction) {
 		return ErrInvalidDirection
 	}
 
@@ -36,65 +48,41 @@ func Link(in LinkParams) error {
 		return ErrLinkSelf
 	}
 
-	switch in.Direction {
-	case DirectionNorth:
-		if err := in.Queries.UpdateRoomExitNorth(context.Background(), query.UpdateRoomExitNorthParams{
-			ID:    in.ID,
-			North: in.To,
-		}); err != nil {
-			return err
-		}
-	case DirectionNortheast:
-		if err := in.Queries.UpdateRoomExitNortheast(context.Background(), query.UpdateRoomExitNortheastParams{
-			ID:        in.ID,
-			Northeast: in.To,
-		}); err != nil {
-			return err
-		}
-	case DirectionEast:
-		if err := in.Queries.UpdateRoomExitEast(context.Background(), query.UpdateRoomExitEastParams{
-			ID:   in.ID,
-			East: in.To,
-		}); err != nil {
-			return err
-		}
-	case DirectionSoutheast:
-		if err := in.Queries.UpdateRoomExitSoutheast(context.Background(), query.UpdateRoomExitSoutheastParams{
-			ID:        in.ID,
-			Southeast: in.To,
-		}); err != nil {
-			return err
-		}
-	case DirectionSouth:
-		if err := in.Queries.UpdateRoomExitSouth(context.Background(), query.UpdateRoomExitSouthParams{
-			ID:    in.ID,
-			South: in.To,
-		}); err != nil {
-			return err
-		}
-	case DirectionSouthwest:
-		if err := in.Queries.UpdateRoomExitSouthwest(context.Background(), query.UpdateRoomExitSouthwestParams{
-			ID:        in.ID,
-			Southwest: in.To,
-		}); err != nil {
-			return err
+	if in.Locked && !in.Door {
+		return ErrLockWithoutDoor
+	}
+
+	ret := in.Return
+	if in.TwoWay {
+		if len(ret) == 0 {
+			ret = DirectionOpposite(in.Direction)
 		}
-	case DirectionWest:
-		if err := in.Queries.UpdateRoomExitWest(context.Background(), query.UpdateRoomExitWestParams{
-			ID:   in.ID,
-			West: in.To,
-		}); err != nil {
-			return err
+		if len(ret) == 0 {
+			return ErrNoReturnDirection
 		}
-	case DirectionNorthwest:
-		if err := in.Queries.UpdateRoomExitNorthwest(context.Background(), query.UpdateRoomExitNorthwestParams{
-			ID:        in.ID,
-			Northwest: in.To,
-		}); err != nil {
-			return err
+		if !IsExitValid(ret) {
+			return ErrInvalidDirection
 		}
-	default:
-		return ErrInvalidDirection
+	}
+
+	if err := in.Queries.SetRoomExit(context.Background(), query.SetRoomExitParams{
+		RID:       in.ID,
+		Direction: in.Direction,
+		ToRID:     in.To,
+		Door:      in.Door,
+		Hidden:    in.Hidden,
+		Locked:    in.Locked,
+	}); err != nil {
+		return err
+	}
+
+	if err := Place(PlaceParams{
+		Queries:   in.Queries,
+		Direction: in.Direction,
+		ID:        in.ID,
+		To:        in.To,
+	}); err != nil {
+		return err
 	}
 
 	if in.TwoWay {
@@ -103,7 +91,9 @@ func Link(in LinkParams) error {
 			ID:        in.To,
 			To:        in.ID,
 			TwoWay:    false,
-			Direction: DirectionOpposite(in.Direction),
+			Direction: ret,
+			Door:      in.Door,
+			Locked:    in.Locked,
 		}); err != nil {
 			return err
 		}
@@ -119,70 +109,39 @@ type UnlinkParams struct {
 }
 
 func Unlink(in UnlinkParams) error {
-	if !IsDirectionValid(in.Direction) {
+	if !IsExitValid(in.Direction) {
 		return ErrInvalidDirection
 	}
 
-	switch in.Direction {
-	case DirectionNorth:
-		if err := in.Queries.UpdateRoomExitNorth(context.Background(), query.UpdateRoomExitNorthParams{
-			ID:    in.ID,
-			North: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionNortheast:
-		if err := in.Queries.UpdateRoomExitNortheast(context.Background(), query.UpdateRoomExitNortheastParams{
-			ID:        in.ID,
-			Northeast: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionEast:
-		if err := in.Queries.UpdateRoomExitEast(context.Background(), query.UpdateRoomExitEastParams{
-			ID:   in.ID,
-			East: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionSoutheast:
-		if err := in.Queries.UpdateRoomExitSoutheast(context.Background(), query.UpdateRoomExitSoutheastParams{
-			ID:        in.ID,
-			Southeast: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionSouth:
-		if err := in.Queries.UpdateRoomExitSouth(context.Background(), query.UpdateRoomExitSouthParams{
-			ID:    in.ID,
-			South: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionSouthwest:
-		if err := in.Queries.UpdateRoomExitSouthwest(context.Background(), query.UpdateRoomExitSouthwestParams{
-			ID:        in.ID,
-			Southwest: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionWest:
-		if err := in.Queries.UpdateRoomExitWest(context.Background(), query.UpdateRoomExitWestParams{
-			ID:   in.ID,
-			West: 0,
-		}); err != nil {
-			return err
-		}
-	case DirectionNorthwest:
-		if err := in.Queries.UpdateRoomExitNorthwest(context.Background(), query.UpdateRoomExitNorthwestParams{
-			ID:        in.ID,
-			Northwest: 0,
-		}); err != nil {
-			return err
-		}
-	default:
+	return in.Queries.DeleteRoomExit(context.Background(), query.DeleteRoomExitParams{
+		RID:       in.ID,
+		Direction: in.Direction,
+	})
+}
+
+type UpdateExitFlagsParams struct {
+	Queries   *query.Queries
+	Direction string
+	ID        int64
+	Door      bool
+	Hidden    bool
+	Locked    bool
+}
+
+func UpdateExitFlags(in UpdateExitFlagsParams) error {
+	if !IsExitValid(in.Direction) {
 		return ErrInvalidDirection
 	}
 
-	return nil
+	if in.Locked && !in.Door {
+		return ErrLockWithoutDoor
+	}
+
+	return in.Queries.UpdateRoomExitFlags(context.Background(), query.UpdateRoomExitFlagsParams{
+		Door:      in.Door,
+		Hidden:    in.Hidden,
+		Locked:    in.Locked,
+		RID:       in.ID,
+		Direction: in.Direction,
+	})
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/service"
 	"petrichormud.com/app/internal/test"
 )
@@ -19,7 +20,7 @@ func TestLinkTwoWay(t *testing.T) {
 	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
 	defer test.DeleteTestRoom(t, &i, ridTwo)
 
-	for _, dir := range DirectionsList {
+	for _, dir := range StandardDirectionsList {
 		tx, err := i.Database.Begin()
 		if err != nil {
 			t.Fatal(err)
@@ -35,12 +36,12 @@ func TestLinkTwoWay(t *testing.T) {
 			TwoWay:    true,
 		})
 
-		rmOne, err := qtx.GetRoom(context.Background(), ridOne)
+		exitsOne, err := qtx.ListRoomExits(context.Background(), ridOne)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		rmTwo, err := qtx.GetRoom(context.Background(), ridTwo)
+		exitsTwo, err := qtx.ListRoomExits(context.Background(), ridTwo)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -49,8 +50,8 @@ func TestLinkTwoWay(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		require.Equal(t, ridTwo, ExitID(&rmOne, dir), ridTwo)
-		require.Equal(t, ridOne, ExitID(&rmTwo, DirectionOpposite(dir)))
+		require.Equal(t, ridTwo, ExitID(exitsOne, dir), ridTwo)
+		require.Equal(t, ridOne, ExitID(exitsTwo, DirectionOpposite(dir)))
 	}
 }
 
@@ -63,7 +64,7 @@ func TestLinkOneWay(t *testing.T) {
 	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
 	defer test.DeleteTestRoom(t, &i, ridTwo)
 
-	for _, dir := range DirectionsList {
+	for _, dir := range StandardDirectionsList {
 		tx, err := i.Database.Begin()
 		if err != nil {
 			t.Fatal(err)
@@ -79,12 +80,12 @@ func TestLinkOneWay(t *testing.T) {
 			TwoWay:    false,
 		})
 
-		rmOne, err := qtx.GetRoom(context.Background(), ridOne)
+		exitsOne, err := qtx.ListRoomExits(context.Background(), ridOne)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		rmTwo, err := qtx.GetRoom(context.Background(), ridTwo)
+		exitsTwo, err := qtx.ListRoomExits(context.Background(), ridTwo)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -93,8 +94,8 @@ func TestLinkOneWay(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		require.Equal(t, ridTwo, ExitID(&rmOne, dir), ridTwo)
-		require.Equal(t, int64(0), ExitID(&rmTwo, DirectionOpposite(dir)))
+		require.Equal(t, ridTwo, ExitID(exitsOne, dir), ridTwo)
+		require.Equal(t, int64(0), ExitID(exitsTwo, DirectionOpposite(dir)))
 	}
 }
 
@@ -107,7 +108,7 @@ func TestUnlink(t *testing.T) {
 	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
 	defer test.DeleteTestRoom(t, &i, ridTwo)
 
-	for _, dir := range DirectionsList {
+	for _, dir := range StandardDirectionsList {
 		tx, err := i.Database.Begin()
 		if err != nil {
 			t.Fatal(err)
@@ -129,12 +130,12 @@ func TestUnlink(t *testing.T) {
 			Direction: dir,
 		})
 
-		rmOne, err := qtx.GetRoom(context.Background(), ridOne)
+		exitsOne, err := qtx.ListRoomExits(context.Background(), ridOne)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		rmTwo, err := qtx.GetRoom(context.Background(), ridTwo)
+		exitsTwo, err := qtx.ListRoomExits(context.Background(), ridTwo)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -143,7 +144,189 @@ func TestUnlink(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		require.Equal(t, int64(0), ExitID(&rmOne, dir))
-		require.Equal(t, ridOne, ExitID(&rmTwo, DirectionOpposite(dir)))
+		require.Equal(t, int64(0), ExitID(exitsOne, dir))
+		require.Equal(t, ridOne, ExitID(exitsTwo, DirectionOpposite(dir)))
 	}
 }
+
+func TestLinkKeyword(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	ridOne := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridOne)
+	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridTwo)
+
+	if err := Link(LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: "tent",
+		Return:    DirectionOut,
+		TwoWay:    true,
+		Door:      true,
+		Hidden:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	exitOne, err := i.Queries.GetRoomExit(context.Background(), query.GetRoomExitParams{
+		RID:       ridOne,
+		Direction: "tent",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, ridTwo, exitOne.ToRID)
+	require.True(t, exitOne.Door)
+	require.True(t, exitOne.Hidden)
+
+	exitTwo, err := i.Queries.GetRoomExit(context.Background(), query.GetRoomExitParams{
+		RID:       ridTwo,
+		Direction: DirectionOut,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, ridOne, exitTwo.ToRID)
+	require.True(t, exitTwo.Door)
+	require.False(t, exitTwo.Hidden)
+}
+
+func TestLinkKeywordTwoWayNoReturn(t *testing.T) {
+	err := Link(LinkParams{
+		ID:        1,
+		To:        2,
+		Direction: "tent",
+		TwoWay:    true,
+	})
+	require.Equal(t, ErrNoReturnDirection, err)
+}
+
+func TestLinkInvalidDirection(t *testing.T) {
+	err := Link(LinkParams{
+		ID:        1,
+		To:        2,
+		Direction: "the tent",
+	})
+	require.Equal(t, ErrInvalidDirection, err)
+}
+
+func TestLinkLockWithoutDoor(t *testing.T) {
+	err := Link(LinkParams{
+		ID:        1,
+		To:        2,
+		Direction: DirectionNorth,
+		Locked:    true,
+	})
+	require.Equal(t, ErrLockWithoutDoor, err)
+}
+
+func TestUpdateExitFlags(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	ridOne := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridOne)
+	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridTwo)
+
+	if err := Link(LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: DirectionUp,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := UpdateExitFlags(UpdateExitFlagsParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		Direction: DirectionUp,
+		Door:      true,
+		Locked:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	exit, err := i.Queries.GetRoomExit(context.Background(), query.GetRoomExitParams{
+		RID:       ridOne,
+		Direction: DirectionUp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, exit.Door)
+	require.True(t, exit.Locked)
+	require.False(t, exit.Hidden)
+}
+
+func TestLinkPlacesRooms(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	ridOne := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridOne)
+	ridTwo := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridTwo)
+	ridThree := test.CreateTestRoom(t, &i, test.TestRoom)
+	defer test.DeleteTestRoom(t, &i, ridThree)
+
+	// Two and three are linked before either is on the map
+	if err := Link(LinkParams{
+		Queries:   i.Queries,
+		ID:        ridThree,
+		To:        ridTwo,
+		Direction: DirectionDown,
+		TwoWay:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rmTwo, err := i.Queries.GetRoom(context.Background(), ridTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, rmTwo.Placed)
+
+	origin := Coordinates{X: 100, Y: 100}
+	if err := placeRoom(i.Queries, ridOne, origin); err != nil {
+		t.Fatal(err)
+	}
+	if err := Link(LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: DirectionNorth,
+		TwoWay:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	rmOne, err := i.Queries.GetRoom(context.Background(), ridOne)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rmTwo, err = i.Queries.GetRoom(context.Background(), ridTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rmThree, err := i.Queries.GetRoom(context.Background(), ridThree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.True(t, rmOne.Placed)
+	require.True(t, rmTwo.Placed)
+	require.True(t, rmThree.Placed)
+	require.Equal(t, origin, RoomCoordinates(&rmOne))
+	require.Equal(t, origin.Add(Coordinates{Y: 1}), RoomCoordinates(&rmTwo))
+	require.Equal(t, origin.Add(Coordinates{Y: 1, Z: 1}), RoomCoordinates(&rmThree))
+
+	issues, err := ValidateGeometry(ValidateGeometryParams{Queries: i.Queries, ID: ridOne})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, issues)
+}
@@ -1,6 +1,44 @@
 package room
 
-import "regexp"
+import (
+	"regexp"
+
+	"petrichormud.com/app/internal/validate"
+)
+
+const (
+	TitleMinLen       int    = 2
+	TitleMaxLen       int    = 150
+	TitleRegex        string = "[^a-zA-Z, -]+"
+	DescriptionMinLen int    = 50
+	DescriptionMaxLen int    = 2000
+	DescriptionRegex  string = "[^a-zA-Z,'. -]+"
+	SizeRegex         string = "^[0-4]$"
+	ExitKeywordMinLen int    = 2
+	ExitKeywordMaxLen int    = 24
+	ExitKeywordRegex  string = "^[a-z]+(-[a-z]+)*$"
+)
+
+var (
+	TitleLengthValidator validate.StringLengthValidator       = validate.NewStringLengthValidator(TitleMinLen, TitleMaxLen)
+	TitleRegexValidator  validate.StringRegexNoMatchValidator = validate.NewStringRegexNoMatchValidator(regexp.MustCompile(TitleRegex))
+	TitleValidator       validate.StringValidatorGroup        = validate.NewStringValidatorGroup([]validate.StringValidator{&TitleLengthValidator, &TitleRegexValidator})
+)
+
+var (
+	DescriptionLengthValidator validate.StringLengthValidator       = validate.NewStringLengthValidator(DescriptionMinLen, DescriptionMaxLen)
+	DescriptionRegexValidator  validate.StringRegexNoMatchValidator = validate.NewStringRegexNoMatchValidator(regexp.MustCompile(DescriptionRegex))
+	DescriptionValidator       validate.StringValidatorGroup        = validate.NewStringValidatorGroup([]validate.StringValidator{&DescriptionLengthValidator, &DescriptionRegexValidator})
+)
+
+var (
+	ExitKeywordLengthValidator validate.StringLengthValidator     = validate.NewStringLengthValidator(ExitKeywordMinLen, ExitKeywordMaxLen)
+	ExitKeywordRegexValidator  validate.StringRegexMatchValidator = validate.NewStringRegexMatchValidator(regexp.MustCompile(ExitKeywordRegex))
+	ExitKeywordValidator       validate.StringValidatorGroup      = validate.NewStringValidatorGroup([]validate.StringValidator{&ExitKeywordLengthValidator, &ExitKeywordRegexValidator})
+)
+
+// SizeValidator validates a size sent as a string, i.e. from a request field
+var SizeValidator validate.StringRegexMatchValidator = validate.NewStringRegexMatchValidator(regexp.MustCompile(SizeRegex))
 
 // TODO: Get these lengths in constant
 // Also, precompile these regular expressions
@@ -20,29 +58,20 @@ func IsImageNameValid(name string) bool {
 }
 
 func IsTitleValid(title string) bool {
-	if len(title) < 2 {
-		return false
-	}
-
-	if len(title) > 150 {
-		return false
-	}
-
-	re := regexp.MustCompile("[^a-zA-Z, -]+")
-	return !re.MatchString(title)
+	return TitleValidator.IsValid(title)
 }
 
 func IsDescriptionValid(description string) bool {
-	if len(description) < 50 {
-		return false
-	}
+	return DescriptionValidator.IsValid(description)
+}
 
-	if len(description) > 2000 {
+// IsExitKeywordValid reports whether an exit can be named with a keyword, i.e. "tent" or
+// "rope-ladder". The standard directions aren't keywords.
+func IsExitKeywordValid(keyword string) bool {
+	if IsDirectionValid(keyword) {
 		return false
 	}
-
-	re := regexp.MustCompile("[^a-zA-Z,'. -]+")
-	return !re.MatchString(description)
+	return ExitKeywordValidator.IsValid(keyword)
 }
 
 func IsSizeValid(size int32) bool {
@@ -11,3 +11,15 @@ func TestIsDescriptionValid(t *testing.T) {
 
 	require.True(t, IsDescriptionValid(validDescription))
 }
+
+func TestIsTitleValid(t *testing.T) {
+	require.True(t, IsTitleValid("An elegant, wood-paneled office"))
+	require.False(t, IsTitleValid("A"))
+}
+
+func TestSizeValidator(t *testing.T) {
+	require.True(t, SizeValidator.IsValid("0"))
+	require.True(t, SizeValidator.IsValid("4"))
+	require.False(t, SizeValidator.IsValid("5"))
+	require.False(t, SizeValidator.IsValid("Medium"))
+}
@@ -1,6 +1,39 @@
 package route
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
-	Characters            = "/characters"
-	CharacterApplications = "/characters/applications"
+	Characters                     = "/characters"
+	CharacterApplications          = "/characters/applications"
+	CharacterNameReservedPathParam = "/characters/applications/:id/name/reserved"
+	CharacterRetirePathParam       = "/characters/:id/retire"
+	CharacterReactivatePathParam   = "/characters/:id/reactivate"
+	CharacterCurrentPathParam      = "/characters/:id/current"
 )
+
+func CharacterNameReservedPath(rid int64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%d/name/reserved", CharacterApplications, rid)
+	return sb.String()
+}
+
+func CharacterRetirePath(aiid int64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%d/retire", Characters, aiid)
+	return sb.String()
+}
+
+func CharacterReactivatePath(aiid int64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%d/reactivate", Characters, aiid)
+	return sb.String()
+}
+
+func CharacterCurrentPath(aiid int64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%d/current", Characters, aiid)
+	return sb.String()
+}
@@ -19,6 +19,10 @@ func ResendEmailVerificationPath(id string) string {
 	return fmt.Sprintf("%s/resend", EmailPath(id))
 }
 
+func PrimaryEmailPath(id string) string {
+	return fmt.Sprintf("%s/primary", EmailPath(id))
+}
+
 func VerifyEmailWithToken(t string) string {
 	return fmt.Sprintf("%s?t=%s", VerifyEmail, t)
 }
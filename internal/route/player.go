@@ -2,26 +2,35 @@ package route
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
 const (
-	Players                string = "/players"
-	PlayerPermissions      string = "/players/permissions"
-	PlayerPasswordParam    string = "/players/:id/password"
-	Login                  string = "/login"
-	Logout                 string = "/logout"
-	Register               string = "/player/new"
-	Reserved               string = "/player/reserved"
-	Profile                string = "/profile"
-	Recover                string = "/recover"
-	RecoverUsername        string = "/recover/username"
-	RecoverUsernameSuccess string = "/recover/username/success"
-	RecoverPassword        string = "/recover/password"
-	RecoverPasswordSuccess string = "/recover/password/success"
-	ResetPassword          string = "/reset/password"
-	ResetPasswordSuccess   string = "/reset/password/success"
-	SearchPlayer           string = "/player/search"
+	Players                 string = "/players"
+	PlayerPermissions       string = "/players/permissions"
+	PlayerHistory           string = "/players/history"
+	PlayerTwoFactor         string = "/players/two-factor"
+	PlayerPasswordParam     string = "/players/:id/password"
+	Login                   string = "/login"
+	LoginTwoFactor          string = "/login/two-factor"
+	Logout                  string = "/logout"
+	Register                string = "/player/new"
+	Reserved                string = "/player/reserved"
+	Profile                 string = "/profile"
+	ProfileSessions         string = "/profile/sessions"
+	ProfileSessionParam     string = "/profile/sessions/:id"
+	ProfileTwoFactor        string = "/profile/two-factor"
+	ProfileTwoFactorEnable  string = "/profile/two-factor/enable"
+	ProfileTwoFactorDisable string = "/profile/two-factor/disable"
+	Recover                 string = "/recover"
+	RecoverUsername         string = "/recover/username"
+	RecoverUsernameSuccess  string = "/recover/username/success"
+	RecoverPassword         string = "/recover/password"
+	RecoverPasswordSuccess  string = "/recover/password/success"
+	ResetPassword           string = "/reset/password"
+	ResetPasswordSuccess    string = "/reset/password/success"
+	SearchPlayer            string = "/player/search"
 )
 
 func SearchPlayerPath(dest string) string {
@@ -49,3 +58,20 @@ func PlayerPermissionsPath(id string) string {
 func PlayerPermissionsTogglePath(id, tag string) string {
 	return fmt.Sprintf("%s/%s/%s", PlayerPermissions, id, tag)
 }
+
+func PlayerRolesTogglePath(id, tag string) string {
+	return fmt.Sprintf("%s/%s/roles/%s", PlayerPermissions, id, tag)
+}
+
+func PlayerHistoryExportPath(format string, filters url.Values) string {
+	params := url.Values{}
+	for key, values := range filters {
+		params[key] = values
+	}
+	params.Set("format", format)
+	return fmt.Sprintf("%s?%s", PlayerHistory, params.Encode())
+}
+
+func ProfileSessionPath(handle string) string {
+	return fmt.Sprintf("%s/%s", ProfileSessions, handle)
+}
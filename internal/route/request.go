@@ -7,22 +7,28 @@ import (
 
 // TODO: Generate these with a param from the string blocks below
 const (
-	Requests                           = "/requests"
-	RequestPathParam                   = "/requests/:id"
-	RequestFieldPathParam              = "/requests/:rid/fields/:rfid"
-	RequestSubfieldsPathParam          = "/requests/:rid/fields/:rfid/subfields"
-	RequestSubfieldPathParam           = "/requests/:rid/fields/:rfid/subfields/:id"
-	RequestFieldTypePathParam          = "/requests/:id/:field"
-	RequestFieldStatusPathParam        = "/requests/:id/:field/status"
-	RequestChangeRequestPathParam      = "/requests/changes/:id"
-	RequestChangeRequestFieldPathParam = "/requests/:id/:field/changes"
-	RequestStatusPathParam             = "/requests/:id/status"
+	Requests                              = "/requests"
+	RequestPathParam                      = "/requests/:id"
+	RequestFieldPathParam                 = "/requests/:rid/fields/:rfid"
+	RequestSubfieldsPathParam             = "/requests/:rid/fields/:rfid/subfields"
+	RequestSubfieldPathParam              = "/requests/:rid/fields/:rfid/subfields/:id"
+	RequestFieldTypePathParam             = "/requests/:id/:field"
+	RequestFieldStatusPathParam           = "/requests/:id/:field/status"
+	RequestChangeRequestPathParam         = "/requests/changes/:id"
+	RequestChangeRequestFieldPathParam    = "/requests/:id/:field/changes"
+	RequestStatusPathParam                = "/requests/:id/status"
+	RequestRestorePathParam               = "/requests/:id/restore"
+	RequestClaimPathParam                 = "/requests/:id/claim"
+	RequestReviewerPathParam              = "/requests/:id/reviewer"
+	RequestFieldCommentsPathParam         = "/requests/:id/:field/comments"
+	RequestFieldCommentsResolvedPathParam = "/requests/:id/:field/comments/resolved"
 )
 
 const (
 	RequestFields         = "fields"
 	RequestSubfields      = "subfields"
 	RequestChangeRequests = "changes"
+	RequestFieldComments  = "comments"
 )
 
 func RequestPath(id int64) string {
@@ -72,3 +78,33 @@ func RequestStatusPath(id int64) string {
 	fmt.Fprintf(&b, "%s/%d/status", Requests, id)
 	return b.String()
 }
+
+func RequestRestorePath(id int64) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%d/restore", Requests, id)
+	return b.String()
+}
+
+func RequestClaimPath(id int64) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%d/claim", Requests, id)
+	return b.String()
+}
+
+func RequestReviewerPath(id int64) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%d/reviewer", Requests, id)
+	return b.String()
+}
+
+func RequestFieldCommentsPath(id int64, field string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%d/%s/%s", Requests, id, field, RequestFieldComments)
+	return b.String()
+}
+
+func RequestFieldCommentsResolvedPath(id int64, field string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%d/%s/%s/resolved", Requests, id, field, RequestFieldComments)
+	return b.String()
+}
@@ -7,11 +7,15 @@ import (
 
 const (
 	Rooms                    string = "/rooms"
+	RoomsReport              string = "/rooms/report"
+	RoomProposals            string = "/rooms/proposals"
+	RoomProposalsQueue       string = "/rooms/proposals/queue"
 	RoomPathParam            string = "/rooms/:id"
 	NewRoom                  string = "/rooms/new"
 	EditRoomPathParam        string = "/rooms/:id/edit"
 	RoomGridPathParam        string = "/rooms/:id/grid/:selected"
 	RoomExitPathParam        string = "/rooms/:id/:exit"
+	RoomExitFlagsPathParam   string = "/rooms/:id/:exit/flags"
 	RoomExitsPathParam       string = "/rooms/:id/exits"
 	RoomTitlePathParam       string = "/rooms/:id/title"
 	RoomDescriptionPathParam string = "/rooms/:id/description"
@@ -36,6 +40,19 @@ func RoomExitPath(id int64, exit string) string {
 	return sb.String()
 }
 
+// RoomGridLevelPath is the grid around a room, one level at a time
+func RoomGridLevelPath(id int64, level int) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%d/grid/%d?level=%d", Rooms, id, id, level)
+	return sb.String()
+}
+
+func RoomExitFlagsPath(id int64, exit string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s/%d/%s/flags", Rooms, id, exit)
+	return sb.String()
+}
+
 func RoomExitsPath(id int64) string {
 	var sb strings.Builder
 	fmt.Fprintf(&sb, "%s/%d/exits", Rooms, id)
@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fiber "github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/app"
+	"petrichormud.com/app/internal/config"
+	"petrichormud.com/app/internal/request"
+	"petrichormud.com/app/internal/route"
+	"petrichormud.com/app/internal/service"
+)
+
+func postTestCharacterAction(t *testing.T, a *fiber.App, u, path string) int {
+	sessionCookie := LoginTestPlayer(t, a, u, TestPassword)
+	req := httptest.NewRequest(http.MethodPost, MakeTestURL(path), nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode
+}
+
+func TestSwitchCurrentCharacterKeepsOneCurrent(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	aiidOne := CreateTestCharacter(t, &i, pid, "Testify")
+	defer DeleteTestCharacter(t, &i, aiidOne)
+	aiidTwo := CreateTestCharacter(t, &i, pid, "Testifier")
+	defer DeleteTestCharacter(t, &i, aiidTwo)
+
+	require.Equal(t, fiber.StatusOK, postTestCharacterAction(t, a, TestUsername, route.CharacterCurrentPath(aiidOne)))
+	require.Equal(t, fiber.StatusOK, postTestCharacterAction(t, a, TestUsername, route.CharacterCurrentPath(aiidTwo)))
+
+	count, err := i.Queries.CountCurrentActorImagePlayerPropertiesForPlayer(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, int64(1), count)
+
+	props, err := i.Queries.GetActorImagePlayerPropertiesForImage(context.Background(), aiidTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, props.Current)
+}
+
+func TestRetireAndReactivateCharacter(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	aiid := CreateTestCharacter(t, &i, pid, "Testify")
+	defer DeleteTestCharacter(t, &i, aiid)
+
+	require.Equal(t, fiber.StatusOK, postTestCharacterAction(t, a, TestUsername, route.CharacterCurrentPath(aiid)))
+	require.Equal(t, fiber.StatusOK, postTestCharacterAction(t, a, TestUsername, route.CharacterRetirePath(aiid)))
+
+	props, err := i.Queries.GetActorImagePlayerPropertiesForImage(context.Background(), aiid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, props.Retired)
+	require.False(t, props.Current)
+
+	require.Equal(t, fiber.StatusConflict, postTestCharacterAction(t, a, TestUsername, route.CharacterCurrentPath(aiid)))
+	require.Equal(t, fiber.StatusOK, postTestCharacterAction(t, a, TestUsername, route.CharacterReactivatePath(aiid)))
+	require.Equal(t, fiber.StatusConflict, postTestCharacterAction(t, a, TestUsername, route.CharacterReactivatePath(aiid)))
+}
+
+func TestSwitchCurrentCharacterForbiddenNotOwner(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	aiid := CreateTestCharacter(t, &i, pid, "Testify")
+	defer DeleteTestCharacter(t, &i, aiid)
+
+	require.Equal(t, fiber.StatusForbidden, postTestCharacterAction(t, a, TestUsernameTwo, route.CharacterCurrentPath(aiid)))
+	require.Equal(t, fiber.StatusForbidden, postTestCharacterAction(t, a, TestUsernameTwo, route.CharacterRetirePath(aiid)))
+}
+
+func TestFulfillCharacterApplicationKeepsCurrentCharacter(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	aiid := CreateTestCharacter(t, &i, pid, "Testify")
+	defer DeleteTestCharacter(t, &i, aiid)
+	require.Equal(t, fiber.StatusOK, postTestCharacterAction(t, a, TestUsername, route.CharacterCurrentPath(aiid)))
+
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestRequest(t, &i, rid)
+	SetTestCharacterApplicationName(t, &i, rid, "Testifier")
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusApproved)
+
+	tx, err := i.Database.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := request.Fulfill(context.Background(), tx, i.Queries, request.FulfillParams{
+		RID: rid,
+		PID: pid,
+	}); err != nil {
+		tx.Rollback()
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	ai, err := i.Queries.GetActorImageByName(context.Background(), fmt.Sprintf("%d-%d-%s", pid, rid, "Testifier"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DeleteTestCharacter(t, &i, ai.ID)
+
+	props, err := i.Queries.GetActorImagePlayerPropertiesForImage(context.Background(), ai.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, props.Current)
+
+	props, err = i.Queries.GetActorImagePlayerPropertiesForImage(context.Background(), aiid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, props.Current)
+}
@@ -1135,3 +1135,106 @@ func TestResendEmailVerificationSuccess(t *testing.T) {
 
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
+
+func TestSetPrimaryEmail(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	eid := CreateTestEmail(t, &i, a, TestEmailAddress, TestUsername, TestPassword)
+	eidTwo := CreateTestEmail(t, &i, a, TestEmailAddressTwo, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	setPrimary := func(id int64) int {
+		url := MakeTestURL(route.PrimaryEmailPath(strconv.FormatInt(id, 10)))
+		req := httptest.NewRequest(http.MethodPost, url, nil)
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+
+	// An unverified email can't be primary
+	require.Equal(t, fiber.StatusForbidden, setPrimary(eid))
+
+	for _, id := range []int64{eid, eidTwo} {
+		if err := i.Queries.MarkEmailVerified(context.Background(), id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := email.EnsurePrimary(context.Background(), i.Queries, pid); err != nil {
+		t.Fatal(err)
+	}
+
+	primary, err := i.Queries.GetPrimaryEmail(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, eid, primary.ID)
+
+	require.Equal(t, fiber.StatusOK, setPrimary(eidTwo))
+	require.Equal(t, fiber.StatusConflict, setPrimary(eidTwo))
+
+	primary, err = i.Queries.GetPrimaryEmail(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, eidTwo, primary.ID)
+}
+
+func TestDeletePrimaryEmailPromotesFallback(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	eid := CreateTestEmail(t, &i, a, TestEmailAddress, TestUsername, TestPassword)
+	eidTwo := CreateTestEmail(t, &i, a, TestEmailAddressTwo, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+
+	for _, id := range []int64{eid, eidTwo} {
+		if err := i.Queries.MarkEmailVerified(context.Background(), id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	primary, promoted, err := email.EnsurePrimary(context.Background(), i.Queries, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, promoted)
+	require.Equal(t, eid, primary.ID)
+
+	// With a primary already in place, there's nothing to promote
+	_, promoted, err = email.EnsurePrimary(context.Background(), i.Queries, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, promoted)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+	url := MakeTestURL(route.EmailPath(strconv.FormatInt(eid, 10)))
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	primary, err = i.Queries.GetPrimaryEmail(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, eidTwo, primary.ID)
+}
@@ -15,6 +15,7 @@ const (
 	TestEmailAddress    = "testify@test.com"
 	TestEmailAddressTwo = "testify2@test.com"
 	TestActorImageName  = "test-actor-image"
+	TestIP              = "0.0.0.0"
 )
 
 var TestRoom CreateTestRoomParams = CreateTestRoomParams{
@@ -8,11 +8,18 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
 
+	"petrichormud.com/app/internal/actor"
+	"petrichormud.com/app/internal/limit"
+	"petrichormud.com/app/internal/player/permission"
+	"petrichormud.com/app/internal/player/session"
 	"petrichormud.com/app/internal/query"
+	"petrichormud.com/app/internal/request/status"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 )
@@ -54,6 +61,8 @@ func CreateTestPlayer(t *testing.T, i *service.Interfaces, a *fiber.App, u, pw s
 }
 
 func DeleteTestPlayer(t *testing.T, i *service.Interfaces, u string) {
+	ClearTestLimits(t, i, u)
+
 	p, err := i.Queries.GetPlayerByUsername(context.Background(), u)
 	if err != nil {
 		return
@@ -73,6 +82,54 @@ func DeleteTestPlayer(t *testing.T, i *service.Interfaces, u string) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	_, err = i.Database.Exec("DELETE FROM player_roles WHERE pid = ?;", p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = i.Database.Exec("DELETE FROM player_role_change_history WHERE pid = ?;", p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = i.Database.Exec("DELETE FROM player_permission_change_history WHERE pid = ?;", p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = i.Database.Exec("DELETE FROM player_two_factor WHERE pid = ?;", p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = i.Database.Exec("DELETE FROM player_recovery_codes WHERE pid = ?;", p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := permission.Invalidate(i.Redis, p.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := i.Redis.Del(context.Background(), session.IndexKey(p.ID)).Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// ClearTestLimits drops any failed attempts and lockouts left behind for a username and the test client's IP
+func ClearTestLimits(t *testing.T, i *service.Interfaces, u string) {
+	for _, p := range []limit.Params{limit.Login, limit.Recover, limit.TwoFactor} {
+		keys := []string{
+			limit.Key(p, limit.KindIP, TestIP),
+			limit.Key(p, limit.KindAccount, u),
+		}
+		for _, key := range keys {
+			if err := limit.Clear(context.Background(), i.Redis, key); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
 }
 
 func LoginTestPlayer(t *testing.T, a *fiber.App, u string, pw string) *http.Cookie {
@@ -145,15 +202,44 @@ func CreateTestPlayerPermission(t *testing.T, i *service.Interfaces, pid int64,
 	if err != nil {
 		t.Fatal(err)
 	}
+	if err := permission.Invalidate(i.Redis, pid); err != nil {
+		t.Fatal(err)
+	}
 	return permissionID
 }
 
 func DeleteTestPlayerPermission(t *testing.T, i *service.Interfaces, id int64) {
+	var pid int64
+	if err := i.Database.QueryRow("SELECT pid FROM player_permissions WHERE id = ?;", id).Scan(&pid); err != nil {
+		if err == sql.ErrNoRows {
+			return
+		}
+		t.Fatal(err)
+	}
+
 	query := fmt.Sprintf("DELETE FROM player_permissions WHERE id = %d;", id)
 	_, err := i.Database.Exec(query)
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	if err := permission.Invalidate(i.Redis, pid); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func CreateTestPlayerRole(t *testing.T, i *service.Interfaces, pid int64, name string) {
+	_, err := i.Queries.CreatePlayerRole(context.Background(), query.CreatePlayerRoleParams{
+		PID:  pid,
+		IPID: pid,
+		Name: name,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := permission.Invalidate(i.Redis, pid); err != nil {
+		t.Fatal(err)
+	}
 }
 
 func CreateTestCharacterApplication(t *testing.T, i *service.Interfaces, a *fiber.App, u, pw string) int64 {
@@ -181,8 +267,15 @@ func CreateTestCharacterApplication(t *testing.T, i *service.Interfaces, a *fibe
 	return reqs[0].ID
 }
 
+func DeleteTestRoomByTitle(t *testing.T, i *service.Interfaces, title string) {
+	_, err := i.Database.Exec("DELETE FROM rooms WHERE title = ?;", title)
+	if err != nil && err != sql.ErrNoRows {
+		t.Fatal(err)
+	}
+}
+
 func CreateTestRequestSubfield(t *testing.T, i *service.Interfaces, rfid int64, ft, v string) int64 {
-	if err := i.Queries.CreateRequestSubfield(context.Background(), query.CreateRequestSubfieldParams{
+	if _, err := i.Queries.CreateRequestSubfield(context.Background(), query.CreateRequestSubfieldParams{
 		RFID:  rfid,
 		Value: v,
 	}); err != nil {
@@ -234,12 +327,71 @@ func DeleteTestRequest(t *testing.T, i *service.Interfaces, rid int64) {
 		if err != nil && err != sql.ErrNoRows {
 			t.Fatal(err)
 		}
+
+		_, err = i.Database.Exec("DELETE FROM request_field_comments WHERE rfid = ?;", field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			t.Fatal(err)
+		}
+
+		_, err = i.Database.Exec("DELETE FROM request_field_comment_reads WHERE rfid = ?;", field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			t.Fatal(err)
+		}
+
+		_, err = i.Database.Exec("DELETE FROM request_field_comment_resolutions WHERE rfid = ?;", field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			t.Fatal(err)
+		}
+
+		_, err = i.Database.Exec("DELETE FROM request_field_versions WHERE rfid = ?;", field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			t.Fatal(err)
+		}
+
+		_, err = i.Database.Exec("DELETE FROM request_subfield_versions WHERE rfid = ?;", field.ID)
+		if err != nil && err != sql.ErrNoRows {
+			t.Fatal(err)
+		}
 	}
 
 	_, err = i.Database.Exec("DELETE FROM request_fields WHERE rid = ?;", rid)
 	if err != nil && err != sql.ErrNoRows {
 		t.Fatal(err)
 	}
+
+	_, err = i.Database.Exec("DELETE FROM request_status_history WHERE rid = ?;", rid)
+	if err != nil && err != sql.ErrNoRows {
+		t.Fatal(err)
+	}
+
+	_, err = i.Database.Exec("DELETE FROM request_deletions WHERE rid = ?;", rid)
+	if err != nil && err != sql.ErrNoRows {
+		t.Fatal(err)
+	}
+
+	_, err = i.Database.Exec("DELETE FROM character_name_reservations WHERE rid = ?;", rid)
+	if err != nil && err != sql.ErrNoRows {
+		t.Fatal(err)
+	}
+}
+
+// TODO: Update this to use a helper that calls the app's API instead of hacking it
+func UpdateTestRequestStatus(t *testing.T, i *service.Interfaces, rid, pid int64, to string) {
+	if to == status.InReview {
+		if err := i.Queries.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+			ID:   rid,
+			RPID: pid,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := i.Queries.UpdateRequestStatus(context.Background(), query.UpdateRequestStatusParams{
+		ID:     rid,
+		Status: to,
+	}); err != nil {
+		t.Fatal(err)
+	}
 }
 
 type CreateTestRequestChangeRequestParams struct {
@@ -309,6 +461,7 @@ type CreateTestRoomParams struct {
 	Title       string
 	Description string
 	Size        int32
+	ZID         int64
 }
 
 func CreateTestRoom(t *testing.T, i *service.Interfaces, p CreateTestRoomParams) int64 {
@@ -316,6 +469,7 @@ func CreateTestRoom(t *testing.T, i *service.Interfaces, p CreateTestRoomParams)
 		Title:       p.Title,
 		Description: p.Description,
 		Size:        p.Size,
+		ZID:         p.ZID,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -330,7 +484,48 @@ func CreateTestRoom(t *testing.T, i *service.Interfaces, p CreateTestRoomParams)
 }
 
 func DeleteTestRoom(t *testing.T, i *service.Interfaces, id int64) {
-	_, err := i.Database.Exec("DELETE FROM rooms WHERE id = ?;", id)
+	_, err := i.Database.Exec("DELETE FROM room_exits WHERE rid = ? OR to_rid = ?;", id, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = i.Database.Exec("DELETE FROM rooms WHERE id = ?;", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func CreateTestZone(t *testing.T, i *service.Interfaces, name string, owner int64) int64 {
+	result, err := i.Queries.CreateZone(context.Background(), query.CreateZoneParams{
+		Name:     name,
+		OwnerPID: owner,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zid, err := result.LastInsertId()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return zid
+}
+
+func AddTestZoneBuilder(t *testing.T, i *service.Interfaces, zid, pid int64) {
+	if err := i.Queries.AddZoneBuilder(context.Background(), query.AddZoneBuilderParams{
+		ZID: zid,
+		PID: pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func DeleteTestZone(t *testing.T, i *service.Interfaces, id int64) {
+	_, err := i.Database.Exec("DELETE FROM zone_builders WHERE zid = ?;", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = i.Database.Exec("DELETE FROM zones WHERE id = ?;", id)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -375,6 +570,41 @@ func DeleteTestActorImage(t *testing.T, i *service.Interfaces, aiid int64) {
 	}
 }
 
+func CreateTestCharacter(t *testing.T, i *service.Interfaces, pid int64, name string) int64 {
+	aiid := CreateTestActorImage(t, i, CreateTestActorImageParams{
+		Gender:           actor.GenderNonBinary,
+		Name:             fmt.Sprintf("%d-%s", pid, strings.ToLower(name)),
+		ShortDescription: actor.DefaultImageShortDescription,
+		Description:      actor.DefaultImageDescription,
+	})
+	if err := i.Queries.CreateActorImageCharacterMetadata(context.Background(), query.CreateActorImageCharacterMetadataParams{
+		AIID:  aiid,
+		Key:   "name",
+		Value: name,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Queries.CreateActorImagePlayerProperties(context.Background(), query.CreateActorImagePlayerPropertiesParams{
+		AIID: aiid,
+		PID:  pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return aiid
+}
+
+func DeleteTestCharacter(t *testing.T, i *service.Interfaces, aiid int64) {
+	_, err := i.Database.Exec("DELETE FROM actor_images_player_properties WHERE aiid = ?;", aiid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = i.Database.Exec("DELETE FROM actor_images_character_metadata WHERE aiid = ?;", aiid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	DeleteTestActorImage(t, i, aiid)
+}
+
 func DeleteTestActorImageByName(t *testing.T, i *service.Interfaces, name string) {
 	_, err := i.Database.Exec("DELETE FROM actor_images WHERE name = ?;", name)
 	if err != nil {
@@ -460,3 +690,18 @@ func DeleteTestHelpFile(t *testing.T, i *service.Interfaces, slug string) {
 		t.Fatal(err)
 	}
 }
+
+// CreateTestRequestStatusHistory backdates a status transition, for tests that care about when it happened
+func CreateTestRequestStatusHistory(t *testing.T, i *service.Interfaces, rid, pid int64, from, to string, ago time.Duration) {
+	_, err := i.Database.Exec(
+		"INSERT INTO request_status_history (note, from_status, to_status, rid, pid, created_at) VALUES ('', ?, ?, ?, ?, NOW() - INTERVAL ? SECOND);",
+		from,
+		to,
+		rid,
+		pid,
+		int64(ago.Seconds()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,17 +2,24 @@ package test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/require"
 
 	"petrichormud.com/app/internal/app"
 	"petrichormud.com/app/internal/config"
+	"petrichormud.com/app/internal/limit"
+	"petrichormud.com/app/internal/player/password"
+	"petrichormud.com/app/internal/player/twofactor"
+	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 )
@@ -66,6 +73,8 @@ func TestLoginNonExistantUser(t *testing.T) {
 	app.Middleware(a, &i)
 	app.Handlers(a, &i)
 
+	defer ClearTestLimits(t, &i, TestUsername)
+
 	url := MakeTestURL(route.Login)
 
 	body := new(bytes.Buffer)
@@ -173,3 +182,236 @@ func TestLoginBadRequestMalformedBody(t *testing.T) {
 
 	require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
 }
+
+func TestLoginLockout(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	ClearTestLimits(t, &i, TestUsername)
+
+	login := func(pw string) *http.Response {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("username", TestUsername)
+		writer.WriteField("password", pw)
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, MakeTestURL(route.Login), body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	for n := int64(0); n < limit.Login.MaxAttempts; n++ {
+		require.Equal(t, fiber.StatusUnauthorized, login("wrong").StatusCode)
+	}
+
+	// Even the right password is refused until the lockout runs out
+	res := login(TestPassword)
+	require.Equal(t, fiber.StatusTooManyRequests, res.StatusCode)
+	require.NotEmpty(t, res.Header.Get(fiber.HeaderRetryAfter))
+}
+
+func TestLoginTwoFactor(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+
+	_, err := twofactor.Enroll(context.Background(), i.Queries, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tf, err := i.Queries.GetPlayerTwoFactor(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	code, err := twofactor.Code(tf.Secret, twofactor.Step(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := twofactor.Confirm(context.Background(), i.Queries, pid, code, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	// The password alone doesn't log the player in
+	req := httptest.NewRequest(http.MethodGet, MakeTestURL(route.Profile), nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+
+	loginTwoFactor := func(code string) *http.Response {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("code", code)
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, MakeTestURL(route.LoginTwoFactor), body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// The code used to confirm setup can't be used again
+	res = loginTwoFactor(code)
+	require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+
+	res = loginTwoFactor(codes[0])
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	req = httptest.NewRequest(http.MethodGet, MakeTestURL(route.Profile), nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+}
+
+func TestLoginUpgradesPasswordHash(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+
+	old := password.Target
+	old.Memory = old.Memory / 2
+	old.Iterations = 1
+	pwHash, err := password.HashWithParams(TestPassword, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Queries.UpdatePlayerPassword(context.Background(), query.UpdatePlayerPasswordParams{
+		PwHash: pwHash,
+		ID:     pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	p, err := i.Queries.GetPlayer(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.NotEqual(t, pwHash, p.PwHash)
+
+	params, _, _, err := password.DecodeHash(p.PwHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, password.Target.Memory, params.Memory)
+	require.Equal(t, password.Target.Iterations, params.Iterations)
+	require.Equal(t, password.Target.Parallelism, params.Parallelism)
+
+	match, err := password.Verify(TestPassword, p.PwHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, match)
+}
+
+func TestDisableTwoFactorLockout(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	ClearTestLimits(t, &i, TestUsername)
+	key := limit.Key(limit.TwoFactor, limit.KindPlayer, strconv.FormatInt(pid, 10))
+	if err := limit.Clear(context.Background(), i.Redis, key); err != nil {
+		t.Fatal(err)
+	}
+	defer limit.Clear(context.Background(), i.Redis, key)
+	defer ClearTestLimits(t, &i, TestUsername)
+
+	// Log in first, so the session isn't waiting on a code
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	_, err := twofactor.Enroll(context.Background(), i.Queries, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tf, err := i.Queries.GetPlayerTwoFactor(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	code, err := twofactor.Code(tf.Secret, twofactor.Step(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := twofactor.Confirm(context.Background(), i.Queries, pid, code, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := twofactor.InvalidateEnabled(i.Redis, pid); err != nil {
+		t.Fatal(err)
+	}
+	defer twofactor.InvalidateEnabled(i.Redis, pid)
+
+	disable := func(code string) *http.Response {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("code", code)
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, MakeTestURL(route.ProfileTwoFactorDisable), body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	for n := int64(0); n < limit.TwoFactor.MaxAttempts; n++ {
+		require.Equal(t, fiber.StatusUnauthorized, disable("000000").StatusCode)
+	}
+
+	// Even a good recovery code is refused until the lockout runs out
+	res := disable(codes[0])
+	require.Equal(t, fiber.StatusTooManyRequests, res.StatusCode)
+	require.NotEmpty(t, res.Header.Get(fiber.HeaderRetryAfter))
+
+	enabled, err := twofactor.Enabled(context.Background(), i.Queries, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, enabled)
+}
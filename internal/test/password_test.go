@@ -491,3 +491,61 @@ func TestChangePasswordSuccess(t *testing.T) {
 
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
+
+func TestChangePasswordRevokesOtherSessions(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+	otherSessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	profile := func(cookie *http.Cookie) int {
+		req := httptest.NewRequest(http.MethodGet, MakeTestURL(route.Profile), nil)
+		req.AddCookie(cookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+
+	// Visiting the profile puts both sessions in the index
+	require.Equal(t, fiber.StatusOK, profile(sessionCookie))
+	require.Equal(t, fiber.StatusOK, profile(otherSessionCookie))
+
+	newPassword := fmt.Sprintf("%s%s", TestPassword, "!!")
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("current", TestPassword)
+	writer.WriteField("password", newPassword)
+	writer.WriteField("confirm", newPassword)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPut, MakeTestURL(route.PlayerPasswordPath(pid)), body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	require.Equal(t, fiber.StatusOK, profile(sessionCookie))
+	require.Equal(t, fiber.StatusUnauthorized, profile(otherSessionCookie))
+
+	p, err := i.Queries.GetPlayer(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := password.Verify(newPassword, p.PwHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, ok)
+}
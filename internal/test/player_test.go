@@ -2,9 +2,11 @@ package test
 
 import (
 	"bytes"
+	"encoding/json"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	neturl "net/url"
 	"strconv"
 	"testing"
 
@@ -14,6 +16,7 @@ import (
 	"petrichormud.com/app/internal/app"
 	"petrichormud.com/app/internal/config"
 	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/player/permission"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 )
@@ -239,3 +242,166 @@ func TestRecoverPageSuccess(t *testing.T) {
 
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
+
+func TestTogglePlayerPermissionRevokeTakesEffectImmediately(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionGrantAll.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	reviewPermissionID := CreateTestPlayerPermission(t, &i, rpid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, reviewPermissionID)
+
+	reviewerCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+	queue := func() int {
+		req := httptest.NewRequest(http.MethodGet, MakeTestURL(route.CharacterApplications), nil)
+		req.AddCookie(reviewerCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+
+	// Loads the reviewer's permissions into the cache
+	require.Equal(t, fiber.StatusOK, queue())
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("issued", "false")
+	writer.Close()
+
+	url := MakeTestURL(route.PlayerPermissionsTogglePath(strconv.FormatInt(rpid, 10), player.PermissionReviewCharacterApplications.Name))
+	req := httptest.NewRequest(http.MethodPost, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.AddCookie(LoginTestPlayer(t, a, TestUsername, TestPassword))
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	require.Equal(t, fiber.StatusForbidden, queue())
+}
+
+func TestTogglePlayerRoleDelegatedGrant(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	CreateTestPlayerRole(t, &i, pid, player.RoleAdmin.Name)
+
+	bpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+
+	builderCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+	rooms := func() int {
+		req := httptest.NewRequest(http.MethodGet, MakeTestURL(route.Rooms), nil)
+		req.AddCookie(builderCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+	require.Equal(t, fiber.StatusForbidden, rooms())
+
+	adminCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+	toggle := func(role string) int {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("issued", "true")
+		writer.Close()
+
+		url := MakeTestURL(route.PlayerRolesTogglePath(strconv.FormatInt(bpid, 10), role))
+		req := httptest.NewRequest(http.MethodPost, url, body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(adminCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+
+	require.Equal(t, fiber.StatusForbidden, toggle(player.RoleAdmin.Name))
+	require.Equal(t, fiber.StatusOK, toggle(player.RoleBuilder.Name))
+	require.Equal(t, fiber.StatusConflict, toggle(player.RoleBuilder.Name))
+	require.Equal(t, fiber.StatusOK, rooms())
+}
+
+func TestPlayerHistoryExport(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionGrantAll.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+
+	tpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+
+	cookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("issued", "true")
+	writer.Close()
+
+	url := MakeTestURL(route.PlayerPermissionsTogglePath(strconv.FormatInt(tpid, 10), player.PermissionCreateRoom.Name))
+	req := httptest.NewRequest(http.MethodPost, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.AddCookie(cookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	filters := neturl.Values{}
+	filters.Set("target", TestUsernameTwo)
+	req = httptest.NewRequest(http.MethodGet, MakeTestURL(route.PlayerHistoryExportPath(permission.HistoryFormatJSON, filters)), nil)
+	req.AddCookie(cookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	var history []permission.HistoryEntry
+	if err := json.NewDecoder(res.Body).Decode(&history); err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, history, 1)
+	require.Equal(t, player.PermissionCreateRoom.Name, history[0].Name)
+	require.Equal(t, permission.HistoryActionGranted, history[0].Action)
+	require.Equal(t, TestUsername, history[0].Issuer)
+
+	req = httptest.NewRequest(http.MethodGet, MakeTestURL(route.PlayerHistoryExportPath(permission.HistoryFormatCSV, filters)), nil)
+	req.AddCookie(cookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+	require.Equal(t, "text/csv", res.Header.Get("Content-Type"))
+}
@@ -3,13 +3,16 @@ package test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	fiber "github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/require"
@@ -20,6 +23,7 @@ import (
 	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/request"
 	"petrichormud.com/app/internal/request/definition"
+	"petrichormud.com/app/internal/request/version"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
 )
@@ -753,19 +757,8 @@ func TestUpdateRequestFieldForbiddenNotEditable(t *testing.T) {
 	defer DeleteTestPlayer(t, &i, TestUsername)
 	defer DeleteTestRequest(t, &i, rid)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	// TODO: Update this to use a helper that calls the app's API instead of hacking it
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusSubmitted,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusSubmitted)
 
 	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
 
@@ -810,19 +803,8 @@ func TestUpdateRequestFieldForbiddenReviewer(t *testing.T) {
 	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
 	defer DeleteTestPlayerPermission(t, &i, permid)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	// TODO: Update this to use a helper that calls the app's API instead of hacking it
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	if err := i.Queries.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
 		ID:   rid,
@@ -927,19 +909,8 @@ func TestUpdateRequestFieldSuccessWhileReviewed(t *testing.T) {
 	defer DeleteTestPlayer(t, &i, TestUsername)
 	defer DeleteTestRequest(t, &i, rid)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	// TODO: Update this to use a helper that calls the app's API instead of hacking it
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusReviewed,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusReviewed)
 
 	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
 
@@ -962,6 +933,80 @@ func TestUpdateRequestFieldSuccessWhileReviewed(t *testing.T) {
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
 
+func TestUpdateStatusRecordsHistory(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	preq, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
+		Request: &preq,
+		PID:     pid,
+		Status:  request.StatusReady,
+		Note:    "Test note",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := i.Queries.ListRequestStatusHistoryForRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, 2, len(history))
+	require.Equal(t, "", history[0].RequestStatusHistory.FromStatus)
+	require.Equal(t, request.StatusIncomplete, history[0].RequestStatusHistory.ToStatus)
+	require.Equal(t, request.StatusIncomplete, history[1].RequestStatusHistory.FromStatus)
+	require.Equal(t, request.StatusReady, history[1].RequestStatusHistory.ToStatus)
+	require.Equal(t, "Test note", history[1].RequestStatusHistory.Note)
+	require.Equal(t, pid, history[1].RequestStatusHistory.PID)
+	require.Equal(t, TestUsername, history[1].Username.String)
+}
+
+func TestUpdateStatusInvalidTransition(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	preq, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = request.UpdateStatus(i.Queries, request.UpdateStatusParams{
+		Request: &preq,
+		PID:     pid,
+		Status:  request.StatusApproved,
+	})
+	require.Equal(t, request.ErrInvalidTransition, err)
+
+	req, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusIncomplete, req.Status)
+}
+
 func TestUpdateRequestStatusUnauthorizedNotLoggedIn(t *testing.T) {
 	i := service.NewInterfaces()
 	defer i.Close()
@@ -1229,18 +1274,7 @@ func TestCreateRequestChangeRequestBadRequestMissingBody(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	url := MakeTestURL(route.RequestChangeRequestFieldPath(rid, definition.FieldCharacterApplicationName.Type))
 
@@ -1274,18 +1308,7 @@ func TestCreateRequestChangeRequestBadRequestInvalidText(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	url := MakeTestURL(route.RequestChangeRequestFieldPath(rid, definition.FieldCharacterApplicationName.Type))
 
@@ -1325,18 +1348,7 @@ func TestCreateRequestChangeRequestBadRequestInvalidField(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	url := MakeTestURL(route.RequestChangeRequestFieldPath(rid, "notafield"))
 
@@ -1376,18 +1388,7 @@ func TestCreateRequestChangeRequestNotFoundNoRequest(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	url := MakeTestURL(route.RequestChangeRequestFieldPath(rid+1000, definition.FieldCharacterApplicationName.Type))
 
@@ -1469,18 +1470,7 @@ func TestCreateRequestChangeRequestForbiddenNotReviewer(t *testing.T) {
 	permissionID = CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
 	defer DeleteTestPlayerPermission(t, &i, permissionID)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
@@ -1519,18 +1509,7 @@ func TestCreateRequestChangeRequestForbiddenNoPermission(t *testing.T) {
 	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
 	defer DeleteTestPlayer(t, &i, TestUsername)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
@@ -1572,18 +1551,7 @@ func TestCreateRequestChangeRequestSuccess(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionID)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	body := new(bytes.Buffer)
 	writer := multipart.NewWriter(body)
@@ -1622,18 +1590,7 @@ func TestDeleteRequestChangeRequestUnauthorizedNotLoggedIn(t *testing.T) {
 	permissionId := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -1676,18 +1633,7 @@ func TestDeleteRequestChangeRequestNotFoundNoChangeRequest(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -1731,18 +1677,7 @@ func TestDeleteRequestChangeRequestNotFoundNoRequest(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -1787,18 +1722,7 @@ func TestDeleteRequestChangeRequestSuccess(t *testing.T) {
 
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -1841,18 +1765,7 @@ func TestEditRequestChangeRequestUnauthorizedNotLoggedIn(t *testing.T) {
 	permissionId := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -1901,18 +1814,7 @@ func TestEditRequestChangeRequestBadRequestMissingBody(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -1956,18 +1858,7 @@ func TestEditRequestChangeRequestBadRequestInvalidText(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -2017,18 +1908,7 @@ func TestEditRequestChangeRequestNotFoundNoChangeRequest(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -2078,18 +1958,7 @@ func TestEditRequestChangeRequestNotFoundNoRequest(t *testing.T) {
 	defer DeleteTestPlayerPermission(t, &i, permissionId)
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -2140,18 +2009,7 @@ func TestEditRequestChangeRequestSuccess(t *testing.T) {
 
 	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
 
-	preq, err := i.Queries.GetRequest(context.Background(), rid)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
-		Request: &preq,
-		PID:     pid,
-		Status:  request.StatusInReview,
-	}); err != nil {
-		t.Fatal(t)
-	}
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
 
 	id := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
 		T:        t,
@@ -3356,3 +3214,1706 @@ func TestDeleteRequestSubfieldSuccess(t *testing.T) {
 
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
+
+func TestDeleteRequestStatusUnauthorizedNotLoggedIn(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	url := MakeTestURL(route.RequestStatusPath(rid))
+
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+}
+
+func TestDeleteRequestStatusForbiddenNotAuthor(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	url := MakeTestURL(route.RequestStatusPath(rid))
+
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestDeleteRequestStatusForbiddenInReview(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RequestStatusPath(rid))
+
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestDeleteRequestStatusCancelsAndReleasesReviewer(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusReviewed)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RequestStatusPath(rid))
+
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	r, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, request.StatusCanceled, r.Status)
+	require.Equal(t, int64(0), r.RPID)
+}
+
+func TestDeleteRequestForbiddenNotCanceled(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RequestPath(rid))
+
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestDeleteRequestForbiddenNotAuthor(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusCanceled)
+
+	CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	url := MakeTestURL(route.RequestPath(rid))
+
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestDeleteAndRestoreRequestSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusCanceled)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RequestPath(rid))
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	deleted, err := request.IsDeleted(i.Queries, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, deleted)
+
+	reqs, err := i.Queries.ListRequestsForPlayer(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, 0, len(reqs))
+
+	req = httptest.NewRequest(http.MethodGet, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusNotFound, res.StatusCode)
+
+	url = MakeTestURL(route.RequestRestorePath(rid))
+	req = httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	deleted, err = request.IsDeleted(i.Queries, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, deleted)
+}
+
+func TestRestoreRequestBadRequestNotDeleted(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RequestRestorePath(rid))
+
+	req := httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+}
+
+func CreateTestRoomProposal(t *testing.T, i *service.Interfaces, pid int64, p CreateTestRoomParams) int64 {
+	rid, err := request.New(i.Queries, request.NewParams{
+		Type: request.TypeRoomProposal,
+		PID:  pid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := map[string]string{
+		definition.FieldRoomProposalTitle.Type:       p.Title,
+		definition.FieldRoomProposalDescription.Type: p.Description,
+		definition.FieldRoomProposalSize.Type:        strconv.Itoa(int(p.Size)),
+	}
+	for ft, v := range values {
+		if err := i.Queries.UpdateRequestFieldValueByRequestAndType(context.Background(), query.UpdateRequestFieldValueByRequestAndTypeParams{
+			RID:   rid,
+			Type:  ft,
+			Value: v,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return rid
+}
+
+func TestFulfillRoomProposalByReviewer(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	prid := CreateTestPlayerPermission(t, &i, rpid, player.PermissionReviewRoomProposals.Name)
+	rid := CreateTestRoomProposal(t, &i, pid, TestRoom)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	defer DeleteTestRequest(t, &i, rid)
+	defer DeleteTestRoomByTitle(t, &i, TestRoom.Title)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusApproved)
+
+	url := MakeTestURL(route.RequestStatusPath(rid))
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+	req := httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+
+	sessionCookie = LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+	req = httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	r, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusFulfilled, r.Status)
+
+	var count int
+	if err := i.Database.QueryRow("SELECT COUNT(*) FROM rooms WHERE title = ?;", TestRoom.Title).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, 1, count)
+}
+
+func TestFulfillRoomProposalIsIdempotent(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	rid := CreateTestRoomProposal(t, &i, pid, TestRoom)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestRequest(t, &i, rid)
+	defer DeleteTestRoomByTitle(t, &i, TestRoom.Title)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusApproved)
+
+	for range 2 {
+		tx, err := i.Database.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := request.Fulfill(context.Background(), tx, i.Queries, request.FulfillParams{
+			RID: rid,
+			PID: rpid,
+		}); err != nil {
+			tx.Rollback()
+			t.Fatal(err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var count int
+	if err := i.Database.QueryRow("SELECT COUNT(*) FROM rooms WHERE title = ?;", TestRoom.Title).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, 1, count)
+}
+
+func SetTestCharacterApplicationName(t *testing.T, i *service.Interfaces, rid int64, name string) {
+	if err := i.Queries.UpdateRequestFieldValueByRequestAndType(context.Background(), query.UpdateRequestFieldValueByRequestAndTypeParams{
+		RID:   rid,
+		Type:  definition.FieldCharacterApplicationName.Type,
+		Value: name,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubmitCharacterApplicationConflictNameReserved(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	ridOne := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	pidTwo := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	ridTwo := CreateTestCharacterApplication(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestRequest(t, &i, ridOne)
+	defer DeleteTestRequest(t, &i, ridTwo)
+	SetTestCharacterApplicationName(t, &i, ridOne, "Testify")
+	SetTestCharacterApplicationName(t, &i, ridTwo, "Testify")
+	UpdateTestRequestStatus(t, &i, ridOne, pid, request.StatusReady)
+	UpdateTestRequestStatus(t, &i, ridTwo, pidTwo, request.StatusReady)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+	req := httptest.NewRequest(http.MethodPost, MakeTestURL(route.RequestStatusPath(ridOne)), nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	sessionCookie = LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+	req = httptest.NewRequest(http.MethodPost, MakeTestURL(route.RequestStatusPath(ridTwo)), nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusConflict, res.StatusCode)
+
+	r, err := i.Queries.GetRequest(context.Background(), ridTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusReady, r.Status)
+}
+
+func TestCharacterNameReserved(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	ridOne := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	ridTwo := CreateTestCharacterApplication(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestRequest(t, &i, ridOne)
+	defer DeleteTestRequest(t, &i, ridTwo)
+	if err := i.Queries.CreateCharacterNameReservation(context.Background(), query.CreateCharacterNameReservationParams{
+		Name: "Testify",
+		RID:  ridOne,
+		PID:  pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(u string, rid int64, name string) int {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("value", name)
+		writer.Close()
+
+		sessionCookie := LoginTestPlayer(t, a, u, TestPassword)
+		req := httptest.NewRequest(http.MethodPost, MakeTestURL(route.CharacterNameReservedPath(rid)), body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+
+	require.Equal(t, fiber.StatusOK, check(TestUsername, ridOne, "Testify"))
+	require.Equal(t, fiber.StatusConflict, check(TestUsernameTwo, ridTwo, "Testify"))
+	require.Equal(t, fiber.StatusOK, check(TestUsernameTwo, ridTwo, "Testifier"))
+	require.Equal(t, fiber.StatusForbidden, check(TestUsernameTwo, ridOne, "Testifier"))
+}
+
+func TestSweepStaleReviewsReleasesReviewer(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	rid := CreateTestRoomProposal(t, &i, pid, TestRoom)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+
+	ctx := context.Background()
+
+	_, err := request.SweepStaleReviews(ctx, i.Database, i.Queries, request.SweepStaleReviewsParams{
+		Timeout: time.Hour,
+		Now:     time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusInReview, req.Status)
+
+	_, err = request.SweepStaleReviews(ctx, i.Database, i.Queries, request.SweepStaleReviewsParams{
+		Timeout: time.Hour,
+		Now:     time.Now().Add(2 * time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err = i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusSubmitted, req.Status)
+	require.Equal(t, int64(0), req.RPID)
+
+	history, err := i.Queries.ListRequestStatusHistoryForRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := history[len(history)-1].RequestStatusHistory
+	require.Equal(t, request.StatusInReview, last.FromStatus)
+	require.Equal(t, request.StatusSubmitted, last.ToStatus)
+	require.Equal(t, request.StaleReviewNote, last.Note)
+}
+
+func TestSweepStaleReviewsSkipsNewlyAssigned(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	apid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	rid := CreateTestRoomProposal(t, &i, pid, TestRoom)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+	CreateTestRequestStatusHistory(t, &i, rid, rpid, request.StatusSubmitted, request.StatusInReview, 3*time.Hour)
+
+	ctx := context.Background()
+
+	req, err := i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	perms := player.NewPermissions(apid, []query.PlayerPermission{
+		{PID: apid, Name: player.PermissionAssignRequestReviewers.Name},
+	})
+	rperms := player.NewPermissions(apid, []query.PlayerPermission{
+		{PID: apid, Name: player.PermissionReviewRoomProposals.Name},
+	})
+	if err := request.Assign(i.Queries, request.AssignParams{
+		Request:             &req,
+		Permissions:         &perms,
+		AssigneePermissions: &rperms,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The last InReview row is older than the timeout, but the assignment starts a new window
+	_, err = request.SweepStaleReviews(ctx, i.Database, i.Queries, request.SweepStaleReviewsParams{
+		Timeout: 2 * time.Hour,
+		Now:     time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err = i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusInReview, req.Status)
+	require.Equal(t, apid, req.RPID)
+}
+
+func TestRequestFieldComments(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	permid := CreateTestPlayerPermission(t, &i, rpid, player.PermissionReviewCharacterApplications.Name)
+	CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+	defer DeleteTestPlayerPermission(t, &i, permid)
+	defer DeleteTestRequest(t, &i, rid)
+
+	ft := definition.FieldCharacterApplicationName.Type
+
+	send := func(u, method, path, text string) int {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("text", text)
+		writer.Close()
+
+		sessionCookie := LoginTestPlayer(t, a, u, TestPassword)
+		req := httptest.NewRequest(method, MakeTestURL(path), body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode
+	}
+	comments := route.RequestFieldCommentsPath(rid, ft)
+	resolved := route.RequestFieldCommentsResolvedPath(rid, ft)
+
+	require.Equal(t, fiber.StatusForbidden, send(TestUsername, http.MethodPost, comments, "Is this name okay?"))
+
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusSubmitted)
+
+	require.Equal(t, fiber.StatusBadRequest, send(TestUsername, http.MethodPost, resolved, ""))
+	require.Equal(t, fiber.StatusOK, send(TestUsername, http.MethodPost, comments, "Is this name okay?"))
+	require.Equal(t, fiber.StatusOK, send(TestUsernameTwo, http.MethodPost, comments, "It is, yes."))
+	require.Equal(t, fiber.StatusForbidden, send(TestUsernameThree, http.MethodPost, comments, "Hello!"))
+	require.Equal(t, fiber.StatusBadRequest, send(TestUsername, http.MethodPost, comments, ""))
+
+	field, err := i.Queries.GetRequestFieldByType(context.Background(), query.GetRequestFieldByTypeParams{
+		RID:  rid,
+		Type: ft,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusOK, send(TestUsername, http.MethodPost, resolved, ""))
+	_, err = i.Queries.GetRequestFieldCommentResolution(context.Background(), field.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, fiber.StatusOK, send(TestUsernameTwo, http.MethodPost, comments, "One more thing."))
+	_, err = i.Queries.GetRequestFieldCommentResolution(context.Background(), field.ID)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	require.Equal(t, fiber.StatusOK, send(TestUsername, http.MethodPost, resolved, ""))
+	require.Equal(t, fiber.StatusOK, send(TestUsernameTwo, http.MethodDelete, resolved, ""))
+	_, err = i.Queries.GetRequestFieldCommentResolution(context.Background(), field.ID)
+	require.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestFieldDiffSinceReview(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	ctx := context.Background()
+	ft := definition.FieldCharacterApplicationName.Type
+
+	req, err := i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	field, err := i.Queries.GetRequestFieldByType(ctx, query.GetRequestFieldByTypeParams{
+		RID:  rid,
+		Type: ft,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := request.UpdateField(i.Queries, request.UpdateFieldParams{
+		Request: &req,
+		Field:   &field,
+		Value:   "Testify",
+		PID:     pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The review has to land after the first version for it to count as the reviewed value
+	if _, err := i.Database.Exec(
+		"INSERT INTO request_status_history (note, from_status, to_status, rid, pid, created_at) VALUES ('', ?, ?, ?, ?, ?);",
+		request.StatusInReview, request.StatusReviewed, rid, pid, time.Now().Add(time.Minute),
+	); err != nil {
+		t.Fatal(err)
+	}
+	SetTestCharacterApplicationName(t, &i, rid, "Testified")
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusSubmitted)
+
+	req, err = i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	field, err = i.Queries.GetRequestFieldByType(ctx, query.GetRequestFieldByTypeParams{
+		RID:  rid,
+		Type: ft,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, ok, err := request.FieldDiffSinceReview(ctx, i.Queries, request.FieldDiffParams{
+		Request: &req,
+		Field:   &field,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, ok)
+	require.Equal(t, []version.Segment{
+		{Op: version.OpDelete, Text: "Testify"},
+		{Op: version.OpInsert, Text: "Testified"},
+	}, diff.Segments)
+}
+
+func TestResubmitArchivesChangeRequests(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	permid := CreateTestPlayerPermission(t, &i, rpid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestPlayerPermission(t, &i, permid)
+	defer DeleteTestRequest(t, &i, rid)
+	SetTestCharacterApplicationName(t, &i, rid, "Testify")
+
+	ctx := context.Background()
+	ft := definition.FieldCharacterApplicationName.Type
+
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+	chid := CreateTestRequestChangeRequest(CreateTestRequestChangeRequestParams{
+		T:        t,
+		I:        &i,
+		A:        a,
+		Username: TestUsernameTwo,
+		Password: TestPassword,
+		Field:    ft,
+		RID:      rid,
+	})
+	if err := i.Queries.CreateRequestChangeRequest(ctx, chid); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.Queries.DeleteOpenRequestChangeRequest(ctx, chid); err != nil {
+		t.Fatal(err)
+	}
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusReviewed)
+
+	req, err := i.Queries.GetRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
+		Request: &req,
+		PID:     pid,
+		Status:  request.StatusSubmitted,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := i.Queries.ListRequestChangeRequestsForRequest(ctx, rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, changes)
+
+	field, err := i.Queries.GetRequestFieldByType(ctx, query.GetRequestFieldByTypeParams{
+		RID:  rid,
+		Type: ft,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	past, err := i.Queries.ListPastRequestChangeRequestsForField(ctx, field.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, past, 1)
+	require.Equal(t, chid, past[0].PastRequestChangeRequest.ID)
+}
+
+func TestClaimRequest(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	opid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+
+	perms := player.NewPermissions(pid, []query.PlayerPermission{
+		{PID: pid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+	operms := player.NewPermissions(opid, []query.PlayerPermission{
+		{PID: opid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+	aperms := player.NewPermissions(apid, []query.PlayerPermission{
+		{PID: apid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+
+	req, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = request.Claim(i.Queries, request.ClaimParams{
+		Request:     &req,
+		Permissions: &aperms,
+		PID:         apid,
+	})
+	require.Equal(t, request.ErrNextStatusForbidden, err)
+
+	if err := request.Claim(i.Queries, request.ClaimParams{
+		Request:     &req,
+		Permissions: &perms,
+		PID:         pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err = i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, pid, req.RPID)
+	require.Equal(t, request.StatusSubmitted, req.Status)
+
+	err = request.Claim(i.Queries, request.ClaimParams{
+		Request:     &req,
+		Permissions: &operms,
+		PID:         opid,
+	})
+	require.Equal(t, request.ErrClaimed, err)
+}
+
+func TestUnclaimRequest(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	opid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
+
+	perms := player.NewPermissions(pid, []query.PlayerPermission{
+		{PID: pid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+	operms := player.NewPermissions(opid, []query.PlayerPermission{
+		{PID: opid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+
+	req, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = request.Unclaim(i.Queries, request.ClaimParams{
+		Request:     &req,
+		Permissions: &operms,
+		PID:         opid,
+	})
+	require.Equal(t, request.ErrNotClaimant, err)
+
+	if err := request.Unclaim(i.Queries, request.ClaimParams{
+		Request:     &req,
+		Permissions: &perms,
+		PID:         pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err = i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, int64(0), req.RPID)
+	require.Equal(t, request.StatusSubmitted, req.Status)
+}
+
+func TestAssignRequest(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+
+	perms := player.NewPermissions(pid, []query.PlayerPermission{
+		{PID: pid, Name: player.PermissionAssignRequestReviewers.Name},
+	})
+	rperms := player.NewPermissions(rpid, []query.PlayerPermission{
+		{PID: rpid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+	aperms := player.NewPermissions(apid, []query.PlayerPermission{
+		{PID: apid, Name: player.PermissionReviewCharacterApplications.Name},
+	})
+	noperms := player.NewPermissions(rpid, []query.PlayerPermission{})
+
+	req, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = request.Assign(i.Queries, request.AssignParams{
+		Request:             &req,
+		Permissions:         &rperms,
+		AssigneePermissions: &rperms,
+	})
+	require.Equal(t, request.ErrNextStatusForbidden, err)
+
+	err = request.Assign(i.Queries, request.AssignParams{
+		Request:             &req,
+		Permissions:         &perms,
+		AssigneePermissions: &noperms,
+	})
+	require.Equal(t, request.ErrInvalidAssignee, err)
+
+	err = request.Assign(i.Queries, request.AssignParams{
+		Request:             &req,
+		Permissions:         &perms,
+		AssigneePermissions: &aperms,
+	})
+	require.Equal(t, request.ErrInvalidAssignee, err)
+
+	if err := request.Assign(i.Queries, request.AssignParams{
+		Request:             &req,
+		Permissions:         &perms,
+		AssigneePermissions: &rperms,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err = i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, rpid, req.RPID)
+}
+
+func TestListQueueOrdersBySubmission(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	opid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	orid := CreateTestCharacterApplication(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	defer DeleteTestRequest(t, &i, orid)
+
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+
+	// The older request was sent back and resubmitted an hour ago, so it goes behind the newer one
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusSubmitted)
+	CreateTestRequestStatusHistory(t, &i, rid, pid, request.StatusReady, request.StatusSubmitted, 5*24*time.Hour)
+	CreateTestRequestStatusHistory(t, &i, rid, pid, request.StatusReviewed, request.StatusSubmitted, time.Hour)
+	// Being released by a reviewer doesn't count as a new submission
+	UpdateTestRequestStatus(t, &i, orid, opid, request.StatusSubmitted)
+	CreateTestRequestStatusHistory(t, &i, orid, opid, request.StatusReady, request.StatusSubmitted, 3*24*time.Hour)
+	CreateTestRequestStatusHistory(t, &i, orid, rpid, request.StatusInReview, request.StatusSubmitted, 2*time.Hour)
+
+	// Claiming both for the same reviewer keeps anything else in the queue out of the way
+	for _, id := range []int64{rid, orid} {
+		if err := i.Queries.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+			ID:   id,
+			RPID: rpid,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, more, err := request.ListQueue(i.Queries, request.ListQueueParams{
+		Now:      time.Now(),
+		Type:     request.TypeCharacterApplication,
+		Reviewer: sql.NullInt64{Int64: rpid, Valid: true},
+		Page:     1,
+		PID:      rpid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, more)
+	require.Len(t, rows, 2)
+	require.Equal(t, orid, rows[0].Request.ID)
+	require.Equal(t, rid, rows[1].Request.ID)
+	require.True(t, rows[1].SubmittedAt.After(rows[0].SubmittedAt))
+
+	rows, _, err = request.ListQueue(i.Queries, request.ListQueueParams{
+		Now:      time.Now(),
+		Type:     request.TypeCharacterApplication,
+		Reviewer: sql.NullInt64{Int64: rpid, Valid: true},
+		MinAge:   24 * time.Hour,
+		Page:     1,
+		PID:      rpid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, rows, 1)
+	require.Equal(t, orid, rows[0].Request.ID)
+
+	rows, _, err = request.ListQueue(i.Queries, request.ListQueueParams{
+		Now:      time.Now(),
+		Type:     request.TypeCharacterApplication,
+		Reviewer: sql.NullInt64{Int64: rpid, Valid: true},
+		Author:   sql.NullInt64{Int64: pid, Valid: true},
+		Page:     1,
+		PID:      rpid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, rows, 1)
+	require.Equal(t, rid, rows[0].Request.ID)
+
+	// A reviewer's own requests never show up in their queue
+	rows, _, err = request.ListQueue(i.Queries, request.ListQueueParams{
+		Now:      time.Now(),
+		Type:     request.TypeCharacterApplication,
+		Reviewer: sql.NullInt64{Int64: rpid, Valid: true},
+		Page:     1,
+		PID:      pid,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, rows, 1)
+	require.Equal(t, orid, rows[0].Request.ID)
+}
+
+func TestCharacterApplicationsQueuePageBadRequestAuthorMe(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	for _, author := range []string{request.QueueFilterMe, request.QueueFilterUnclaimed} {
+		url := MakeTestURL(route.CharacterApplications + "?author=" + author)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.AddCookie(sessionCookie)
+
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	}
+}
+
+func TestClaimRequestUnauthorizedNotLoggedIn(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusSubmitted)
+
+	url := MakeTestURL(route.RequestClaimPath(rid))
+	req := httptest.NewRequest(http.MethodPost, url, nil)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+}
+
+func TestClaimRequestForbiddenNoPermission(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	url := MakeTestURL(route.RequestClaimPath(rid))
+	req := httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestClaimRequestConflictClaimed(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	opid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+	if err := i.Queries.UpdateRequestReviewer(context.Background(), query.UpdateRequestReviewerParams{
+		ID:   rid,
+		RPID: opid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	url := MakeTestURL(route.RequestClaimPath(rid))
+	req := httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusConflict, res.StatusCode)
+}
+
+func TestClaimRequestSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	url := MakeTestURL(route.RequestClaimPath(rid))
+	req := httptest.NewRequest(http.MethodPost, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	preq, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, pid, preq.RPID)
+}
+
+func TestUnclaimRequestForbiddenNotClaimant(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	opid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+	UpdateTestRequestStatus(t, &i, rid, opid, request.StatusInReview)
+
+	url := MakeTestURL(route.RequestClaimPath(rid))
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestUnclaimRequestSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+	UpdateTestRequestStatus(t, &i, rid, pid, request.StatusInReview)
+
+	url := MakeTestURL(route.RequestClaimPath(rid))
+	req := httptest.NewRequest(http.MethodDelete, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	preq, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, int64(0), preq.RPID)
+	require.Equal(t, request.StatusSubmitted, preq.Status)
+}
+
+func TestAssignRequestReviewerForbiddenNoPermission(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("username", TestUsernameTwo)
+	writer.Close()
+
+	url := MakeTestURL(route.RequestReviewerPath(rid))
+	req := httptest.NewRequest(http.MethodPut, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestAssignRequestReviewerBadRequestInvalidAssignee(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionAssignRequestReviewers.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("username", TestUsernameThree)
+	writer.Close()
+
+	url := MakeTestURL(route.RequestReviewerPath(rid))
+	req := httptest.NewRequest(http.MethodPut, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+}
+
+func TestAssignRequestReviewerSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	apid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+	UpdateTestRequestStatus(t, &i, rid, apid, request.StatusSubmitted)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionAssignRequestReviewers.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameThree, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameThree)
+	reviewerPermissionID := CreateTestPlayerPermission(t, &i, rpid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, reviewerPermissionID)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("username", TestUsernameThree)
+	writer.Close()
+
+	url := MakeTestURL(route.RequestReviewerPath(rid))
+	req := httptest.NewRequest(http.MethodPut, url, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	preq, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, rpid, preq.RPID)
+}
+
+func TestRoomProposalSubmitAndFulfill(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	prid := CreateTestPlayerPermission(t, &i, rpid, player.PermissionReviewRoomProposals.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	defer DeleteTestRoomByTitle(t, &i, TestRoom.Title)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+	req := httptest.NewRequest(http.MethodPost, MakeTestURL(route.RoomProposals), nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusCreated, res.StatusCode)
+
+	reqs, err := i.Queries.ListRequestsForPlayer(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, reqs, 1)
+	require.Equal(t, request.TypeRoomProposal, reqs[0].Type)
+	rid := reqs[0].ID
+	defer DeleteTestRequest(t, &i, rid)
+
+	values := []struct {
+		Field string
+		Value string
+	}{
+		{Field: definition.FieldRoomProposalTitle.Type, Value: TestRoom.Title},
+		{Field: definition.FieldRoomProposalDescription.Type, Value: TestRoom.Description},
+		{Field: definition.FieldRoomProposalSize.Type, Value: strconv.Itoa(int(TestRoom.Size))},
+	}
+	for _, v := range values {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("value", v.Value)
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPatch, MakeTestURL(route.RequestFieldTypePath(rid, v.Field)), body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, fiber.StatusOK, res.StatusCode)
+	}
+
+	statusURL := MakeTestURL(route.RequestStatusPath(rid))
+	advance := func(cookie *http.Cookie, want string) {
+		req := httptest.NewRequest(http.MethodPost, statusURL, nil)
+		req.AddCookie(cookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		r, err := i.Queries.GetRequest(context.Background(), rid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, want, r.Status)
+	}
+
+	advance(sessionCookie, request.StatusSubmitted)
+
+	sessionCookie = LoginTestPlayer(t, a, TestUsernameTwo, TestPassword)
+	req = httptest.NewRequest(http.MethodGet, MakeTestURL(route.RoomProposalsQueue), nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	advance(sessionCookie, request.StatusInReview)
+	advance(sessionCookie, request.StatusApproved)
+	advance(sessionCookie, request.StatusFulfilled)
+
+	var count int
+	if err := i.Database.QueryRow("SELECT COUNT(*) FROM rooms WHERE title = ? AND size = ?;", TestRoom.Title, TestRoom.Size).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, 1, count)
+}
+
+func TestRoomProposalsQueuePageForbiddenOtherReviewer(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionReviewCharacterApplications.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	req := httptest.NewRequest(http.MethodGet, MakeTestURL(route.RoomProposalsQueue), nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestRoomProposalsPageSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	rid := CreateTestRoomProposal(t, &i, pid, TestRoom)
+	defer DeleteTestRequest(t, &i, rid)
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	req := httptest.NewRequest(http.MethodGet, MakeTestURL(route.RoomProposals), nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+}
+
+func TestResubmitReleasesReviewer(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	rid := CreateTestCharacterApplication(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	defer DeleteTestRequest(t, &i, rid)
+
+	rpid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusInReview)
+	UpdateTestRequestStatus(t, &i, rid, rpid, request.StatusReviewed)
+
+	req, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, rpid, req.RPID)
+
+	if err := request.UpdateStatus(i.Queries, request.UpdateStatusParams{
+		Request: &req,
+		PID:     pid,
+		Status:  request.StatusSubmitted,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err = i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, request.StatusSubmitted, req.Status)
+	require.Equal(t, int64(0), req.RPID)
+}
+
+func TestListDeletedRequestsForPlayerByType(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	rid := CreateTestRoomProposal(t, &i, pid, TestRoom)
+	defer DeleteTestRequest(t, &i, rid)
+
+	req, err := i.Queries.GetRequest(context.Background(), rid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := request.Delete(i.Queries, request.DeleteParams{
+		Request: &req,
+		PID:     pid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := i.Queries.ListDeletedRequestsForPlayer(context.Background(), query.ListDeletedRequestsForPlayerParams{
+		PID:       pid,
+		Type:      request.TypeCharacterApplication,
+		CreatedAt: request.RestorableSince(time.Now()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Empty(t, rows)
+
+	rows, err = i.Queries.ListDeletedRequestsForPlayer(context.Background(), query.ListDeletedRequestsForPlayerParams{
+		PID:       pid,
+		Type:      request.TypeRoomProposal,
+		CreatedAt: request.RestorableSince(time.Now()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, rows, 1)
+	require.Equal(t, rid, rows[0].Request.ID)
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"mime/multipart"
 	"net/http"
@@ -16,9 +17,11 @@ import (
 	"petrichormud.com/app/internal/app"
 	"petrichormud.com/app/internal/config"
 	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
 	"petrichormud.com/app/internal/room"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/service"
+	"petrichormud.com/app/internal/zone"
 )
 
 func TestRoomsPageUnauthorized(t *testing.T) {
@@ -92,6 +95,84 @@ func TestRoomsPageSuccess(t *testing.T) {
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
 
+func TestRoomsReportPageUnauthorized(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	url := MakeTestURL(route.RoomsReport)
+
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+}
+
+func TestRoomsReportPageForbiddenNoPermission(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionViewAllRooms.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomsReport)
+
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestRoomsReportPageSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionCreateRoom.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+
+	ridOne := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOne)
+	ridTwo := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridTwo)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(fmt.Sprintf("%s?origin=%d&from=%d&to=%d", route.RoomsReport, ridOne, ridOne, ridTwo))
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+}
+
 func TestRoomPageUnauthorized(t *testing.T) {
 	i := service.NewInterfaces()
 	defer i.Close()
@@ -850,6 +931,249 @@ func TestEditRoomExitSuccess(t *testing.T) {
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
 
+func TestEditRoomExitKeywordSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionCreateRoom.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	ridOne := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOne)
+	ridTwo := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridTwo)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomExitsPath(ridOne))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("id", strconv.FormatInt(ridTwo, 10))
+	writer.WriteField("keyword", "tent")
+	writer.WriteField("return", room.DirectionOut)
+	writer.WriteField("two-way", "true")
+	writer.WriteField("new", "true")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	exits, err := i.Queries.ListRoomExits(context.Background(), ridTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, ridOne, room.ExitID(exits, room.DirectionOut))
+}
+
+func TestEditRoomExitKeywordConflict(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionCreateRoom.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	ridOne := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOne)
+	ridTwo := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridTwo)
+
+	if err := room.Link(room.LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: "tent",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomExitsPath(ridOne))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("id", strconv.FormatInt(ridTwo, 10))
+	writer.WriteField("keyword", "tent")
+	writer.WriteField("new", "true")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusConflict, res.StatusCode)
+}
+
+func TestEditRoomExitKeywordBadRequestNoReturn(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionCreateRoom.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	ridOne := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOne)
+	ridTwo := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridTwo)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomExitsPath(ridOne))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("id", strconv.FormatInt(ridTwo, 10))
+	writer.WriteField("keyword", "tent")
+	writer.WriteField("two-way", "true")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+}
+
+func TestEditRoomExitFlagsSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionCreateRoom.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	ridOne := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOne)
+	ridTwo := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridTwo)
+
+	if err := room.Link(room.LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: room.DirectionNorth,
+		TwoWay:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomExitFlagsPath(ridOne, room.DirectionNorth))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("door", "true")
+	writer.WriteField("locked", "true")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	exit, err := i.Queries.GetRoomExit(context.Background(), query.GetRoomExitParams{
+		RID:       ridOne,
+		Direction: room.DirectionNorth,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.True(t, exit.Door)
+	require.True(t, exit.Locked)
+}
+
+func TestEditRoomExitFlagsBadRequestLockWithoutDoor(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionCreateRoom.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	ridOne := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOne)
+	ridTwo := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridTwo)
+
+	if err := room.Link(room.LinkParams{
+		Queries:   i.Queries,
+		ID:        ridOne,
+		To:        ridTwo,
+		Direction: room.DirectionNorth,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomExitFlagsPath(ridOne, room.DirectionNorth))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("locked", "true")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+}
+
 func TestClearRoomExitUnauthorized(t *testing.T) {
 	i := service.NewInterfaces()
 	defer i.Close()
@@ -1139,6 +1463,244 @@ func TestEditRoomTitleSuccess(t *testing.T) {
 	require.Equal(t, fiber.StatusOK, res.StatusCode)
 }
 
+func TestEditRoomTitleZoneBuilderSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionBuildZoneRooms.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	opid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	zid := CreateTestZone(t, &i, "The Docks", opid)
+	defer DeleteTestZone(t, &i, zid)
+	AddTestZoneBuilder(t, &i, zid, pid)
+	rid := CreateTestRoom(t, &i, CreateTestRoomParams{
+		Title:       TestRoom.Title,
+		Description: TestRoom.Description,
+		Size:        TestRoom.Size,
+		ZID:         zid,
+	})
+	defer DeleteTestRoom(t, &i, rid)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomTitlePath(rid))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("title", "A bright, calm stretch of ocean")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+}
+
+func TestEditRoomTitleForbiddenOutsideZone(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionBuildZoneRooms.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	opid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	zid := CreateTestZone(t, &i, "The Docks", opid)
+	defer DeleteTestZone(t, &i, zid)
+	rid := CreateTestRoom(t, &i, CreateTestRoomParams{
+		Title:       TestRoom.Title,
+		Description: TestRoom.Description,
+		Size:        TestRoom.Size,
+		ZID:         zid,
+	})
+	defer DeleteTestRoom(t, &i, rid)
+	unzonedRID := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, unzonedRID)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	for _, id := range []int64{rid, unzonedRID} {
+		url := MakeTestURL(route.RoomTitlePath(id))
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		writer.WriteField("title", "A bright, calm stretch of ocean")
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPatch, url, body)
+		req.Header.Add("Content-Type", writer.FormDataContentType())
+		req.AddCookie(sessionCookie)
+
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+	}
+}
+
+func TestEditRoomExitForbiddenTwoWayOutsideZone(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionBuildZoneRooms.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	zid := CreateTestZone(t, &i, "The Docks", pid)
+	defer DeleteTestZone(t, &i, zid)
+	rid := CreateTestRoom(t, &i, CreateTestRoomParams{
+		Title:       TestRoom.Title,
+		Description: TestRoom.Description,
+		Size:        TestRoom.Size,
+		ZID:         zid,
+	})
+	defer DeleteTestRoom(t, &i, rid)
+	unzonedRID := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, unzonedRID)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	url := MakeTestURL(route.RoomExitsPath(rid))
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("direction", room.DirectionNorth)
+	writer.WriteField("id", strconv.FormatInt(unzonedRID, 10))
+	writer.WriteField("two-way", "true")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPatch, url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.AddCookie(sessionCookie)
+
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
+func TestRoomsPageZoneFilterSuccess(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	permissionID := CreateTestPlayerPermission(t, &i, pid, player.PermissionViewAllRooms.Name)
+	defer DeleteTestPlayerPermission(t, &i, permissionID)
+	zid := CreateTestZone(t, &i, "The Docks", pid)
+	defer DeleteTestZone(t, &i, zid)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	for _, filter := range []string{strconv.FormatInt(zid, 10), zone.FilterNone} {
+		url := MakeTestURL(fmt.Sprintf("%s?zone=%s", route.Rooms, filter))
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, fiber.StatusOK, res.StatusCode)
+	}
+}
+
+func TestRoomsPageZoneBuilderSeesOwnZone(t *testing.T) {
+	i := service.NewInterfaces()
+	defer i.Close()
+
+	a := fiber.New(config.Fiber(i.Templates))
+	app.Middleware(a, &i)
+	app.Handlers(a, &i)
+
+	pid := CreateTestPlayer(t, &i, a, TestUsername, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsername)
+	prid := CreateTestPlayerPermission(t, &i, pid, player.PermissionBuildZoneRooms.Name)
+	defer DeleteTestPlayerPermission(t, &i, prid)
+	opid := CreateTestPlayer(t, &i, a, TestUsernameTwo, TestPassword)
+	defer DeleteTestPlayer(t, &i, TestUsernameTwo)
+	zid := CreateTestZone(t, &i, "The Docks", opid)
+	defer DeleteTestZone(t, &i, zid)
+	AddTestZoneBuilder(t, &i, zid, pid)
+	rid := CreateTestRoom(t, &i, CreateTestRoomParams{
+		Title:       TestRoom.Title,
+		Description: TestRoom.Description,
+		Size:        TestRoom.Size,
+		ZID:         zid,
+	})
+	defer DeleteTestRoom(t, &i, rid)
+	ridOutside := CreateTestRoom(t, &i, TestRoom)
+	defer DeleteTestRoom(t, &i, ridOutside)
+
+	sessionCookie := LoginTestPlayer(t, a, TestUsername, TestPassword)
+
+	for _, filter := range []string{"", strconv.FormatInt(zid, 10)} {
+		url := MakeTestURL(fmt.Sprintf("%s?zone=%s", route.Rooms, filter))
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.AddCookie(sessionCookie)
+		res, err := a.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		body := new(bytes.Buffer)
+		if _, err := body.ReadFrom(res.Body); err != nil {
+			t.Fatal(err)
+		}
+		require.Contains(t, body.String(), fmt.Sprintf(`"%s"`, route.RoomPath(rid)))
+		require.NotContains(t, body.String(), fmt.Sprintf(`"%s"`, route.RoomPath(ridOutside)))
+	}
+
+	url := MakeTestURL(route.RoomPath(rid))
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err := a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	url = MakeTestURL(route.RoomPath(ridOutside))
+	req = httptest.NewRequest(http.MethodGet, url, nil)
+	req.AddCookie(sessionCookie)
+	res, err = a.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.Equal(t, fiber.StatusForbidden, res.StatusCode)
+}
+
 func TestEditRoomDescriptionUnauthorized(t *testing.T) {
 	i := service.NewInterfaces()
 	defer i.Close()
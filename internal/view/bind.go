@@ -11,6 +11,7 @@ import (
 	"petrichormud.com/app/internal/player"
 	"petrichormud.com/app/internal/route"
 	"petrichormud.com/app/internal/util"
+	"petrichormud.com/app/internal/zone"
 )
 
 // TODO: Clean this up to split Menus and Footer stuff only when needed
@@ -43,17 +44,18 @@ func nav(c *fiber.Ctx) []fiber.Map {
 	if err != nil {
 		return nav
 	}
-	if perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
-		nav = append(nav, reviewMenu(c))
+	if review := reviewMenu(c, &perms); review != nil {
+		nav = append(nav, review)
 	}
 	// TODO: Clean up this permissions check
 	if perms.HasPermission(player.PermissionViewAllActorImages.Name) {
 		nav = append(nav, actorMenu(c))
 	}
-	if perms.HasPermission(player.PermissionViewAllRooms.Name) {
+	// Builders get the rooms page too, scoped to the zones they can build in
+	if perms.HasPermission(player.PermissionViewAllRooms.Name) || zone.IsBuilder(&perms) {
 		nav = append(nav, roomsMenu(c))
 	}
-	if perms.HasPermission(player.PermissionGrantAll.Name) {
+	if perms.CanManagePermissions() {
 		nav = append(nav, permissionsMenu(c))
 	}
 
@@ -159,6 +161,11 @@ func accountMenu(c *fiber.Ctx) fiber.Map {
 						"Path":   route.Characters,
 						"Active": c.Path() == route.Characters,
 					},
+					{
+						"Label":  "Room Proposals",
+						"Path":   route.RoomProposals,
+						"Active": c.Path() == route.RoomProposals,
+					},
 					{
 						"Label":  "Profile",
 						"Path":   route.Profile,
@@ -179,7 +186,27 @@ func accountMenu(c *fiber.Ctx) fiber.Map {
 	}
 }
 
-func reviewMenu(c *fiber.Ctx) fiber.Map {
+// reviewMenu links to each queue the player can review, or is nil if there aren't any
+func reviewMenu(c *fiber.Ctx, perms *player.Permissions) fiber.Map {
+	items := []fiber.Map{}
+	if perms.HasPermission(player.PermissionReviewCharacterApplications.Name) {
+		items = append(items, fiber.Map{
+			"Label":  "Character Applications",
+			"Path":   route.CharacterApplications,
+			"Active": c.Path() == route.CharacterApplications,
+		})
+	}
+	if perms.HasPermission(player.PermissionReviewRoomProposals.Name) {
+		items = append(items, fiber.Map{
+			"Label":  "Room Proposals",
+			"Path":   route.RoomProposalsQueue,
+			"Active": c.Path() == route.RoomProposalsQueue,
+		})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
 	return fiber.Map{
 		"Type": "List",
 		"Button": fiber.Map{
@@ -187,13 +214,7 @@ func reviewMenu(c *fiber.Ctx) fiber.Map {
 		},
 		"Sections": []fiber.Map{
 			{
-				"Items": []fiber.Map{
-					{
-						"Label":  "Character Applications",
-						"Path":   route.CharacterApplications,
-						"Active": c.Path() == route.CharacterApplications,
-					},
-				},
+				"Items": items,
 			},
 		},
 	}
@@ -213,6 +234,11 @@ func permissionsMenu(c *fiber.Ctx) fiber.Map {
 						"Path":   route.PlayerPermissions,
 						"Active": c.Path() == route.PlayerPermissions,
 					},
+					{
+						"Label":  "Permission History",
+						"Path":   route.PlayerHistory,
+						"Active": c.Path() == route.PlayerHistory,
+					},
 				},
 			},
 		},
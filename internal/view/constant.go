@@ -3,8 +3,9 @@ package view
 const Home string = "view-home"
 
 const (
-	Login  string = "view-login"
-	Logout string = "view-logout"
+	Login          string = "view-login"
+	LoginTwoFactor string = "view-login-two-factor"
+	Logout         string = "view-logout"
 )
 
 const DesignDictionary string = "view-design-dictionary"
@@ -33,8 +34,9 @@ const (
 )
 
 const (
-	PlayerPermissions       string = "view-player-permissions"
-	PlayerPermissionsDetail string = "view-player-permissions-detail"
+	PlayerPermissions        string = "view-player-permissions"
+	PlayerPermissionsDetail  string = "view-player-permissions-detail"
+	PlayerPermissionsHistory string = "view-player-permissions-history"
 )
 
 const Profile string = "view-profile"
@@ -60,7 +62,10 @@ const (
 )
 
 const (
-	Rooms    string = "view-rooms"
-	Room     string = "view-room"
-	EditRoom string = "view-room-edit"
+	Rooms             string = "view-rooms"
+	RoomsReport       string = "view-rooms-report"
+	Room              string = "view-room"
+	EditRoom          string = "view-room-edit"
+	RoomProposals     string = "view-room-proposals"
+	RoomProposalQueue string = "view-room-proposal-queue"
 )
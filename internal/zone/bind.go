@@ -0,0 +1,49 @@
+package zone
+
+import (
+	"strconv"
+
+	fiber "github.com/gofiber/fiber/v2"
+
+	"petrichormud.com/app/internal/query"
+)
+
+// FilterNone picks out the rooms that aren't in a zone
+const FilterNone string = "none"
+
+// ParseFilter reads a zone filter, which is either a zone's ID or FilterNone.
+// An empty or unreadable filter doesn't filter at all.
+func ParseFilter(filter string) (int64, bool) {
+	if filter == FilterNone {
+		return 0, true
+	}
+	zid, err := strconv.ParseInt(filter, 10, 64)
+	if err != nil || zid <= 0 {
+		return 0, false
+	}
+	return zid, true
+}
+
+func BindFilterOptions(zones []query.Zone, filter string) []fiber.Map {
+	options := []fiber.Map{
+		{
+			"Value":    "",
+			"Text":     "All Zones",
+			"Selected": len(filter) == 0,
+		},
+		{
+			"Value":    FilterNone,
+			"Text":     "No Zone",
+			"Selected": filter == FilterNone,
+		},
+	}
+	for _, z := range zones {
+		value := strconv.FormatInt(z.ID, 10)
+		options = append(options, fiber.Map{
+			"Value":    value,
+			"Text":     z.Name,
+			"Selected": filter == value,
+		})
+	}
+	return options
+}
@@ -0,0 +1,23 @@
+package zone
+
+import (
+	"regexp"
+
+	"petrichormud.com/app/internal/validate"
+)
+
+const (
+	NameMinLen int    = 2
+	NameMaxLen int    = 64
+	NameRegex  string = "[^a-zA-Z0-9,' -]+"
+)
+
+var (
+	NameLengthValidator validate.StringLengthValidator       = validate.NewStringLengthValidator(NameMinLen, NameMaxLen)
+	NameRegexValidator  validate.StringRegexNoMatchValidator = validate.NewStringRegexNoMatchValidator(regexp.MustCompile(NameRegex))
+	NameValidator       validate.StringValidatorGroup        = validate.NewStringValidatorGroup([]validate.StringValidator{&NameLengthValidator, &NameRegexValidator})
+)
+
+func IsNameValid(name string) bool {
+	return NameValidator.IsValid(name)
+}
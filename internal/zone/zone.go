@@ -0,0 +1,71 @@
+package zone
+
+import (
+	"context"
+	"database/sql"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+// IsBuilder reports whether a player can build in any zone at all. Handlers check it
+// before working out which zone a room is in.
+func IsBuilder(perms *player.Permissions) bool {
+	return perms.HasPermission(player.PermissionCreateRoom.Name) || perms.HasPermission(player.PermissionBuildZoneRooms.Name)
+}
+
+// CanBuild reports whether a player can build in a zone. Create-room covers every zone,
+// along with the rooms outside of one. Build-zone-rooms only covers the zones the player
+// owns or has been assigned to.
+func CanBuild(q *query.Queries, perms *player.Permissions, pid, zid int64) (bool, error) {
+	if perms.HasPermission(player.PermissionCreateRoom.Name) {
+		return true, nil
+	}
+
+	if !perms.HasPermission(player.PermissionBuildZoneRooms.Name) || zid == 0 {
+		return false, nil
+	}
+
+	z, err := q.GetZone(context.Background(), zid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	if z.OwnerPID == pid {
+		return true, nil
+	}
+
+	count, err := q.CountZoneBuilder(context.Background(), query.CountZoneBuilderParams{
+		ZID: zid,
+		PID: pid,
+	})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CanView reports whether a player can see the rooms in a zone. View-all-rooms covers every
+// zone; otherwise a player only sees the zones they can build in.
+func CanView(q *query.Queries, perms *player.Permissions, pid, zid int64) (bool, error) {
+	if perms.HasPermission(player.PermissionViewAllRooms.Name) {
+		return true, nil
+	}
+	return CanBuild(q, perms, pid, zid)
+}
+
+// CanBuildRoom reports whether a player can build in the zone a room is in.
+// It passes on sql.ErrNoRows when there's no such room.
+func CanBuildRoom(q *query.Queries, perms *player.Permissions, pid, rid int64) (bool, error) {
+	if perms.HasPermission(player.PermissionCreateRoom.Name) {
+		return true, nil
+	}
+
+	rm, err := q.GetRoom(context.Background(), rid)
+	if err != nil {
+		return false, err
+	}
+	return CanBuild(q, perms, pid, rm.ZID)
+}
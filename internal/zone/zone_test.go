@@ -0,0 +1,72 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"petrichormud.com/app/internal/player"
+	"petrichormud.com/app/internal/query"
+)
+
+func testPermissions(pid int64, names ...string) player.Permissions {
+	records := []query.PlayerPermission{}
+	for _, name := range names {
+		records = append(records, query.PlayerPermission{PID: pid, Name: name})
+	}
+	return player.NewPermissions(pid, records)
+}
+
+func TestIsBuilder(t *testing.T) {
+	perms := testPermissions(1, player.PermissionCreateRoom.Name)
+	require.True(t, IsBuilder(&perms))
+	perms = testPermissions(1, player.PermissionBuildZoneRooms.Name)
+	require.True(t, IsBuilder(&perms))
+	perms = testPermissions(1, player.PermissionViewAllRooms.Name)
+	require.False(t, IsBuilder(&perms))
+}
+
+func TestCanBuildWithoutLookingUpZone(t *testing.T) {
+	// Neither of these needs to look up the zone, so there's no need for queries
+	perms := testPermissions(1, player.PermissionCreateRoom.Name)
+	ok, err := CanBuild(nil, &perms, 1, 0)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	perms = testPermissions(1, player.PermissionBuildZoneRooms.Name)
+	ok, err = CanBuild(nil, &perms, 1, 0)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestParseFilter(t *testing.T) {
+	zid, ok := ParseFilter(FilterNone)
+	require.True(t, ok)
+	require.Equal(t, int64(0), zid)
+
+	zid, ok = ParseFilter("12")
+	require.True(t, ok)
+	require.Equal(t, int64(12), zid)
+
+	_, ok = ParseFilter("")
+	require.False(t, ok)
+	_, ok = ParseFilter("-3")
+	require.False(t, ok)
+	_, ok = ParseFilter("docks")
+	require.False(t, ok)
+}
+
+func TestBindFilterOptions(t *testing.T) {
+	options := BindFilterOptions([]query.Zone{{ID: 4, Name: "Docks"}}, "4")
+	require.Equal(t, 3, len(options))
+	require.Equal(t, false, options[0]["Selected"])
+	require.Equal(t, "4", options[2]["Value"])
+	require.Equal(t, true, options[2]["Selected"])
+}
+
+func TestIsNameValid(t *testing.T) {
+	require.True(t, IsNameValid("The Docks"))
+	require.True(t, IsNameValid("Ward 9"))
+	require.False(t, IsNameValid("D"))
+	require.False(t, IsNameValid("Docks!"))
+}
@@ -102,3 +102,23 @@ SELECT COUNT(*) FROM actor_images_player_properties WHERE pid = ? AND current =
 
 -- name: SetActorImagePlayerPropertiesCurrent :exec
 UPDATE actor_images_player_properties SET current = ? WHERE id = ?;
+
+-- name: ClearCurrentActorImagePlayerPropertiesForPlayer :exec
+UPDATE actor_images_player_properties SET current = false WHERE pid = ?;
+
+-- name: SetActorImagePlayerPropertiesRetired :exec
+UPDATE actor_images_player_properties SET retired = ? WHERE id = ?;
+
+-- name: ListCharactersForPlayer :many
+SELECT
+  sqlc.embed(actor_images_player_properties), sqlc.embed(actor_images), actor_images_character_metadata.value
+FROM
+  actor_images_player_properties
+JOIN
+  actor_images ON actor_images.id = actor_images_player_properties.aiid
+JOIN
+  actor_images_character_metadata ON actor_images_character_metadata.aiid = actor_images.id AND actor_images_character_metadata.`key` = 'name'
+WHERE
+  actor_images_player_properties.pid = ?
+ORDER BY
+  actor_images_player_properties.current DESC, actor_images_player_properties.retired, actor_images_player_properties.created_at DESC;
@@ -0,0 +1,18 @@
+-- name: CountUniqueActorImagesWithCharacterName :one
+SELECT
+  COUNT(*)
+FROM
+  actor_images_character_metadata
+JOIN
+  actor_images ON actor_images.id = actor_images_character_metadata.aiid
+WHERE
+  actor_images_character_metadata.`key` = 'name' AND actor_images_character_metadata.value = ? AND actor_images.uniq = true;
+
+-- name: CreateCharacterNameReservation :exec
+INSERT INTO character_name_reservations (name, rid, pid) VALUES (?, ?, ?);
+
+-- name: GetCharacterNameReservation :one
+SELECT * FROM character_name_reservations WHERE name = ?;
+
+-- name: DeleteCharacterNameReservationForRequest :exec
+DELETE FROM character_name_reservations WHERE rid = ?;
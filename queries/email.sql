@@ -24,3 +24,12 @@ SELECT COUNT(*) FROM emails WHERE pid = ?;
 
 -- name: DeleteEmail :exec
 DELETE FROM emails WHERE id = ?;
+
+-- name: GetPrimaryEmail :one
+SELECT * FROM emails WHERE pid = ? AND is_primary = true;
+
+-- name: ClearPrimaryEmail :exec
+UPDATE emails SET is_primary = false WHERE pid = ?;
+
+-- name: MarkEmailPrimary :exec
+UPDATE emails SET is_primary = true WHERE id = ? AND verified = true;
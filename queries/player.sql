@@ -4,6 +4,9 @@ INSERT INTO players (username, pw_hash) VALUES (?, ?);
 -- name: UpdatePlayerPassword :execresult
 UPDATE players SET pw_hash = ? WHERE id = ?;
 
+-- name: ListPlayerPasswordHashes :many
+SELECT pw_hash FROM players;
+
 -- name: GetPlayer :one
 SELECT * FROM players WHERE id = ?;
 
@@ -34,6 +37,55 @@ INSERT INTO player_permission_change_history (name, pid, ipid) VALUES (?, ?, ?);
 -- name: CreatePlayerPermissionRevokedChangeHistory :exec
 INSERT INTO player_permission_change_history (name, pid, ipid, revoked) VALUES (?, ?, ?, true);
 
+-- name: ListPlayerPermissionChangeHistory :many
+SELECT
+  sqlc.embed(player_permission_change_history), issuers.username AS issuer, targets.username AS target
+FROM
+  player_permission_change_history
+LEFT JOIN
+  players AS issuers ON issuers.id = player_permission_change_history.ipid
+LEFT JOIN
+  players AS targets ON targets.id = player_permission_change_history.pid
+WHERE
+  player_permission_change_history.ipid = COALESCE(sqlc.narg(ipid), player_permission_change_history.ipid)
+  AND player_permission_change_history.pid = COALESCE(sqlc.narg(pid), player_permission_change_history.pid)
+  AND player_permission_change_history.name = COALESCE(sqlc.narg(name), player_permission_change_history.name)
+ORDER BY
+  player_permission_change_history.created_at DESC, player_permission_change_history.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CreatePlayerRole :execresult
+INSERT INTO player_roles (name, pid, ipid) VALUES (?, ?, ?);
+
+-- name: DeletePlayerRole :exec
+DELETE FROM player_roles WHERE name = ? AND pid = ?;
+
+-- name: ListPlayerRoles :many
+SELECT * FROM player_roles WHERE pid = ?;
+
+-- name: CreatePlayerRoleIssuedChangeHistory :exec
+INSERT INTO player_role_change_history (name, pid, ipid) VALUES (?, ?, ?);
+
+-- name: CreatePlayerRoleRevokedChangeHistory :exec
+INSERT INTO player_role_change_history (name, pid, ipid, revoked) VALUES (?, ?, ?, true);
+
+-- name: ListPlayerRoleChangeHistory :many
+SELECT
+  sqlc.embed(player_role_change_history), issuers.username AS issuer, targets.username AS target
+FROM
+  player_role_change_history
+LEFT JOIN
+  players AS issuers ON issuers.id = player_role_change_history.ipid
+LEFT JOIN
+  players AS targets ON targets.id = player_role_change_history.pid
+WHERE
+  player_role_change_history.ipid = COALESCE(sqlc.narg(ipid), player_role_change_history.ipid)
+  AND player_role_change_history.pid = COALESCE(sqlc.narg(pid), player_role_change_history.pid)
+  AND player_role_change_history.name = COALESCE(sqlc.narg(name), player_role_change_history.name)
+ORDER BY
+  player_role_change_history.created_at DESC, player_role_change_history.id DESC
+LIMIT ? OFFSET ?;
+
 -- name: CreatePlayerSettings :exec
 INSERT INTO player_settings (theme, pid) VALUES (?, ?);
 
@@ -42,3 +94,30 @@ SELECT * FROM player_settings WHERE pid = ?;
 
 -- name: UpdatePlayerSettingsTheme :exec
 UPDATE player_settings SET theme = ? WHERE pid = ?;
+
+-- name: GetPlayerTwoFactor :one
+SELECT * FROM player_two_factor WHERE pid = ?;
+
+-- name: CreatePlayerTwoFactor :exec
+INSERT INTO player_two_factor (secret, pid) VALUES (?, ?) ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled = false, last_step = 0;
+
+-- name: EnablePlayerTwoFactor :exec
+UPDATE player_two_factor SET enabled = true, last_step = ? WHERE pid = ?;
+
+-- name: UpdatePlayerTwoFactorLastStep :exec
+UPDATE player_two_factor SET last_step = ? WHERE pid = ?;
+
+-- name: DeletePlayerTwoFactor :exec
+DELETE FROM player_two_factor WHERE pid = ?;
+
+-- name: CreatePlayerRecoveryCode :exec
+INSERT INTO player_recovery_codes (hash, pid) VALUES (?, ?);
+
+-- name: ListUnusedPlayerRecoveryCodes :many
+SELECT * FROM player_recovery_codes WHERE pid = ? AND used = false;
+
+-- name: UsePlayerRecoveryCode :exec
+UPDATE player_recovery_codes SET used = true WHERE id = ?;
+
+-- name: DeletePlayerRecoveryCodes :exec
+DELETE FROM player_recovery_codes WHERE pid = ?;
@@ -1,9 +1,12 @@
 -- name: ListRequestsForPlayer :many
-SELECT * FROM requests WHERE pid = ?;
+SELECT * FROM requests WHERE pid = ? AND id NOT IN (SELECT rid FROM request_deletions);
 
 -- name: GetRequest :one
 SELECT * FROM requests WHERE id = ?;
 
+-- name: GetRequestForUpdate :one
+SELECT * FROM requests WHERE id = ? FOR UPDATE;
+
 -- name: CreateRequest :execresult
 INSERT INTO requests (type, status, pid) VALUES (?, ?, ?);
 
@@ -61,7 +64,7 @@ UPDATE request_fields SET status = ? WHERE id = ?;
 -- name: UpdateRequestFieldStatusByRequestAndType :exec
 UPDATE request_fields SET status = ? WHERE type = ? AND rid = ?;
 
--- name: CreateRequestSubfield :exec
+-- name: CreateRequestSubfield :execresult
 INSERT INTO request_subfields (value, rfid) VALUES (?, ?);
 
 -- name: DeleteRequestSubfield :exec
@@ -79,6 +82,45 @@ SELECT * FROM request_subfields WHERE rfid = ?;
 -- name: ListRequestSubfieldsForFields :many
 SELECT * FROM request_subfields WHERE rfid IN (sqlc.slice("rfids"));
 
+-- name: CreateRequestFieldVersion :exec
+INSERT INTO request_field_versions (value, rfid, pid) VALUES (?, ?, ?);
+
+-- name: GetRequestFieldVersionBefore :one
+SELECT
+  *
+FROM
+  request_field_versions
+WHERE
+  rfid = ? AND created_at <= sqlc.arg(before)
+ORDER BY
+  created_at DESC, id DESC
+LIMIT 1;
+
+-- name: ListRequestFieldVersionsForField :many
+SELECT
+  sqlc.embed(request_field_versions), players.username
+FROM
+  request_field_versions
+LEFT JOIN
+  players ON players.id = request_field_versions.pid
+WHERE
+  request_field_versions.rfid = ?
+ORDER BY
+  request_field_versions.created_at DESC, request_field_versions.id DESC;
+
+-- name: CreateRequestSubfieldVersion :exec
+INSERT INTO request_subfield_versions (value, deleted, rsid, rfid, pid) VALUES (?, ?, ?, ?, ?);
+
+-- name: ListRequestSubfieldVersionsBefore :many
+SELECT
+  *
+FROM
+  request_subfield_versions
+WHERE
+  rfid = ? AND created_at <= sqlc.arg(before)
+ORDER BY
+  created_at, id;
+
 -- name: CreateOpenRequestChangeRequest :exec
 INSERT INTO open_request_change_requests (value, text, rfid, pid) VALUES (?, ?, ?, ?);
 
@@ -139,6 +181,19 @@ WHERE
 -- name: DeleteRequestChangeRequest :exec
 DELETE FROM request_change_requests WHERE id = ?;
 
+-- name: BatchDeleteRequestChangeRequest :exec
+DELETE FROM request_change_requests WHERE id IN (sqlc.slice("ids"));
+
+-- name: ListRequestChangeRequestsForRequest :many
+SELECT
+  request_change_requests.*
+FROM
+  request_fields
+JOIN
+  request_change_requests ON request_change_requests.rfid = request_fields.id
+WHERE
+  request_fields.rid = ?;
+
 -- name: GetRequestChangeRequestByFieldID :one
 SELECT * FROM request_change_requests WHERE rfid = ?;
 
@@ -154,5 +209,138 @@ SELECT * FROM
 WHERE
   request_change_requests.id = ?;
 
+-- name: BatchCreatePastRequestChangeRequest :exec
+INSERT INTO
+  past_request_change_requests
+SELECT * FROM
+  request_change_requests
+WHERE
+  request_change_requests.id IN (sqlc.slice("ids"));
+
+-- name: ListPastRequestChangeRequestsForField :many
+SELECT
+  sqlc.embed(past_request_change_requests), players.username
+FROM
+  past_request_change_requests
+LEFT JOIN
+  players ON players.id = past_request_change_requests.pid
+WHERE
+  past_request_change_requests.rfid = ?
+ORDER BY
+  past_request_change_requests.created_at DESC, past_request_change_requests.id DESC;
+
+-- name: CreateRequestFieldComment :exec
+INSERT INTO request_field_comments (text, rfid, pid) VALUES (?, ?, ?);
+
+-- name: ListRequestFieldCommentsForField :many
+SELECT
+  sqlc.embed(request_field_comments), players.username
+FROM
+  request_field_comments
+LEFT JOIN
+  players ON players.id = request_field_comments.pid
+WHERE
+  request_field_comments.rfid = ?
+ORDER BY
+  request_field_comments.created_at, request_field_comments.id;
+
+-- name: GetRequestFieldCommentResolution :one
+SELECT * FROM request_field_comment_resolutions WHERE rfid = ?;
+
+-- name: CreateRequestFieldCommentResolution :exec
+INSERT INTO request_field_comment_resolutions (rfid, pid) VALUES (?, ?);
+
+-- name: DeleteRequestFieldCommentResolution :exec
+DELETE FROM request_field_comment_resolutions WHERE rfid = ?;
+
+-- name: GetRequestFieldCommentRead :one
+SELECT * FROM request_field_comment_reads WHERE rfid = ? AND pid = ?;
+
+-- name: MarkRequestFieldCommentsRead :exec
+INSERT INTO request_field_comment_reads (cid, rfid, pid) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE cid = VALUES(cid);
+
 -- name: ListRequestsByTypeAndStatus :many
 SELECT * FROM requests WHERE type = ? AND status IN (sqlc.slice("statuses"));
+
+-- name: ListRequestQueue :many
+SELECT
+  sqlc.embed(requests),
+  COALESCE(submissions.submitted_at, requests.created_at) AS submitted_at
+FROM
+  requests
+LEFT JOIN
+  (SELECT rid, MAX(created_at) AS submitted_at FROM request_status_history WHERE to_status = 'Submitted' AND from_status IN ('Ready', 'Reviewed') GROUP BY rid) AS submissions
+  ON submissions.rid = requests.id
+WHERE
+  requests.type = sqlc.arg(type)
+  AND requests.pid != sqlc.arg(viewer)
+  AND requests.status IN (sqlc.slice("statuses"))
+  AND requests.rpid = COALESCE(sqlc.narg(rpid), requests.rpid)
+  AND requests.pid = COALESCE(sqlc.narg(author), requests.pid)
+  AND COALESCE(submissions.submitted_at, requests.created_at) <= sqlc.arg(submitted_before)
+  AND requests.id NOT IN (SELECT rid FROM request_deletions)
+ORDER BY
+  submitted_at ASC, requests.id ASC
+LIMIT ? OFFSET ?;
+
+-- name: CreateRequestStatusHistory :exec
+INSERT INTO request_status_history (note, from_status, to_status, rid, pid) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetLatestRequestStatusHistoryTo :one
+SELECT
+  *
+FROM
+  request_status_history
+WHERE
+  rid = ? AND to_status = ?
+ORDER BY
+  created_at DESC, id DESC
+LIMIT 1;
+
+-- name: ListRequestStatusHistoryForRequest :many
+SELECT
+  sqlc.embed(request_status_history), players.username
+FROM
+  request_status_history
+LEFT JOIN
+  players ON players.id = request_status_history.pid
+WHERE
+  request_status_history.rid = ?
+ORDER BY
+  request_status_history.created_at, request_status_history.id;
+
+-- name: CreateRequestDeletion :exec
+INSERT INTO request_deletions (rid, pid) VALUES (?, ?);
+
+-- name: GetRequestDeletion :one
+SELECT * FROM request_deletions WHERE rid = ?;
+
+-- name: DeleteRequestDeletion :exec
+DELETE FROM request_deletions WHERE rid = ?;
+
+-- name: ListDeletedRequestsForPlayer :many
+SELECT
+  sqlc.embed(requests), sqlc.embed(request_deletions)
+FROM
+  requests
+JOIN
+  request_deletions ON request_deletions.rid = requests.id
+WHERE
+  requests.pid = ? AND requests.type = ? AND request_deletions.created_at > ?
+ORDER BY
+  request_deletions.created_at DESC;
+
+-- name: ListStaleInReviewRequests :many
+SELECT
+  *
+FROM
+  requests
+WHERE
+  status = 'InReview'
+  AND id NOT IN (SELECT rid FROM request_deletions)
+  AND COALESCE(
+    (SELECT MAX(created_at) FROM request_status_history WHERE rid = requests.id AND to_status = 'InReview'),
+    updated_at
+  ) <= sqlc.arg(before)
+ORDER BY
+  id;
@@ -7,8 +7,11 @@ SELECT * FROM rooms;
 -- name: ListRoomsByIDs :many
 SELECT * FROM rooms WHERE id IN (sqlc.slice("ids"));
 
+-- name: ListRoomsByZone :many
+SELECT * FROM rooms WHERE zid = ?;
+
 -- name: CreateRoom :execresult
-INSERT INTO rooms (title, description, size) VALUES (?, ?, ?);
+INSERT INTO rooms (title, description, size, zid) VALUES (?, ?, ?, ?);
 
 -- name: UpdateRoom :exec
 UPDATE
@@ -30,26 +33,41 @@ UPDATE rooms SET description = ?, unmodified = false WHERE id = ?;
 -- name: UpdateRoomSize :exec
 UPDATE rooms SET size = ? WHERE id = ?;
 
--- name: UpdateRoomExitNorth :exec
-UPDATE rooms SET north = ? WHERE id = ?;
+-- name: UpdateRoomZone :exec
+UPDATE rooms SET zid = ? WHERE id = ?;
+
+-- name: UpdateRoomCoordinates :exec
+UPDATE rooms SET x = ?, y = ?, z = ?, placed = true WHERE id = ?;
 
--- name: UpdateRoomExitNortheast :exec
-UPDATE rooms SET northeast = ? WHERE id = ?;
+-- name: ListRoomExits :many
+SELECT * FROM room_exits WHERE rid = ?;
 
--- name: UpdateRoomExitEast :exec
-UPDATE rooms SET east = ? WHERE id = ?;
+-- name: ListAllRoomExits :many
+SELECT * FROM room_exits;
 
--- name: UpdateRoomExitSoutheast :exec
-UPDATE rooms SET southeast = ? WHERE id = ?;
+-- name: ListRoomExitsByRoomIDs :many
+SELECT * FROM room_exits WHERE rid IN (sqlc.slice("rids"));
 
--- name: UpdateRoomExitSouth :exec
-UPDATE rooms SET south = ? WHERE id = ?;
+-- name: GetRoomExit :one
+SELECT * FROM room_exits WHERE rid = ? AND direction = ?;
 
--- name: UpdateRoomExitSouthwest :exec
-UPDATE rooms SET southwest = ? WHERE id = ?;
+-- name: SetRoomExit :exec
+INSERT INTO
+  room_exits (rid, direction, to_rid, door, hidden, locked)
+VALUES
+  (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  to_rid = VALUES(to_rid);
 
--- name: UpdateRoomExitWest :exec
-UPDATE rooms SET west = ? WHERE id = ?;
+-- name: UpdateRoomExitFlags :exec
+UPDATE
+  room_exits
+SET
+  door = ?,
+  hidden = ?,
+  locked = ?
+WHERE
+  rid = ? AND direction = ?;
 
--- name: UpdateRoomExitNorthwest :exec
-UPDATE rooms SET northwest = ? WHERE id = ?;
+-- name: DeleteRoomExit :exec
+DELETE FROM room_exits WHERE rid = ? AND direction = ?;
@@ -0,0 +1,5 @@
+-- name: GetSiteSetting :one
+SELECT * FROM site_settings WHERE name = ?;
+
+-- name: SetSiteSetting :exec
+INSERT INTO site_settings (name, value) VALUES (?, ?) ON DUPLICATE KEY UPDATE value = VALUES(value);
@@ -0,0 +1,23 @@
+-- name: CreateZone :execresult
+INSERT INTO zones (name, owner_pid) VALUES (?, ?);
+
+-- name: GetZone :one
+SELECT * FROM zones WHERE id = ?;
+
+-- name: ListZones :many
+SELECT * FROM zones ORDER BY name;
+
+-- name: UpdateZoneOwner :exec
+UPDATE zones SET owner_pid = ? WHERE id = ?;
+
+-- name: AddZoneBuilder :exec
+INSERT IGNORE INTO zone_builders (zid, pid) VALUES (?, ?);
+
+-- name: RemoveZoneBuilder :exec
+DELETE FROM zone_builders WHERE zid = ? AND pid = ?;
+
+-- name: ListZoneBuilders :many
+SELECT * FROM zone_builders WHERE zid = ?;
+
+-- name: CountZoneBuilder :one
+SELECT COUNT(*) FROM zone_builders WHERE zid = ? AND pid = ?;
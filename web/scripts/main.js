@@ -364,6 +364,7 @@ export function getCharacterApplicationNameData(name) {
         len: name.length > 0,
       },
     },
+    reserved: false,
     sanitizeCharacterName,
     isCharacterNameValid,
   };
@@ -379,6 +380,36 @@ export function getCharacterApplicationGenderData(gender) {
   };
 }
 
+export function getRoomProposalTitleData(title) {
+  return {
+    title,
+    showSubmitDialog: false,
+    showCancelDialog: false,
+    partsOpen: false,
+    actionsOpen: false,
+  };
+}
+
+export function getRoomProposalDescriptionData(description) {
+  return {
+    description,
+    showSubmitDialog: false,
+    showCancelDialog: false,
+    partsOpen: false,
+    actionsOpen: false,
+  };
+}
+
+export function getRoomProposalSizeData(size) {
+  return {
+    size,
+    showSubmitDialog: false,
+    showCancelDialog: false,
+    partsOpen: false,
+    actionsOpen: false,
+  };
+}
+
 export function getCharacterApplicationShortDescriptionData(sdesc) {
   return {
     sdesc,
@@ -464,6 +495,7 @@ export function getRequestData(text = "") {
     showFinishReviewDialog: false,
     showRejectDialog: false,
     showFulfillDialog: false,
+    showDeleteDialog: false,
     changeRequestOpen: false,
     editChangeRequestOpen: false,
     changeRequestOpenField: "",
@@ -534,6 +566,9 @@ window.getCharacterApplicationDescriptionData =
 window.getCharacterApplicationBackstoryData =
   getCharacterApplicationBackstoryData;
 window.getCharacterApplicationKeywordData = getCharacterApplicationKeywordData;
+window.getRoomProposalTitleData = getRoomProposalTitleData;
+window.getRoomProposalDescriptionData = getRoomProposalDescriptionData;
+window.getRoomProposalSizeData = getRoomProposalSizeData;
 // TODO: I believe this function can be removed
 window.getCharacterApplicationSummaryData = getCharacterApplicationSummaryData;
 // TODO: I think this function can be removed